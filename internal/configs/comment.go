@@ -0,0 +1,8 @@
+package configs
+
+type Comment struct {
+	// MaxReplyDepth is how deep replies can be nested, top level comments have a depth of 0.
+	MaxReplyDepth uint64 `yaml:"max_reply_depth"`
+	// InlineReplyCount is how many replies are returned with each top level comment of a threaded comment list.
+	InlineReplyCount uint64 `yaml:"inline_reply_count"`
+}
@@ -0,0 +1,7 @@
+package configs
+
+type Content struct {
+	PostMaxLength    uint64 `yaml:"post_max_length"`
+	CommentMaxLength uint64 `yaml:"comment_max_length"`
+	MarkdownEnabled  bool   `yaml:"markdown_enabled"`
+}
@@ -0,0 +1,14 @@
+package configs
+
+import "time"
+
+type Counter struct {
+	// FlushInterval is how often the engagement counters kept in the cache are persisted to the database, and the
+	// counters missing from the cache are rebuilt from the source tables.
+	FlushInterval  string `yaml:"flush_interval"`
+	FlushBatchSize uint64 `yaml:"flush_batch_size"`
+}
+
+func (c Counter) GetFlushIntervalDuration() (time.Duration, error) {
+	return time.ParseDuration(c.FlushInterval)
+}
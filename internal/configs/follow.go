@@ -0,0 +1,14 @@
+package configs
+
+import "time"
+
+type Follow struct {
+	// SuggestionRefreshInterval is how often the follow suggestions of the accounts that were active since the last
+	// run are recomputed into the cache.
+	SuggestionRefreshInterval  string `yaml:"suggestion_refresh_interval"`
+	SuggestionRefreshBatchSize uint64 `yaml:"suggestion_refresh_batch_size"`
+}
+
+func (f Follow) GetSuggestionRefreshIntervalDuration() (time.Duration, error) {
+	return time.ParseDuration(f.SuggestionRefreshInterval)
+}
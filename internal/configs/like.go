@@ -0,0 +1,7 @@
+package configs
+
+type Like struct {
+	// ReactionTypes are the reactions accounts can use, out of like, love, laugh, wow, sad and angry. Every type is
+	// enabled when it is empty. Like is always enabled so that clients that only know about likes keep working.
+	ReactionTypes []string `yaml:"reaction_types"`
+}
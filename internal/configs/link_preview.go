@@ -0,0 +1,16 @@
+package configs
+
+import "time"
+
+type LinkPreview struct {
+	Timeout      string `yaml:"timeout"`
+	MaxBodySize  int64  `yaml:"max_body_size"`
+	MaxRedirects int    `yaml:"max_redirects"`
+	UserAgent    string `yaml:"user_agent"`
+	// AllowPrivateAddresses turns off the SSRF protection, it is only meant for local development and tests.
+	AllowPrivateAddresses bool `yaml:"allow_private_addresses"`
+}
+
+func (l LinkPreview) GetTimeoutDuration() (time.Duration, error) {
+	return time.ParseDuration(l.Timeout)
+}
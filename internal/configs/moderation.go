@@ -0,0 +1,8 @@
+package configs
+
+type Moderation struct {
+	// BlockedKeywords are matched case insensitively on word boundaries.
+	BlockedKeywords []string `yaml:"blocked_keywords"`
+	BlockedPatterns []string `yaml:"blocked_patterns"`
+	AdminAccountIDs []uint64 `yaml:"admin_account_ids"`
+}
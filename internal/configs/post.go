@@ -0,0 +1,49 @@
+package configs
+
+import "time"
+
+type Post struct {
+	SchedulerInterval  string `yaml:"scheduler_interval"`
+	SchedulerBatchSize uint64 `yaml:"scheduler_batch_size"`
+	MaxPinnedPosts     uint64 `yaml:"max_pinned_posts"`
+	PollCloserInterval string `yaml:"poll_closer_interval"`
+	// RestoreWindow is how long a deleted post can be restored, DeletedRetention is how long deleted posts and
+	// comments are kept before the purge worker hard deletes them.
+	RestoreWindow    string `yaml:"restore_window"`
+	DeletedRetention string `yaml:"deleted_retention"`
+	PurgeInterval    string `yaml:"purge_interval"`
+	PurgeBatchSize   uint64 `yaml:"purge_batch_size"`
+	// ViewCountFlushInterval is how often the unique viewer counts kept in the cache are persisted to the database.
+	ViewCountFlushInterval  string `yaml:"view_count_flush_interval"`
+	ViewCountFlushBatchSize uint64 `yaml:"view_count_flush_batch_size"`
+	// TrendingRefreshInterval is how often the decayed trending scores are recomputed from the recorded activity.
+	TrendingRefreshInterval string `yaml:"trending_refresh_interval"`
+}
+
+func (p Post) GetSchedulerIntervalDuration() (time.Duration, error) {
+	return time.ParseDuration(p.SchedulerInterval)
+}
+
+func (p Post) GetPollCloserIntervalDuration() (time.Duration, error) {
+	return time.ParseDuration(p.PollCloserInterval)
+}
+
+func (p Post) GetRestoreWindowDuration() (time.Duration, error) {
+	return time.ParseDuration(p.RestoreWindow)
+}
+
+func (p Post) GetDeletedRetentionDuration() (time.Duration, error) {
+	return time.ParseDuration(p.DeletedRetention)
+}
+
+func (p Post) GetPurgeIntervalDuration() (time.Duration, error) {
+	return time.ParseDuration(p.PurgeInterval)
+}
+
+func (p Post) GetViewCountFlushIntervalDuration() (time.Duration, error) {
+	return time.ParseDuration(p.ViewCountFlushInterval)
+}
+
+func (p Post) GetTrendingRefreshIntervalDuration() (time.Duration, error) {
+	return time.ParseDuration(p.TrendingRefreshInterval)
+}
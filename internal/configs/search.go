@@ -0,0 +1,12 @@
+package configs
+
+type SearchType string
+
+const (
+	SearchTypeInMemory SearchType = "in_memory"
+	SearchTypePostgres SearchType = "postgres"
+)
+
+type Search struct {
+	Type SearchType `yaml:"type"`
+}
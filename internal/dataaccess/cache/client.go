@@ -21,8 +21,37 @@ var (
 type Client interface {
 	Set(ctx context.Context, key string, data any, ttl time.Duration) error
 	Get(ctx context.Context, key string) (any, error)
+	Delete(ctx context.Context, key string) error
 	AddToSet(ctx context.Context, key string, data ...any) error
 	IsDataInSet(ctx context.Context, key string, data any) (bool, error)
+	PopFromSet(ctx context.Context, key string, count int64) ([]string, error)
+	AddToHyperLogLog(ctx context.Context, key string, data ...any) error
+	AddToHyperLogLogs(ctx context.Context, keys []string, data any) error
+	CountHyperLogLog(ctx context.Context, key string) (uint64, error)
+	SetIfNotExists(ctx context.Context, key string, data any, ttl time.Duration) (bool, error)
+	Expire(ctx context.Context, key string, ttl time.Duration) error
+	IncrementSortedSetScore(ctx context.Context, key string, member string, increment float64) error
+	UnionSortedSets(ctx context.Context, destination string, keys []string, weights []float64) error
+	TrimSortedSet(ctx context.Context, key string, maxSize int64) error
+	GetSortedSetTopMembers(ctx context.Context, key string, count int64) ([]SortedSetMember, error)
+	GetHash(ctx context.Context, key string) (map[string]string, error)
+	SetHash(ctx context.Context, key string, fields map[string]any, ttl time.Duration) error
+	IncrementHashFieldIfExists(ctx context.Context, key string, field string, increment int64) (bool, error)
+}
+
+// incrementHashFieldIfExistsScript only increments a field of a hash that exists, so that a partial hash is never
+// created from an increment alone.
+var incrementHashFieldIfExistsScript = redis.NewScript(`
+if redis.call("EXISTS", KEYS[1]) == 0 then
+	return 0
+end
+redis.call("HINCRBY", KEYS[1], ARGV[1], ARGV[2])
+return 1
+`)
+
+type SortedSetMember struct {
+	Member string
+	Score  float64
 }
 
 type redisClient struct {
@@ -71,6 +100,17 @@ func (c redisClient) Get(ctx context.Context, key string) (any, error) {
 	return data, nil
 }
 
+func (c redisClient) Delete(ctx context.Context, key string) error {
+	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.String("key", key))
+
+	if err := c.redisClient.Del(ctx, key).Err(); err != nil {
+		logger.With(zap.Error(err)).Error("failed to delete data from cache")
+		return status.Error(codes.Internal, "failed to delete data from cache")
+	}
+
+	return nil
+}
+
 func (c redisClient) AddToSet(ctx context.Context, key string, data ...any) error {
 	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.String("key", key)).With(zap.Any("data", data))
 
@@ -93,3 +133,192 @@ func (c redisClient) IsDataInSet(ctx context.Context, key string, data any) (boo
 
 	return result, nil
 }
+
+func (c redisClient) PopFromSet(ctx context.Context, key string, count int64) ([]string, error) {
+	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.String("key", key)).With(zap.Int64("count", count))
+
+	result, err := c.redisClient.SPopN(ctx, key, count).Result()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to pop data from set inside cache")
+		return nil, status.Error(codes.Internal, "failed to pop data from set inside cache")
+	}
+
+	return result, nil
+}
+
+func (c redisClient) AddToHyperLogLog(ctx context.Context, key string, data ...any) error {
+	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.String("key", key)).With(zap.Any("data", data))
+
+	if err := c.redisClient.PFAdd(ctx, key, data...).Err(); err != nil {
+		logger.With(zap.Error(err)).Error("failed to add data into hyperloglog inside cache")
+		return status.Error(codes.Internal, "failed to add data into hyperloglog inside cache")
+	}
+
+	return nil
+}
+
+// AddToHyperLogLogs adds data to every hyperloglog of keys in a single round trip.
+func (c redisClient) AddToHyperLogLogs(ctx context.Context, keys []string, data any) error {
+	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.Strings("keys", keys)).With(zap.Any("data", data))
+
+	_, err := c.redisClient.Pipelined(ctx, func(pipeliner redis.Pipeliner) error {
+		for _, key := range keys {
+			pipeliner.PFAdd(ctx, key, data)
+		}
+		return nil
+	})
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to add data into hyperloglogs inside cache")
+		return status.Error(codes.Internal, "failed to add data into hyperloglogs inside cache")
+	}
+
+	return nil
+}
+
+func (c redisClient) CountHyperLogLog(ctx context.Context, key string) (uint64, error) {
+	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.String("key", key))
+
+	result, err := c.redisClient.PFCount(ctx, key).Result()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to count hyperloglog inside cache")
+		return 0, status.Error(codes.Internal, "failed to count hyperloglog inside cache")
+	}
+
+	return uint64(result), nil
+}
+
+func (c redisClient) SetIfNotExists(ctx context.Context, key string, data any, ttl time.Duration) (bool, error) {
+	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.String("key", key)).With(zap.Any("data", data)).With(zap.Duration("ttl", ttl))
+
+	result, err := c.redisClient.SetNX(ctx, key, data, ttl).Result()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to set data into cache if not exists")
+		return false, status.Error(codes.Internal, "failed to set data into cache if not exists")
+	}
+
+	return result, nil
+}
+
+func (c redisClient) Expire(ctx context.Context, key string, ttl time.Duration) error {
+	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.String("key", key)).With(zap.Duration("ttl", ttl))
+
+	if err := c.redisClient.Expire(ctx, key, ttl).Err(); err != nil {
+		logger.With(zap.Error(err)).Error("failed to set expiration of key inside cache")
+		return status.Error(codes.Internal, "failed to set expiration of key inside cache")
+	}
+
+	return nil
+}
+
+func (c redisClient) IncrementSortedSetScore(ctx context.Context, key string, member string, increment float64) error {
+	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.String("key", key)).With(zap.String("member", member))
+
+	if err := c.redisClient.ZIncrBy(ctx, key, increment, member).Err(); err != nil {
+		logger.With(zap.Error(err)).Error("failed to increment score of member of sorted set inside cache")
+		return status.Error(codes.Internal, "failed to increment score of member of sorted set inside cache")
+	}
+
+	return nil
+}
+
+// UnionSortedSets replaces destination with the sum of the scores of keys, each multiplied by its weight. Missing
+// keys count as empty sorted sets.
+func (c redisClient) UnionSortedSets(ctx context.Context, destination string, keys []string, weights []float64) error {
+	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.String("destination", destination)).With(zap.Strings("keys", keys))
+
+	err := c.redisClient.ZUnionStore(ctx, destination, &redis.ZStore{
+		Keys:      keys,
+		Weights:   weights,
+		Aggregate: "SUM",
+	}).Err()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to union sorted sets inside cache")
+		return status.Error(codes.Internal, "failed to union sorted sets inside cache")
+	}
+
+	return nil
+}
+
+// TrimSortedSet keeps only the maxSize members with the highest scores.
+func (c redisClient) TrimSortedSet(ctx context.Context, key string, maxSize int64) error {
+	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.String("key", key)).With(zap.Int64("max_size", maxSize))
+
+	if err := c.redisClient.ZRemRangeByRank(ctx, key, 0, -maxSize-1).Err(); err != nil {
+		logger.With(zap.Error(err)).Error("failed to trim sorted set inside cache")
+		return status.Error(codes.Internal, "failed to trim sorted set inside cache")
+	}
+
+	return nil
+}
+
+func (c redisClient) GetSortedSetTopMembers(ctx context.Context, key string, count int64) ([]SortedSetMember, error) {
+	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.String("key", key)).With(zap.Int64("count", count))
+
+	result, err := c.redisClient.ZRevRangeWithScores(ctx, key, 0, count-1).Result()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get top members of sorted set inside cache")
+		return nil, status.Error(codes.Internal, "failed to get top members of sorted set inside cache")
+	}
+
+	members := make([]SortedSetMember, 0, len(result))
+	for _, item := range result {
+		member, ok := item.Member.(string)
+		if !ok {
+			continue
+		}
+		members = append(members, SortedSetMember{
+			Member: member,
+			Score:  item.Score,
+		})
+	}
+
+	return members, nil
+}
+
+// GetHash returns every field of a hash, ErrCacheMiss when the hash does not exist.
+func (c redisClient) GetHash(ctx context.Context, key string) (map[string]string, error) {
+	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.String("key", key))
+
+	result, err := c.redisClient.HGetAll(ctx, key).Result()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get hash from cache")
+		return nil, status.Error(codes.Internal, "failed to get hash from cache")
+	}
+	if len(result) == 0 {
+		return nil, ErrCacheMiss
+	}
+
+	return result, nil
+}
+
+// SetHash replaces a hash with fields in a single transaction.
+func (c redisClient) SetHash(ctx context.Context, key string, fields map[string]any, ttl time.Duration) error {
+	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.String("key", key)).With(zap.Duration("ttl", ttl))
+
+	_, err := c.redisClient.TxPipelined(ctx, func(pipeliner redis.Pipeliner) error {
+		pipeliner.Del(ctx, key)
+		pipeliner.HSet(ctx, key, fields)
+		pipeliner.Expire(ctx, key, ttl)
+		return nil
+	})
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to set hash into cache")
+		return status.Error(codes.Internal, "failed to set hash into cache")
+	}
+
+	return nil
+}
+
+// IncrementHashFieldIfExists atomically increments a field of a hash and reports whether the hash existed. Nothing is
+// written when it did not.
+func (c redisClient) IncrementHashFieldIfExists(ctx context.Context, key string, field string, increment int64) (bool, error) {
+	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.String("key", key)).With(zap.String("field", field))
+
+	result, err := incrementHashFieldIfExistsScript.Run(ctx, c.redisClient, []string{key}, field, increment).Int()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to increment field of hash inside cache")
+		return false, status.Error(codes.Internal, "failed to increment field of hash inside cache")
+	}
+
+	return result == 1, nil
+}
@@ -0,0 +1,193 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+
+	"GoFeed/internal/utils"
+)
+
+type CounterKind string
+
+const (
+	CounterKindPost    CounterKind = "post"
+	CounterKindAccount CounterKind = "account"
+)
+
+const (
+	// counterTTL bounds how long a counter can drift from the source tables, once it expires the next read
+	// rebuilds it.
+	counterTTL = 24 * time.Hour
+)
+
+// Counter keeps the engagement counters of posts and accounts in one hash per entity, so that they can be read and
+// updated without counting rows. Entities whose counters changed are tracked in a pending set until they are flushed
+// to the database, and entities whose counters are missing from the cache are tracked in a stale set until they are
+// rebuilt.
+type Counter interface {
+	Get(ctx context.Context, kind CounterKind, id uint64) (map[string]int64, error)
+	Set(ctx context.Context, kind CounterKind, id uint64, counts map[string]int64) error
+	Delete(ctx context.Context, kind CounterKind, id uint64) error
+	Increment(ctx context.Context, kind CounterKind, id uint64, field string, delta int64) (bool, error)
+	AddPending(ctx context.Context, kind CounterKind, ids ...uint64) error
+	PopPending(ctx context.Context, kind CounterKind, count int64) ([]uint64, error)
+	AddStale(ctx context.Context, kind CounterKind, ids ...uint64) error
+	PopStale(ctx context.Context, kind CounterKind, count int64) ([]uint64, error)
+}
+
+type counter struct {
+	client Client
+	logger *zap.Logger
+}
+
+func NewCounter(
+	client Client,
+	logger *zap.Logger,
+) Counter {
+	return &counter{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (c counter) getHashKey(kind CounterKind, id uint64) string {
+	return fmt.Sprintf("counters:%s:%d", kind, id)
+}
+
+func (c counter) getPendingSetKey(kind CounterKind) string {
+	return fmt.Sprintf("pending_counters:%s", kind)
+}
+
+func (c counter) getStaleSetKey(kind CounterKind) string {
+	return fmt.Sprintf("stale_counters:%s", kind)
+}
+
+// Get returns the counters of an entity, ErrCacheMiss when they are not in the cache.
+func (c counter) Get(ctx context.Context, kind CounterKind, id uint64) (map[string]int64, error) {
+	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.String("kind", string(kind))).With(zap.Uint64("id", id))
+
+	fields, err := c.client.GetHash(ctx, c.getHashKey(kind, id))
+	if err != nil {
+		if !errors.Is(err, ErrCacheMiss) {
+			logger.With(zap.Error(err)).Error("failed to get counters from cache")
+		}
+		return nil, err
+	}
+
+	counts := make(map[string]int64, len(fields))
+	for field, value := range fields {
+		count, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			logger.With(zap.String("field", field)).Warn("skipping invalid counter in cache")
+			continue
+		}
+		counts[field] = count
+	}
+
+	return counts, nil
+}
+
+func (c counter) Set(ctx context.Context, kind CounterKind, id uint64, counts map[string]int64) error {
+	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.String("kind", string(kind))).With(zap.Uint64("id", id))
+
+	fields := make(map[string]any, len(counts))
+	for field, count := range counts {
+		fields[field] = count
+	}
+	if err := c.client.SetHash(ctx, c.getHashKey(kind, id), fields, counterTTL); err != nil {
+		logger.With(zap.Error(err)).Error("failed to set counters into cache")
+		return err
+	}
+
+	return nil
+}
+
+// Delete drops the counters of an entity, the increments made until they are set again mark the entity as stale.
+func (c counter) Delete(ctx context.Context, kind CounterKind, id uint64) error {
+	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.String("kind", string(kind))).With(zap.Uint64("id", id))
+
+	if err := c.client.Delete(ctx, c.getHashKey(kind, id)); err != nil {
+		logger.With(zap.Error(err)).Error("failed to delete counters from cache")
+		return err
+	}
+
+	return nil
+}
+
+// Increment adds delta to a counter of an entity and marks it as pending. It reports false without changing anything
+// when the counters of the entity are not in the cache.
+func (c counter) Increment(ctx context.Context, kind CounterKind, id uint64, field string, delta int64) (bool, error) {
+	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.String("kind", string(kind))).With(zap.Uint64("id", id))
+
+	found, err := c.client.IncrementHashFieldIfExists(ctx, c.getHashKey(kind, id), field, delta)
+	if err != nil {
+		logger.With(zap.String("field", field)).With(zap.Error(err)).Error("failed to increment counter in cache")
+		return false, err
+	}
+	if !found {
+		return false, nil
+	}
+
+	return true, c.AddPending(ctx, kind, id)
+}
+
+func (c counter) AddPending(ctx context.Context, kind CounterKind, ids ...uint64) error {
+	return c.addToSet(ctx, c.getPendingSetKey(kind), ids)
+}
+
+func (c counter) PopPending(ctx context.Context, kind CounterKind, count int64) ([]uint64, error) {
+	return c.popFromSet(ctx, c.getPendingSetKey(kind), count)
+}
+
+func (c counter) AddStale(ctx context.Context, kind CounterKind, ids ...uint64) error {
+	return c.addToSet(ctx, c.getStaleSetKey(kind), ids)
+}
+
+func (c counter) PopStale(ctx context.Context, kind CounterKind, count int64) ([]uint64, error) {
+	return c.popFromSet(ctx, c.getStaleSetKey(kind), count)
+}
+
+func (c counter) addToSet(ctx context.Context, key string, ids []uint64) error {
+	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.String("key", key))
+
+	if len(ids) == 0 {
+		return nil
+	}
+	members := make([]any, 0, len(ids))
+	for _, id := range ids {
+		members = append(members, id)
+	}
+	if err := c.client.AddToSet(ctx, key, members...); err != nil {
+		logger.With(zap.Error(err)).Error("failed to add counters to set in cache")
+		return err
+	}
+
+	return nil
+}
+
+func (c counter) popFromSet(ctx context.Context, key string, count int64) ([]uint64, error) {
+	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.String("key", key))
+
+	members, err := c.client.PopFromSet(ctx, key, count)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to pop counters from set in cache")
+		return nil, err
+	}
+
+	ids := make([]uint64, 0, len(members))
+	for _, member := range members {
+		id, err := strconv.ParseUint(member, 10, 64)
+		if err != nil {
+			logger.With(zap.String("member", member)).Warn("skipping invalid id in counter set")
+			continue
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
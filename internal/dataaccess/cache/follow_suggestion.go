@@ -0,0 +1,132 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+
+	"GoFeed/internal/utils"
+)
+
+const (
+	setKeyNameActiveFollowSuggestion = "active_follow_suggestion_set"
+
+	// followSuggestionTTL bounds how long the suggestions of an account that stopped asking for them are kept.
+	followSuggestionTTL = 24 * time.Hour
+)
+
+type FollowSuggestionEntry struct {
+	AccountID   uint64 `json:"account_id"`
+	MutualCount uint64 `json:"mutual_count"`
+}
+
+// FollowSuggestion keeps the ranked follow suggestions of accounts. Accounts that asked for suggestions or followed
+// someone are tracked in an active set until the follow suggestion worker recomputes their suggestions.
+type FollowSuggestion interface {
+	Get(ctx context.Context, accountID uint64) ([]FollowSuggestionEntry, error)
+	Set(ctx context.Context, accountID uint64, entries []FollowSuggestionEntry) error
+	AddActive(ctx context.Context, accountIDs ...uint64) error
+	PopActive(ctx context.Context, count int64) ([]uint64, error)
+}
+
+type followSuggestion struct {
+	client Client
+	logger *zap.Logger
+}
+
+func NewFollowSuggestion(
+	client Client,
+	logger *zap.Logger,
+) FollowSuggestion {
+	return &followSuggestion{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (c followSuggestion) getKey(accountID uint64) string {
+	return fmt.Sprintf("follow_suggestions:%d", accountID)
+}
+
+// Get returns the suggestions of an account in rank order, ErrCacheMiss when they are not in the cache.
+func (c followSuggestion) Get(ctx context.Context, accountID uint64) ([]FollowSuggestionEntry, error) {
+	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.Uint64("account_id", accountID))
+
+	data, err := c.client.Get(ctx, c.getKey(accountID))
+	if err != nil {
+		return nil, err
+	}
+	value, ok := data.(string)
+	if !ok {
+		logger.Warn("skipping invalid follow suggestions in cache")
+		return nil, ErrCacheMiss
+	}
+
+	var entries []FollowSuggestionEntry
+	if err = json.Unmarshal([]byte(value), &entries); err != nil {
+		logger.With(zap.Error(err)).Warn("skipping invalid follow suggestions in cache")
+		return nil, ErrCacheMiss
+	}
+
+	return entries, nil
+}
+
+func (c followSuggestion) Set(ctx context.Context, accountID uint64, entries []FollowSuggestionEntry) error {
+	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.Uint64("account_id", accountID))
+
+	data, err := json.Marshal(entries)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to marshal follow suggestions")
+		return err
+	}
+	if err = c.client.Set(ctx, c.getKey(accountID), string(data), followSuggestionTTL); err != nil {
+		logger.With(zap.Error(err)).Error("failed to set follow suggestions into cache")
+		return err
+	}
+
+	return nil
+}
+
+func (c followSuggestion) AddActive(ctx context.Context, accountIDs ...uint64) error {
+	logger := utils.LoggerWithContext(ctx, c.logger)
+
+	if len(accountIDs) == 0 {
+		return nil
+	}
+	members := make([]any, 0, len(accountIDs))
+	for _, accountID := range accountIDs {
+		members = append(members, accountID)
+	}
+	if err := c.client.AddToSet(ctx, setKeyNameActiveFollowSuggestion, members...); err != nil {
+		logger.With(zap.Error(err)).Error("failed to add active accounts to set in cache")
+		return err
+	}
+
+	return nil
+}
+
+func (c followSuggestion) PopActive(ctx context.Context, count int64) ([]uint64, error) {
+	logger := utils.LoggerWithContext(ctx, c.logger)
+
+	members, err := c.client.PopFromSet(ctx, setKeyNameActiveFollowSuggestion, count)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to pop active accounts from cache")
+		return nil, err
+	}
+
+	accountIDs := make([]uint64, 0, len(members))
+	for _, member := range members {
+		accountID, err := strconv.ParseUint(member, 10, 64)
+		if err != nil {
+			logger.With(zap.String("member", member)).Warn("skipping invalid id in active account set")
+			continue
+		}
+		accountIDs = append(accountIDs, accountID)
+	}
+
+	return accountIDs, nil
+}
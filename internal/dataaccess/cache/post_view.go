@@ -0,0 +1,122 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"go.uber.org/zap"
+
+	"GoFeed/internal/utils"
+)
+
+const (
+	setKeyNamePendingPostView = "pending_post_view_set"
+)
+
+// PostView counts the unique viewers of posts with one HyperLogLog per post, so each post costs at most 12KB no
+// matter how many accounts see it. Posts with new views are tracked in a set until their count is persisted.
+type PostView interface {
+	Add(ctx context.Context, viewerID uint64, postIDs ...uint64) error
+	Count(ctx context.Context, postID uint64) (uint64, error)
+	PopPending(ctx context.Context, count int64) ([]uint64, error)
+	AddPending(ctx context.Context, postIDs ...uint64) error
+	Delete(ctx context.Context, postID uint64) error
+}
+
+type postView struct {
+	client Client
+	logger *zap.Logger
+}
+
+func NewPostView(
+	client Client,
+	logger *zap.Logger,
+) PostView {
+	return &postView{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (c postView) getHyperLogLogKey(postID uint64) string {
+	return fmt.Sprintf("post_viewers:%d", postID)
+}
+
+func (c postView) Add(ctx context.Context, viewerID uint64, postIDs ...uint64) error {
+	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.Uint64("viewer_id", viewerID))
+
+	keys := make([]string, 0, len(postIDs))
+	for _, postID := range postIDs {
+		keys = append(keys, c.getHyperLogLogKey(postID))
+	}
+	if err := c.client.AddToHyperLogLogs(ctx, keys, viewerID); err != nil {
+		logger.With(zap.Error(err)).Error("failed to add viewer of posts in cache")
+		return err
+	}
+
+	return c.AddPending(ctx, postIDs...)
+}
+
+func (c postView) Count(ctx context.Context, postID uint64) (uint64, error) {
+	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.Uint64("post_id", postID))
+
+	count, err := c.client.CountHyperLogLog(ctx, c.getHyperLogLogKey(postID))
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to count viewers of post in cache")
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (c postView) PopPending(ctx context.Context, count int64) ([]uint64, error) {
+	logger := utils.LoggerWithContext(ctx, c.logger)
+
+	members, err := c.client.PopFromSet(ctx, setKeyNamePendingPostView, count)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to pop posts with pending views from cache")
+		return nil, err
+	}
+
+	postIDs := make([]uint64, 0, len(members))
+	for _, member := range members {
+		postID, err := strconv.ParseUint(member, 10, 64)
+		if err != nil {
+			logger.With(zap.String("member", member)).Warn("skipping invalid post id in pending post view set")
+			continue
+		}
+		postIDs = append(postIDs, postID)
+	}
+
+	return postIDs, nil
+}
+
+func (c postView) AddPending(ctx context.Context, postIDs ...uint64) error {
+	logger := utils.LoggerWithContext(ctx, c.logger)
+
+	if len(postIDs) == 0 {
+		return nil
+	}
+	members := make([]any, 0, len(postIDs))
+	for _, postID := range postIDs {
+		members = append(members, postID)
+	}
+	if err := c.client.AddToSet(ctx, setKeyNamePendingPostView, members...); err != nil {
+		logger.With(zap.Error(err)).Error("failed to add posts with pending views to cache")
+		return err
+	}
+
+	return nil
+}
+
+func (c postView) Delete(ctx context.Context, postID uint64) error {
+	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.Uint64("post_id", postID))
+
+	if err := c.client.Delete(ctx, c.getHyperLogLogKey(postID)); err != nil {
+		logger.With(zap.Error(err)).Error("failed to delete viewers of post from cache")
+		return err
+	}
+
+	return nil
+}
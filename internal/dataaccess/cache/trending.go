@@ -0,0 +1,157 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"go.uber.org/zap"
+
+	"GoFeed/internal/utils"
+)
+
+type TrendingKind string
+
+const (
+	TrendingKindHashtag TrendingKind = "hashtag"
+	TrendingKindPost    TrendingKind = "post"
+)
+
+type TrendingWindow string
+
+const (
+	TrendingWindowHour TrendingWindow = "1h"
+	TrendingWindowDay  TrendingWindow = "24h"
+	TrendingWindowWeek TrendingWindow = "7d"
+)
+
+const (
+	// trendingMaxSize is how many members are kept in each trending result set.
+	trendingMaxSize = 1000
+)
+
+// trendingWindowSpec splits a window into buckets of bucketLength. When the trending scores are refreshed the score
+// of each bucket is halved every halfLife, so recent activity weighs more than activity at the start of the window.
+type trendingWindowSpec struct {
+	length       time.Duration
+	bucketLength time.Duration
+	halfLife     time.Duration
+}
+
+var trendingWindowSpecs = map[TrendingWindow]trendingWindowSpec{
+	TrendingWindowHour: {length: time.Hour, bucketLength: 5 * time.Minute, halfLife: 15 * time.Minute},
+	TrendingWindowDay:  {length: 24 * time.Hour, bucketLength: time.Hour, halfLife: 6 * time.Hour},
+	TrendingWindowWeek: {length: 7 * 24 * time.Hour, bucketLength: 6 * time.Hour, halfLife: 48 * time.Hour},
+}
+
+var TrendingWindows = []TrendingWindow{TrendingWindowHour, TrendingWindowDay, TrendingWindowWeek}
+
+// Trending keeps decayed activity scores of hashtags and posts in sorted sets, one per time bucket of each window.
+// An account only counts once per member and window, so repeating an action does not push a member up.
+type Trending interface {
+	Add(ctx context.Context, kind TrendingKind, member string, accountID uint64, at time.Time) error
+	Refresh(ctx context.Context, kind TrendingKind, now time.Time) error
+	GetTop(ctx context.Context, kind TrendingKind, window TrendingWindow, count int64) ([]SortedSetMember, error)
+}
+
+type trending struct {
+	client Client
+	logger *zap.Logger
+}
+
+func NewTrending(
+	client Client,
+	logger *zap.Logger,
+) Trending {
+	return &trending{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (c trending) getBucketKey(kind TrendingKind, window TrendingWindow, bucketStart time.Time) string {
+	return fmt.Sprintf("trending_bucket:%s:%s:%d", kind, window, bucketStart.Unix())
+}
+
+func (c trending) getSeenKey(kind TrendingKind, window TrendingWindow, member string, accountID uint64) string {
+	return fmt.Sprintf("trending_seen:%s:%s:%s:%d", kind, window, member, accountID)
+}
+
+func (c trending) getResultKey(kind TrendingKind, window TrendingWindow) string {
+	return fmt.Sprintf("trending:%s:%s", kind, window)
+}
+
+func (c trending) Add(ctx context.Context, kind TrendingKind, member string, accountID uint64, at time.Time) error {
+	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.String("kind", string(kind))).With(zap.String("member", member))
+
+	for _, window := range TrendingWindows {
+		spec := trendingWindowSpecs[window]
+		if time.Since(at) >= spec.length {
+			continue
+		}
+
+		firstSeen, err := c.client.SetIfNotExists(ctx, c.getSeenKey(kind, window, member, accountID), 1, spec.length)
+		if err != nil {
+			logger.With(zap.Error(err)).Error("failed to deduplicate trending activity in cache")
+			return err
+		}
+		if !firstSeen {
+			continue
+		}
+
+		bucketKey := c.getBucketKey(kind, window, at.Truncate(spec.bucketLength))
+		if err = c.client.IncrementSortedSetScore(ctx, bucketKey, member, 1); err != nil {
+			logger.With(zap.Error(err)).Error("failed to add trending activity to cache")
+			return err
+		}
+		if err = c.client.Expire(ctx, bucketKey, spec.length+spec.bucketLength); err != nil {
+			logger.With(zap.Error(err)).Error("failed to set expiration of trending bucket in cache")
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Refresh recomputes the trending result set of every window from the buckets that are still inside the window.
+func (c trending) Refresh(ctx context.Context, kind TrendingKind, now time.Time) error {
+	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.String("kind", string(kind)))
+
+	for _, window := range TrendingWindows {
+		spec := trendingWindowSpecs[window]
+		bucketCount := int(spec.length / spec.bucketLength)
+		keys := make([]string, 0, bucketCount)
+		weights := make([]float64, 0, bucketCount)
+		currentBucketStart := now.Truncate(spec.bucketLength)
+		for i := 0; i < bucketCount; i++ {
+			bucketStart := currentBucketStart.Add(-time.Duration(i) * spec.bucketLength)
+			keys = append(keys, c.getBucketKey(kind, window, bucketStart))
+			weights = append(weights, math.Pow(0.5, float64(now.Sub(bucketStart))/float64(spec.halfLife)))
+		}
+
+		resultKey := c.getResultKey(kind, window)
+		if err := c.client.UnionSortedSets(ctx, resultKey, keys, weights); err != nil {
+			logger.With(zap.String("window", string(window))).With(zap.Error(err)).Error("failed to refresh trending in cache")
+			return err
+		}
+		if err := c.client.TrimSortedSet(ctx, resultKey, trendingMaxSize); err != nil {
+			logger.With(zap.String("window", string(window))).With(zap.Error(err)).Error("failed to trim trending in cache")
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c trending) GetTop(ctx context.Context, kind TrendingKind, window TrendingWindow, count int64) ([]SortedSetMember, error) {
+	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.String("kind", string(kind))).With(zap.String("window", string(window)))
+
+	members, err := c.client.GetSortedSetTopMembers(ctx, c.getResultKey(kind, window), count)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get trending from cache")
+		return nil, err
+	}
+
+	return members, nil
+}
@@ -17,22 +17,41 @@ var (
 )
 
 const (
-	ColNameAccountsID          = "id"
-	ColNameAccountsAccountName = "account_name"
-	ColNameAccountsHasing      = "hasing"
+	ColNameAccountsID             = "id"
+	ColNameAccountsAccountName    = "account_name"
+	ColNameAccountsHasing         = "hasing"
+	ColNameAccountsFollowerCount  = "follower_count"
+	ColNameAccountsFollowingCount = "following_count"
+	ColNameAccountsLikesHidden    = "likes_hidden"
+	ColNameAccountsIsPrivate      = "is_private"
 )
 
 type Account struct {
 	ID           uint64
 	Account_name string
 	Hashing      string
+	// FollowerCount and FollowingCount are flushed from the counters kept in the cache, they are only written by
+	// UpdateFollowCounts.
+	FollowerCount  uint64 `db:"follower_count"`
+	FollowingCount uint64 `db:"following_count"`
+	// LikesHidden hides the posts the account liked from other accounts.
+	LikesHidden bool `db:"likes_hidden"`
+	// IsPrivate makes following the account need its approval, and hides its posts from accounts that do not follow
+	// it.
+	IsPrivate bool `db:"is_private"`
 }
 
 type AccountDataAccessor interface {
 	CreateAccount(ctx context.Context, account Account) (uint64, error)
 	GetAccountByID(ctx context.Context, id uint64) (Account, error)
+	GetAccountByIDWithXLock(ctx context.Context, id uint64) (Account, error)
 	GetAccountByIDs(ctx context.Context, ids []uint64) ([]Account, error)
 	GetAccountByAccountName(ctx context.Context, account_name string) (Account, error)
+	GetAccountsByAccountNames(ctx context.Context, account_names []string) ([]Account, error)
+	GetMostFollowedAccountIDs(ctx context.Context, limit uint64) ([]uint64, error)
+	UpdateFollowCounts(ctx context.Context, id uint64, follower_count uint64, following_count uint64) error
+	UpdateLikesHidden(ctx context.Context, id uint64, likes_hidden bool) error
+	UpdateIsPrivate(ctx context.Context, id uint64, is_private bool) error
 	WithDatabase(database Database) AccountDataAccessor
 }
 
@@ -93,18 +112,40 @@ func (a accountDataAccessor) GetAccountByID(ctx context.Context, id uint64) (Acc
 	return account, nil
 }
 
+// GetAccountByIDWithXLock locks the account row, it is used to serialize writes that check a per account limit.
+func (a accountDataAccessor) GetAccountByIDWithXLock(ctx context.Context, id uint64) (Account, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger)
+
+	account := Account{}
+	found, err := a.database.
+		From(TabNameAccounts).
+		Where(goqu.C(ColNameAccountsID).Eq(id)).
+		ForUpdate(goqu.Wait).
+		ScanStructContext(ctx, &account)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get account by id")
+		return Account{}, status.Error(codes.Internal, "failed to get account by id")
+	}
+	if !found {
+		logger.Warn("cannot find account by id")
+		return Account{}, ErrAccountNotFound
+	}
+	return account, nil
+}
+
+// GetAccountByIDs returns the accounts of ids that exist, in no particular order.
 func (a accountDataAccessor) GetAccountByIDs(ctx context.Context, ids []uint64) ([]Account, error) {
 	logger := utils.LoggerWithContext(ctx, a.logger)
 
-	var accounts []Account
+	accounts := make([]Account, 0)
 	if len(ids) == 0 {
 		return accounts, nil
 	}
-	_, err := a.database.
+	err := a.database.
 		From(TabNameAccounts).
-		Select(ColNameAccountsID, ColNameAccountsAccountName).
 		Where(goqu.C(ColNameAccountsID).In(ids)).
-		ScanStructContext(ctx, &accounts)
+		ScanStructsContext(ctx, &accounts)
 	if err != nil {
 		logger.With(zap.Error(err)).Error("failed to get accounts by IDs")
 		return nil, status.Error(codes.Internal, "failed to get accounts by IDs")
@@ -150,8 +191,100 @@ func (a accountDataAccessor) GetAccountByAccountName(ctx context.Context, accoun
 	return account, nil
 }
 
+// GetAccountsByAccountNames returns the accounts of account_names that exist, in no particular order.
+func (a accountDataAccessor) GetAccountsByAccountNames(ctx context.Context, account_names []string) ([]Account, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger)
+
+	accounts := make([]Account, 0)
+	if len(account_names) == 0 {
+		return accounts, nil
+	}
+	err := a.database.
+		From(TabNameAccounts).
+		Where(goqu.C(ColNameAccountsAccountName).In(account_names)).
+		ScanStructsContext(ctx, &accounts)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get accounts by account names")
+		return nil, status.Error(codes.Internal, "failed to get accounts by account names")
+	}
+	return accounts, nil
+}
+
+// GetMostFollowedAccountIDs returns the accounts with the most followers according to their follower count column.
+func (a accountDataAccessor) GetMostFollowedAccountIDs(ctx context.Context, limit uint64) ([]uint64, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger)
+
+	accountIDs := make([]uint64, 0)
+	err := a.database.
+		Select(ColNameAccountsID).
+		From(TabNameAccounts).
+		Order(goqu.C(ColNameAccountsFollowerCount).Desc(), goqu.C(ColNameAccountsID).Asc()).
+		Limit(uint(limit)).
+		ScanValsContext(ctx, &accountIDs)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get most followed accounts")
+		return nil, status.Error(codes.Internal, "failed to get most followed accounts")
+	}
+	return accountIDs, nil
+}
+
+func (a accountDataAccessor) UpdateFollowCounts(ctx context.Context, id uint64, follower_count uint64, following_count uint64) error {
+	logger := utils.LoggerWithContext(ctx, a.logger)
+
+	_, err := a.database.
+		Update(TabNameAccounts).
+		Set(goqu.Record{
+			ColNameAccountsFollowerCount:  follower_count,
+			ColNameAccountsFollowingCount: following_count,
+		}).
+		Where(goqu.C(ColNameAccountsID).Eq(id)).
+		Executor().
+		ExecContext(ctx)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to update follow counts of account")
+		return status.Error(codes.Internal, "failed to update follow counts of account")
+	}
+	return nil
+}
+
+func (a accountDataAccessor) UpdateLikesHidden(ctx context.Context, id uint64, likes_hidden bool) error {
+	logger := utils.LoggerWithContext(ctx, a.logger)
+
+	_, err := a.database.
+		Update(TabNameAccounts).
+		Set(goqu.Record{ColNameAccountsLikesHidden: likes_hidden}).
+		Where(goqu.C(ColNameAccountsID).Eq(id)).
+		Executor().
+		ExecContext(ctx)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to update likes hidden of account")
+		return status.Error(codes.Internal, "failed to update likes hidden of account")
+	}
+	return nil
+}
+
+func (a accountDataAccessor) UpdateIsPrivate(ctx context.Context, id uint64, is_private bool) error {
+	logger := utils.LoggerWithContext(ctx, a.logger)
+
+	_, err := a.database.
+		Update(TabNameAccounts).
+		Set(goqu.Record{ColNameAccountsIsPrivate: is_private}).
+		Where(goqu.C(ColNameAccountsID).Eq(id)).
+		Executor().
+		ExecContext(ctx)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to update is private of account")
+		return status.Error(codes.Internal, "failed to update is private of account")
+	}
+	return nil
+}
+
 func (a accountDataAccessor) WithDatabase(database Database) AccountDataAccessor {
 	return &accountDataAccessor{
 		database: database,
+		logger:   a.logger,
 	}
 }
@@ -0,0 +1,197 @@
+package database
+
+import (
+	"GoFeed/internal/utils"
+	"context"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	TabNameAccountBlocks = goqu.T("account_blocks")
+)
+
+const (
+	ColNameAccountBlocksAccountID = "account_id"
+	ColNameAccountBlocksBlockedID = "blocked_id"
+	ColNameAccountBlocksCreatedAt = "created_at"
+)
+
+// Block is AccountID blocking BlockedID. A block works both ways, neither account can see or interact with the posts
+// of the other.
+type Block struct {
+	AccountID uint64    `db:"account_id"`
+	BlockedID uint64    `db:"blocked_id"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+// BlockCursor is the position of the last block of the previous page, the zero value starts from the first page.
+type BlockCursor struct {
+	CreatedAt time.Time
+	BlockedID uint64
+}
+
+type BlockDataAccessor interface {
+	CreateBlock(ctx context.Context, block Block) error
+	GetBlocksOfAccount(ctx context.Context, account_id uint64, before BlockCursor, limit uint64) ([]Block, error)
+	GetAccountIDsBlockedEitherWay(ctx context.Context, account_id uint64, other_ids []uint64) ([]uint64, error)
+	GetBlockedIDsAmong(ctx context.Context, account_id uint64, other_ids []uint64) ([]uint64, error)
+	DeleteBlock(ctx context.Context, account_id uint64, blocked_id uint64) error
+	WithDatabase(database Database) BlockDataAccessor
+}
+
+type blockDataAccessor struct {
+	database Database
+	logger   *zap.Logger
+}
+
+func NewBlockDataAccessor(database *goqu.Database, logger *zap.Logger) BlockDataAccessor {
+	return &blockDataAccessor{
+		database: database,
+		logger:   logger,
+	}
+}
+
+// CreateBlock blocks an account, blocking it twice keeps the first block.
+func (b blockDataAccessor) CreateBlock(ctx context.Context, block Block) error {
+	logger := utils.LoggerWithContext(ctx, b.logger)
+
+	_, err := b.database.
+		Insert(TabNameAccountBlocks).
+		Rows(goqu.Record{
+			ColNameAccountBlocksAccountID: block.AccountID,
+			ColNameAccountBlocksBlockedID: block.BlockedID,
+			ColNameAccountBlocksCreatedAt: block.CreatedAt,
+		}).
+		OnConflict(goqu.DoNothing()).
+		Executor().
+		ExecContext(ctx)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to create block")
+		return status.Error(codes.Internal, "failed to create block")
+	}
+	return nil
+}
+
+// GetBlocksOfAccount returns a page of the accounts blocked by an account, the most recently blocked first, starting
+// before the before cursor.
+func (b blockDataAccessor) GetBlocksOfAccount(ctx context.Context, account_id uint64, before BlockCursor, limit uint64) ([]Block, error) {
+	logger := utils.LoggerWithContext(ctx, b.logger)
+
+	query := b.database.
+		From(TabNameAccountBlocks).
+		Where(goqu.C(ColNameAccountBlocksAccountID).Eq(account_id))
+	if before.BlockedID != 0 {
+		query = query.Where(goqu.Or(
+			goqu.C(ColNameAccountBlocksCreatedAt).Lt(before.CreatedAt),
+			goqu.And(
+				goqu.C(ColNameAccountBlocksCreatedAt).Eq(before.CreatedAt),
+				goqu.C(ColNameAccountBlocksBlockedID).Lt(before.BlockedID),
+			),
+		))
+	}
+
+	blocks := make([]Block, 0)
+	err := query.
+		Order(goqu.C(ColNameAccountBlocksCreatedAt).Desc(), goqu.C(ColNameAccountBlocksBlockedID).Desc()).
+		Limit(uint(limit)).
+		ScanStructsContext(ctx, &blocks)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get blocks of account")
+		return nil, status.Error(codes.Internal, "failed to get blocks of account")
+	}
+	return blocks, nil
+}
+
+// GetAccountIDsBlockedEitherWay returns the accounts of other_ids that account_id blocked or that blocked account_id.
+func (b blockDataAccessor) GetAccountIDsBlockedEitherWay(ctx context.Context, account_id uint64, other_ids []uint64) ([]uint64, error) {
+	logger := utils.LoggerWithContext(ctx, b.logger)
+
+	accountIDs := make([]uint64, 0)
+	if len(other_ids) == 0 {
+		return accountIDs, nil
+	}
+	var blocks []Block
+	err := b.database.
+		From(TabNameAccountBlocks).
+		Where(goqu.Or(
+			goqu.And(
+				goqu.C(ColNameAccountBlocksAccountID).Eq(account_id),
+				goqu.C(ColNameAccountBlocksBlockedID).In(other_ids),
+			),
+			goqu.And(
+				goqu.C(ColNameAccountBlocksAccountID).In(other_ids),
+				goqu.C(ColNameAccountBlocksBlockedID).Eq(account_id),
+			),
+		)).
+		ScanStructsContext(ctx, &blocks)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get accounts blocked either way")
+		return nil, status.Error(codes.Internal, "failed to get accounts blocked either way")
+	}
+	for _, block := range blocks {
+		if block.AccountID == account_id {
+			accountIDs = append(accountIDs, block.BlockedID)
+		} else {
+			accountIDs = append(accountIDs, block.AccountID)
+		}
+	}
+	return accountIDs, nil
+}
+
+// GetBlockedIDsAmong returns the accounts of other_ids that account_id blocked.
+func (b blockDataAccessor) GetBlockedIDsAmong(ctx context.Context, account_id uint64, other_ids []uint64) ([]uint64, error) {
+	logger := utils.LoggerWithContext(ctx, b.logger)
+
+	blockedIDs := make([]uint64, 0)
+	if len(other_ids) == 0 {
+		return blockedIDs, nil
+	}
+	err := b.database.
+		Select(ColNameAccountBlocksBlockedID).
+		From(TabNameAccountBlocks).
+		Where(
+			goqu.C(ColNameAccountBlocksAccountID).Eq(account_id),
+			goqu.C(ColNameAccountBlocksBlockedID).In(other_ids),
+		).
+		ScanValsContext(ctx, &blockedIDs)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get blocked accounts")
+		return nil, status.Error(codes.Internal, "failed to get blocked accounts")
+	}
+	return blockedIDs, nil
+}
+
+func (b blockDataAccessor) DeleteBlock(ctx context.Context, account_id uint64, blocked_id uint64) error {
+	logger := utils.LoggerWithContext(ctx, b.logger)
+
+	_, err := b.database.
+		Delete(TabNameAccountBlocks).
+		Where(
+			goqu.C(ColNameAccountBlocksAccountID).Eq(account_id),
+			goqu.C(ColNameAccountBlocksBlockedID).Eq(blocked_id),
+		).
+		Executor().
+		ExecContext(ctx)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to delete block")
+		return status.Error(codes.Internal, "failed to delete block")
+	}
+	return nil
+}
+
+func (b blockDataAccessor) WithDatabase(database Database) BlockDataAccessor {
+	return &blockDataAccessor{
+		database: database,
+		logger:   b.logger,
+	}
+}
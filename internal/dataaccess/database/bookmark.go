@@ -0,0 +1,177 @@
+package database
+
+import (
+	"GoFeed/internal/utils"
+	"context"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	TabNameBookmarks = goqu.T("bookmarks")
+)
+
+const (
+	ColNameBookmarksID        = "id"
+	ColNameBookmarksAccountID = "account_id"
+	ColNameBookmarksPostID    = "post_id"
+	ColNameBookmarksCreatedAt = "created_at"
+)
+
+type Bookmark struct {
+	ID        uint64    `db:"id"`
+	AccountID uint64    `db:"account_id"`
+	PostID    uint64    `db:"post_id"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+type BookmarkDataAccessor interface {
+	CreateBookmark(ctx context.Context, bookmark Bookmark) error
+	GetBookmarksOfAccount(ctx context.Context, account_id uint64, before_id uint64, limit uint64) ([]Bookmark, error)
+	GetBookmarkedPostIDs(ctx context.Context, account_id uint64, post_ids []uint64) ([]uint64, error)
+	DeleteBookmark(ctx context.Context, account_id uint64, post_id uint64) error
+	DeleteBookmarksOfPost(ctx context.Context, post_id uint64) error
+	WithDatabase(database Database) BookmarkDataAccessor
+}
+
+type bookmarkDataAccessor struct {
+	database Database
+	logger   *zap.Logger
+}
+
+func NewBookmarkDataAccessor(database *goqu.Database, logger *zap.Logger) BookmarkDataAccessor {
+	return &bookmarkDataAccessor{
+		database: database,
+		logger:   logger,
+	}
+}
+
+// CreateBookmark saves a bookmark, saving a post the account already bookmarked has no effect.
+func (b bookmarkDataAccessor) CreateBookmark(ctx context.Context, bookmark Bookmark) error {
+	logger := utils.LoggerWithContext(ctx, b.logger)
+
+	_, err := b.database.
+		Insert(TabNameBookmarks).
+		Rows(goqu.Record{
+			ColNameBookmarksID:        bookmark.ID,
+			ColNameBookmarksAccountID: bookmark.AccountID,
+			ColNameBookmarksPostID:    bookmark.PostID,
+			ColNameBookmarksCreatedAt: bookmark.CreatedAt,
+		}).
+		OnConflict(goqu.DoNothing()).
+		Executor().
+		ExecContext(ctx)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to create bookmark")
+		return status.Error(codes.Internal, "failed to create bookmark")
+	}
+	return nil
+}
+
+// GetBookmarksOfAccount returns the bookmarks of an account older than before_id, the newest first. Bookmarks of
+// posts that are no longer published are skipped, a before_id of 0 starts from the newest bookmark.
+func (b bookmarkDataAccessor) GetBookmarksOfAccount(ctx context.Context, account_id uint64, before_id uint64, limit uint64) ([]Bookmark, error) {
+	logger := utils.LoggerWithContext(ctx, b.logger)
+
+	query := b.database.
+		From(TabNameBookmarks).
+		Select(
+			TabNameBookmarks.Col(ColNameBookmarksID),
+			TabNameBookmarks.Col(ColNameBookmarksAccountID),
+			TabNameBookmarks.Col(ColNameBookmarksPostID),
+			TabNameBookmarks.Col(ColNameBookmarksCreatedAt),
+		).
+		InnerJoin(TabNamePosts, goqu.On(TabNamePosts.Col(ColNamePostsID).Eq(TabNameBookmarks.Col(ColNameBookmarksPostID)))).
+		Where(
+			TabNameBookmarks.Col(ColNameBookmarksAccountID).Eq(account_id),
+			TabNamePosts.Col(ColNamePostsStatus).Eq(PostStatusPublished),
+			TabNamePosts.Col(ColNamePostsRemovedAt).IsNull(),
+			TabNamePosts.Col(ColNamePostsDeletedAt).IsNull(),
+		)
+	if before_id != 0 {
+		query = query.Where(TabNameBookmarks.Col(ColNameBookmarksID).Lt(before_id))
+	}
+
+	var bookmarks []Bookmark
+	err := query.
+		Order(TabNameBookmarks.Col(ColNameBookmarksID).Desc()).
+		Limit(uint(limit)).
+		ScanStructsContext(ctx, &bookmarks)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get bookmarks of account")
+		return nil, status.Error(codes.Internal, "failed to get bookmarks of account")
+	}
+	return bookmarks, nil
+}
+
+// GetBookmarkedPostIDs returns which of post_ids the account has bookmarked.
+func (b bookmarkDataAccessor) GetBookmarkedPostIDs(ctx context.Context, account_id uint64, post_ids []uint64) ([]uint64, error) {
+	logger := utils.LoggerWithContext(ctx, b.logger)
+
+	var postIDs []uint64
+	if len(post_ids) == 0 {
+		return postIDs, nil
+	}
+	err := b.database.
+		From(TabNameBookmarks).
+		Select(ColNameBookmarksPostID).
+		Where(
+			goqu.C(ColNameBookmarksAccountID).Eq(account_id),
+			goqu.C(ColNameBookmarksPostID).In(post_ids),
+		).
+		ScanValsContext(ctx, &postIDs)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get bookmarked posts")
+		return nil, status.Error(codes.Internal, "failed to get bookmarked posts")
+	}
+	return postIDs, nil
+}
+
+func (b bookmarkDataAccessor) DeleteBookmark(ctx context.Context, account_id uint64, post_id uint64) error {
+	logger := utils.LoggerWithContext(ctx, b.logger)
+
+	_, err := b.database.
+		Delete(TabNameBookmarks).
+		Where(
+			goqu.C(ColNameBookmarksAccountID).Eq(account_id),
+			goqu.C(ColNameBookmarksPostID).Eq(post_id),
+		).
+		Executor().
+		ExecContext(ctx)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to delete bookmark")
+		return status.Error(codes.Internal, "failed to delete bookmark")
+	}
+	return nil
+}
+
+func (b bookmarkDataAccessor) DeleteBookmarksOfPost(ctx context.Context, post_id uint64) error {
+	logger := utils.LoggerWithContext(ctx, b.logger)
+
+	_, err := b.database.
+		Delete(TabNameBookmarks).
+		Where(goqu.C(ColNameBookmarksPostID).Eq(post_id)).
+		Executor().
+		ExecContext(ctx)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to delete bookmarks of post")
+		return status.Error(codes.Internal, "failed to delete bookmarks of post")
+	}
+	return nil
+}
+
+func (b bookmarkDataAccessor) WithDatabase(database Database) BookmarkDataAccessor {
+	return &bookmarkDataAccessor{
+		database: database,
+		logger:   b.logger,
+	}
+}
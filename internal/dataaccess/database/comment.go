@@ -3,38 +3,95 @@ package database
 import (
 	"GoFeed/internal/utils"
 	"context"
+	"database/sql"
 	"time"
 
 	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 var (
 	TabNameComments = goqu.T("comments")
+
+	ErrCommentNotFound = status.Error(codes.NotFound, "comment not found")
 )
 
 const (
-	ColNameCommentsID        = "id"
-	ColNameCommentsAccountID = "account_id"
-	ColNameCommentsPostID    = "post_id"
-	ColNameCommentsContent   = "content"
-	ColNameCommentsCreatedAt = "created_at"
+	ColNameCommentsID            = "id"
+	ColNameCommentsAccountID     = "account_id"
+	ColNameCommentsPostID        = "post_id"
+	ColNameCommentsContent       = "content"
+	ColNameCommentsContentHTML   = "content_html"
+	ColNameCommentsCreatedAt     = "created_at"
+	ColNameCommentsRemovedAt     = "removed_at"
+	ColNameCommentsRemovedReason = "removed_reason"
+	ColNameCommentsDeletedAt     = "deleted_at"
+	ColNameCommentsParentID      = "parent_comment_id"
+	ColNameCommentsDepth         = "depth"
+	ColNameCommentsTombstonedAt  = "tombstoned_at"
+	ColNameCommentsLikeCount     = "like_count"
+	ColNameCommentsHiddenAt      = "hidden_at"
 )
 
-type Comment struct {
+type CommentSort string
+
+const (
+	CommentSortOldest CommentSort = "oldest"
+	CommentSortNewest CommentSort = "newest"
+	CommentSortTop    CommentSort = "top"
+)
+
+// CommentCursor is the position of the last comment of the previous page. LikeCount is only used by the top sort,
+// the zero value starts from the first page.
+type CommentCursor struct {
 	ID        uint64
-	AccountID uint64
-	PostID    uint64
-	Content   string
-	CreatedAt time.Time
+	LikeCount uint64
+}
+
+type Comment struct {
+	ID          uint64    `db:"id"`
+	AccountID   uint64    `db:"account_id"`
+	PostID      uint64    `db:"post_id"`
+	Content     string    `db:"content"`
+	ContentHTML string    `db:"content_html"`
+	CreatedAt   time.Time `db:"created_at" goqu:"skipupdate"`
+	// RemovedAt is set when moderation takes the comment down, the row is kept for audit but
+	// never returned by reads.
+	RemovedAt     sql.NullTime `db:"removed_at"`
+	RemovedReason string       `db:"removed_reason"`
+	// DeletedAt is set when the author deletes the comment, the purge job hard deletes it after the retention period.
+	DeletedAt sql.NullTime `db:"deleted_at"`
+	// ParentCommentID is null for top level comments, Depth is 0 for them and one more than the parent for replies.
+	ParentCommentID sql.NullInt64 `db:"parent_comment_id" goqu:"skipupdate"`
+	Depth           uint64        `db:"depth" goqu:"skipupdate"`
+	// TombstonedAt is set instead of DeletedAt when the author deletes a comment that has replies, so the replies
+	// keep their place in the thread. The content is kept for reports and moderation, it is masked when read.
+	TombstonedAt sql.NullTime `db:"tombstoned_at"`
+	LikeCount    uint64       `db:"like_count" goqu:"skipupdate"`
+	// HiddenAt is set when the author of the post hides the comment, it is then only shown to the author of the post
+	// and to the author of the comment.
+	HiddenAt sql.NullTime `db:"hidden_at"`
+}
+
+type CommentReplyCount struct {
+	ParentCommentID uint64 `db:"parent_comment_id"`
+	ReplyCount      uint64 `db:"reply_count"`
 }
 
 type CommentDataAccessor interface {
 	CreateComment(ctx context.Context, comment Comment) (uint64, error)
 	GetCommentCountOfPost(ctx context.Context, post_id uint64) (int, error)
-	GetCommentsOfPost(ctx context.Context, post_id uint64) ([]Comment, error)
+	GetCommentsOfPost(ctx context.Context, post_id uint64, viewer_id uint64, include_hidden bool, top_level_only bool, sort CommentSort, after CommentCursor, limit uint64) ([]Comment, error)
+	GetRepliesOfComment(ctx context.Context, parent_comment_id uint64, viewer_id uint64, include_hidden bool, after_id uint64, limit uint64) ([]Comment, error)
+	GetReplyCountsOfComments(ctx context.Context, comment_ids []uint64) (map[uint64]uint64, error)
+	GetCommentByID(ctx context.Context, id uint64) (Comment, error)
 	GetCommentByIdWithXLock(ctx context.Context, id uint64) (Comment, error)
+	GetPurgeableCommentIDs(ctx context.Context, deleted_before time.Time, limit uint64) ([]uint64, error)
 	UpdateComment(ctx context.Context, comment Comment) error
+	IncrementLikeCount(ctx context.Context, id uint64, delta int64) error
 	DeleteComment(ctx context.Context, id uint64) error
 	DeleteCommentOfPost(ctx context.Context, post_id uint64) error
 	WithDatabase(database Database) CommentDataAccessor
@@ -58,10 +115,13 @@ func (c commentDataAccessor) CreateComment(ctx context.Context, comment Comment)
 	_, err := c.database.
 		Insert(TabNameComments).
 		Rows(goqu.Record{
-			ColNameCommentsID:        comment.ID,
-			ColNameCommentsAccountID: comment.AccountID,
-			ColNameCommentsPostID:    comment.PostID,
-			ColNameCommentsContent:   comment.Content,
+			ColNameCommentsID:          comment.ID,
+			ColNameCommentsAccountID:   comment.AccountID,
+			ColNameCommentsPostID:      comment.PostID,
+			ColNameCommentsContent:     comment.Content,
+			ColNameCommentsContentHTML: comment.ContentHTML,
+			ColNameCommentsParentID:    comment.ParentCommentID,
+			ColNameCommentsDepth:       comment.Depth,
 			// ColNameCommentsCreatedAt: comment.CreatedAt,
 		}).Executor().Exec()
 
@@ -75,54 +135,245 @@ func (c commentDataAccessor) CreateComment(ctx context.Context, comment Comment)
 func (c commentDataAccessor) GetCommentCountOfPost(ctx context.Context, post_id uint64) (int, error) {
 	logger := utils.LoggerWithContext(ctx, c.logger)
 
-	var comments []uint64
-	err := c.database.
-		Select(ColNameCommentsPostID).
+	var commentCount int
+	_, err := c.database.
+		Select(goqu.COUNT(goqu.Star())).
 		From(TabNameComments).
-		Where(goqu.C(ColNameCommentsPostID).Eq(post_id)).
-		ScanValsContext(ctx, &comments)
+		Where(
+			goqu.C(ColNameCommentsPostID).Eq(post_id),
+			goqu.C(ColNameCommentsRemovedAt).IsNull(),
+			goqu.C(ColNameCommentsDeletedAt).IsNull(),
+			goqu.C(ColNameCommentsTombstonedAt).IsNull(),
+			goqu.C(ColNameCommentsHiddenAt).IsNull(),
+		).
+		ScanValContext(ctx, &commentCount)
 
 	if err != nil {
 		logger.With(zap.Error(err)).Error("failed to get comment count of post")
-		return 0, err
+		return 0, status.Error(codes.Internal, "failed to get comment count of post")
 	}
-	return len(comments), nil
+	return commentCount, nil
+}
+
+// notHiddenFromViewer keeps hidden comments out of the results, except for the ones written by viewer_id.
+func notHiddenFromViewer(viewer_id uint64) exp.Expression {
+	return goqu.Or(
+		goqu.C(ColNameCommentsHiddenAt).IsNull(),
+		goqu.C(ColNameCommentsAccountID).Eq(viewer_id),
+	)
 }
 
-func (c commentDataAccessor) GetCommentsOfPost(ctx context.Context, post_id uint64) ([]Comment, error) {
+// notBlockedWithViewer keeps the comments of the accounts viewer_id blocked, or that blocked viewer_id, out of the
+// results.
+func (c commentDataAccessor) notBlockedWithViewer(viewer_id uint64) exp.Expression {
+	return goqu.And(
+		TabNameComments.Col(ColNameCommentsAccountID).NotIn(
+			c.database.
+				Select(ColNameAccountBlocksBlockedID).
+				From(TabNameAccountBlocks).
+				Where(goqu.C(ColNameAccountBlocksAccountID).Eq(viewer_id)),
+		),
+		TabNameComments.Col(ColNameCommentsAccountID).NotIn(
+			c.database.
+				Select(ColNameAccountBlocksAccountID).
+				From(TabNameAccountBlocks).
+				Where(goqu.C(ColNameAccountBlocksBlockedID).Eq(viewer_id)),
+		),
+	)
+}
+
+// GetCommentsOfPost returns a page of the comments of a post in sort order, starting after the after cursor. Comments
+// of accounts blocked either way with viewer_id are left out.
+func (c commentDataAccessor) GetCommentsOfPost(ctx context.Context, post_id uint64, viewer_id uint64, include_hidden bool, top_level_only bool, sort CommentSort, after CommentCursor, limit uint64) ([]Comment, error) {
 	logger := utils.LoggerWithContext(ctx, c.logger)
 
+	query := c.database.
+		From(TabNameComments).
+		Where(
+			goqu.C(ColNameCommentsPostID).Eq(post_id),
+			goqu.C(ColNameCommentsRemovedAt).IsNull(),
+			goqu.C(ColNameCommentsDeletedAt).IsNull(),
+			c.notBlockedWithViewer(viewer_id),
+		)
+	if !include_hidden {
+		query = query.Where(notHiddenFromViewer(viewer_id))
+	}
+	if top_level_only {
+		query = query.Where(goqu.C(ColNameCommentsParentID).IsNull())
+	}
+
+	switch sort {
+	case CommentSortNewest:
+		if after.ID != 0 {
+			query = query.Where(goqu.C(ColNameCommentsID).Lt(after.ID))
+		}
+		query = query.Order(goqu.C(ColNameCommentsID).Desc())
+	case CommentSortTop:
+		if after.ID != 0 {
+			query = query.Where(goqu.Or(
+				goqu.C(ColNameCommentsLikeCount).Lt(after.LikeCount),
+				goqu.And(
+					goqu.C(ColNameCommentsLikeCount).Eq(after.LikeCount),
+					goqu.C(ColNameCommentsID).Lt(after.ID),
+				),
+			))
+		}
+		query = query.Order(goqu.C(ColNameCommentsLikeCount).Desc(), goqu.C(ColNameCommentsID).Desc())
+	default:
+		if after.ID != 0 {
+			query = query.Where(goqu.C(ColNameCommentsID).Gt(after.ID))
+		}
+		query = query.Order(goqu.C(ColNameCommentsID).Asc())
+	}
+
 	var comments []Comment
-	err := c.database.
-		// Select(ColNameCommentsPostID).
+	err := query.
+		Limit(uint(limit)).
+		ScanStructsContext(ctx, &comments)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get comments of post")
+		return nil, status.Error(codes.Internal, "failed to get comments of post")
+	}
+	return comments, nil
+}
+
+// GetRepliesOfComment returns the direct replies of a comment from the oldest, starting after after_id when it is
+// not 0. Replies of accounts blocked either way with viewer_id are left out.
+func (c commentDataAccessor) GetRepliesOfComment(ctx context.Context, parent_comment_id uint64, viewer_id uint64, include_hidden bool, after_id uint64, limit uint64) ([]Comment, error) {
+	logger := utils.LoggerWithContext(ctx, c.logger)
+
+	query := c.database.
 		From(TabNameComments).
-		Where(goqu.C(ColNameCommentsPostID).Eq(post_id)).
-		ScanValsContext(ctx, &comments)
+		Where(
+			goqu.C(ColNameCommentsParentID).Eq(parent_comment_id),
+			goqu.C(ColNameCommentsRemovedAt).IsNull(),
+			goqu.C(ColNameCommentsDeletedAt).IsNull(),
+			c.notBlockedWithViewer(viewer_id),
+		)
+	if !include_hidden {
+		query = query.Where(notHiddenFromViewer(viewer_id))
+	}
+	if after_id != 0 {
+		query = query.Where(goqu.C(ColNameCommentsID).Gt(after_id))
+	}
+
+	var comments []Comment
+	err := query.
+		Order(goqu.C(ColNameCommentsID).Asc()).
+		Limit(uint(limit)).
+		ScanStructsContext(ctx, &comments)
 
 	if err != nil {
-		logger.With(zap.Error(err)).Error("failed to get comment count of post")
-		return nil, err
+		logger.With(zap.Error(err)).Error("failed to get replies of comment")
+		return nil, status.Error(codes.Internal, "failed to get replies of comment")
 	}
 	return comments, nil
 }
 
+// GetReplyCountsOfComments counts the direct replies of each comment, including hidden ones so that a comment with
+// only hidden replies is still tombstoned when deleted. Comments without replies are left out.
+func (c commentDataAccessor) GetReplyCountsOfComments(ctx context.Context, comment_ids []uint64) (map[uint64]uint64, error) {
+	logger := utils.LoggerWithContext(ctx, c.logger)
+
+	replyCounts := make(map[uint64]uint64)
+	if len(comment_ids) == 0 {
+		return replyCounts, nil
+	}
+
+	var replyCountList []CommentReplyCount
+	err := c.database.
+		From(TabNameComments).
+		Select(
+			goqu.C(ColNameCommentsParentID),
+			goqu.COUNT(goqu.Star()).As("reply_count"),
+		).
+		Where(
+			goqu.C(ColNameCommentsParentID).In(comment_ids),
+			goqu.C(ColNameCommentsRemovedAt).IsNull(),
+			goqu.C(ColNameCommentsDeletedAt).IsNull(),
+		).
+		GroupBy(goqu.C(ColNameCommentsParentID)).
+		ScanStructsContext(ctx, &replyCountList)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get reply counts of comments")
+		return nil, status.Error(codes.Internal, "failed to get reply counts of comments")
+	}
+	for _, replyCount := range replyCountList {
+		replyCounts[replyCount.ParentCommentID] = replyCount.ReplyCount
+	}
+	return replyCounts, nil
+}
+
+func (c commentDataAccessor) GetCommentByID(ctx context.Context, id uint64) (Comment, error) {
+	logger := utils.LoggerWithContext(ctx, c.logger)
+
+	var comment Comment
+	found, err := c.database.
+		From(TabNameComments).
+		Where(
+			goqu.C(ColNameCommentsID).Eq(id),
+			goqu.C(ColNameCommentsRemovedAt).IsNull(),
+			goqu.C(ColNameCommentsDeletedAt).IsNull(),
+		).
+		ScanStructContext(ctx, &comment)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get comment by id")
+		return Comment{}, status.Error(codes.Internal, "failed to get comment by id")
+	}
+	if !found {
+		logger.Warn("cannot find comment by id")
+		return Comment{}, ErrCommentNotFound
+	}
+	return comment, nil
+}
+
 func (c commentDataAccessor) GetCommentByIdWithXLock(ctx context.Context, id uint64) (Comment, error) {
 	logger := utils.LoggerWithContext(ctx, c.logger)
 
 	var comment Comment
-	err := c.database.
+	found, err := c.database.
 		From(TabNameComments).
-		Where(goqu.C(ColNameCommentsID).Eq(id)).
+		Where(
+			goqu.C(ColNameCommentsID).Eq(id),
+			goqu.C(ColNameCommentsRemovedAt).IsNull(),
+			goqu.C(ColNameCommentsDeletedAt).IsNull(),
+		).
 		ForUpdate(goqu.Wait).
-		ScanStructsContext(ctx, &comment)
+		ScanStructContext(ctx, &comment)
 
 	if err != nil {
 		logger.With(zap.Error(err)).Error("failed to get comment by id with Xlock")
 		return Comment{}, err
 	}
+	if !found {
+		logger.Warn("cannot find comment by id")
+		return Comment{}, ErrCommentNotFound
+	}
 	return comment, nil
 }
 
+func (c commentDataAccessor) GetPurgeableCommentIDs(ctx context.Context, deleted_before time.Time, limit uint64) ([]uint64, error) {
+	logger := utils.LoggerWithContext(ctx, c.logger)
+
+	var commentIDs []uint64
+	err := c.database.
+		From(TabNameComments).
+		Select(ColNameCommentsID).
+		Where(goqu.C(ColNameCommentsDeletedAt).Lt(deleted_before)).
+		Order(goqu.C(ColNameCommentsDeletedAt).Asc()).
+		Limit(uint(limit)).
+		ScanValsContext(ctx, &commentIDs)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get purgeable comments")
+		return nil, status.Error(codes.Internal, "failed to get purgeable comments")
+	}
+	return commentIDs, nil
+}
+
 func (c commentDataAccessor) UpdateComment(ctx context.Context, comment Comment) error {
 	logger := utils.LoggerWithContext(ctx, c.logger)
 
@@ -139,6 +390,26 @@ func (c commentDataAccessor) UpdateComment(ctx context.Context, comment Comment)
 	return nil
 }
 
+// IncrementLikeCount adds delta to the like count of a comment, which the top sort orders by.
+func (c commentDataAccessor) IncrementLikeCount(ctx context.Context, id uint64, delta int64) error {
+	logger := utils.LoggerWithContext(ctx, c.logger)
+
+	_, err := c.database.
+		Update(TabNameComments).
+		Set(goqu.Record{
+			ColNameCommentsLikeCount: goqu.L("? + ?", goqu.C(ColNameCommentsLikeCount), delta),
+		}).
+		Where(goqu.C(ColNameCommentsID).Eq(id)).
+		Executor().
+		ExecContext(ctx)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to update like count of comment")
+		return status.Error(codes.Internal, "failed to update like count of comment")
+	}
+	return nil
+}
+
 func (c commentDataAccessor) DeleteComment(ctx context.Context, id uint64) error {
 	logger := utils.LoggerWithContext(ctx, c.logger)
 
@@ -172,5 +443,6 @@ func (c commentDataAccessor) DeleteCommentOfPost(ctx context.Context, post_id ui
 func (c commentDataAccessor) WithDatabase(database Database) CommentDataAccessor {
 	return &commentDataAccessor{
 		database: database,
+		logger:   c.logger,
 	}
 }
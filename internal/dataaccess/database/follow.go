@@ -3,6 +3,7 @@ package database
 import (
 	"GoFeed/internal/utils"
 	"context"
+	"time"
 
 	"github.com/doug-martin/goqu/v9"
 	"go.uber.org/zap"
@@ -17,20 +18,39 @@ var (
 const (
 	ColNameFollowsAccountID   = "account_id"
 	ColNameFollowsFollowingID = "following_id"
+	ColNameFollowsCreatedAt   = "created_at"
 )
 
 type Follow struct {
-	AccountID   uint64
-	FollowingID uint64
+	AccountID   uint64    `db:"account_id"`
+	FollowingID uint64    `db:"following_id"`
+	CreatedAt   time.Time `db:"created_at"`
+}
+
+// FollowCursor is the position of the last follow of the previous page, the zero value starts from the first page.
+// AccountID is the follower in a list of followers and the followed account in a list of followings.
+type FollowCursor struct {
+	CreatedAt time.Time
+	AccountID uint64
+}
+
+// FollowSuggestion is an account followed by MutualCount of the accounts someone follows.
+type FollowSuggestion struct {
+	AccountID   uint64 `db:"account_id"`
+	MutualCount uint64 `db:"mutual_count"`
 }
 
 type FollowDataAccessor interface {
-	CreateFollow(ctx context.Context, follow Follow) error
+	CreateFollow(ctx context.Context, follow Follow) (bool, error)
 	GetFollowerCountOfAccount(ctx context.Context, account_id uint64) (int, error)
-	GetFollowersOfAccount(ctx context.Context, account_id uint64) ([]uint64, error)
+	GetFollowersOfAccount(ctx context.Context, account_id uint64, before FollowCursor, limit uint64) ([]Follow, error)
 	GetFollowingCountOfAccount(ctx context.Context, account_id uint64) (int, error)
-	GetFollowingsOfAccount(ctx context.Context, account_id uint64) ([]uint64, error)
-	DeleteFollow(ctx context.Context, follow Follow) error
+	GetFollowingsOfAccount(ctx context.Context, account_id uint64, before FollowCursor, limit uint64) ([]Follow, error)
+	IsFollowing(ctx context.Context, account_id uint64, following_id uint64) (bool, error)
+	GetFollowingIDsAmong(ctx context.Context, account_id uint64, other_ids []uint64) ([]uint64, error)
+	GetFollowerIDsAmong(ctx context.Context, account_id uint64, other_ids []uint64) ([]uint64, error)
+	GetFollowSuggestions(ctx context.Context, account_id uint64, limit uint64) ([]FollowSuggestion, error)
+	DeleteFollow(ctx context.Context, follow Follow) (bool, error)
 	WithDatabase(database Database) FollowDataAccessor
 }
 
@@ -46,50 +66,73 @@ func NewFollowDataAccessor(database *goqu.Database, logger *zap.Logger) FollowDa
 	}
 }
 
-func (f followDataAccessor) CreateFollow(ctx context.Context, follow Follow) error {
+// CreateFollow follows an account and reports whether the follow is new, following an account twice has no effect.
+func (f followDataAccessor) CreateFollow(ctx context.Context, follow Follow) (bool, error) {
 	logger := utils.LoggerWithContext(ctx, f.logger)
 
-	_, err := f.database.
+	result, err := f.database.
 		Insert(TabNameFollows).
 		Rows(goqu.Record{
 			ColNameFollowsAccountID:   follow.AccountID,
 			ColNameFollowsFollowingID: follow.FollowingID,
+			ColNameFollowsCreatedAt:   follow.CreatedAt,
 		}).
-		Executor().Exec()
+		OnConflict(goqu.DoNothing()).
+		Executor().
+		ExecContext(ctx)
 
 	if err != nil {
 		logger.With(zap.Error(err)).Error("failed to create follow")
-		return status.Error(codes.Internal, "failed to create follow")
+		return false, status.Error(codes.Internal, "failed to create follow")
 	}
-	return nil
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to create follow")
+		return false, status.Error(codes.Internal, "failed to create follow")
+	}
+	return rowsAffected > 0, nil
 }
 
 func (f followDataAccessor) GetFollowerCountOfAccount(ctx context.Context, account_id uint64) (int, error) {
 	logger := utils.LoggerWithContext(ctx, f.logger)
 
-	var followers []uint64
-	err := f.database.
-		Select(ColNameFollowsAccountID).
+	var followerCount int
+	_, err := f.database.
+		Select(goqu.COUNT(goqu.Star())).
 		From(TabNameFollows).
 		Where(goqu.C(ColNameFollowsFollowingID).Eq(account_id)).
-		ScanValsContext(ctx, &followers)
+		ScanValContext(ctx, &followerCount)
 
 	if err != nil {
-		logger.With(zap.Error(err)).Error("failed to get followers of account")
-		return 0, status.Error(codes.Internal, "failed to get followers of account")
+		logger.With(zap.Error(err)).Error("failed to count followers of account")
+		return 0, status.Error(codes.Internal, "failed to count followers of account")
 	}
-	return len(followers), nil
+	return followerCount, nil
 }
 
-func (f followDataAccessor) GetFollowersOfAccount(ctx context.Context, account_id uint64) ([]uint64, error) {
+// GetFollowersOfAccount returns a page of the followers of an account, the most recent first, starting before the
+// before cursor.
+func (f followDataAccessor) GetFollowersOfAccount(ctx context.Context, account_id uint64, before FollowCursor, limit uint64) ([]Follow, error) {
 	logger := utils.LoggerWithContext(ctx, f.logger)
 
-	var followers []uint64
-	err := f.database.
-		Select(ColNameFollowsAccountID).
+	query := f.database.
 		From(TabNameFollows).
-		Where(goqu.C(ColNameFollowsFollowingID).Eq(account_id)).
-		ScanValsContext(ctx, &followers)
+		Where(goqu.C(ColNameFollowsFollowingID).Eq(account_id))
+	if before.AccountID != 0 {
+		query = query.Where(goqu.Or(
+			goqu.C(ColNameFollowsCreatedAt).Lt(before.CreatedAt),
+			goqu.And(
+				goqu.C(ColNameFollowsCreatedAt).Eq(before.CreatedAt),
+				goqu.C(ColNameFollowsAccountID).Lt(before.AccountID),
+			),
+		))
+	}
+
+	followers := make([]Follow, 0)
+	err := query.
+		Order(goqu.C(ColNameFollowsCreatedAt).Desc(), goqu.C(ColNameFollowsAccountID).Desc()).
+		Limit(uint(limit)).
+		ScanStructsContext(ctx, &followers)
 
 	if err != nil {
 		logger.With(zap.Error(err)).Error("failed to get followers of account")
@@ -101,56 +144,187 @@ func (f followDataAccessor) GetFollowersOfAccount(ctx context.Context, account_i
 func (f followDataAccessor) GetFollowingCountOfAccount(ctx context.Context, account_id uint64) (int, error) {
 	logger := utils.LoggerWithContext(ctx, f.logger)
 
-	var followings []uint64
-	err := f.database.
-		Select(ColNameFollowsFollowingID).
+	var followingCount int
+	_, err := f.database.
+		Select(goqu.COUNT(goqu.Star())).
 		From(TabNameFollows).
 		Where(goqu.C(ColNameFollowsAccountID).Eq(account_id)).
-		ScanValsContext(ctx, &followings)
+		ScanValContext(ctx, &followingCount)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to count followings of account")
+		return 0, status.Error(codes.Internal, "failed to count followings of account")
+	}
+	return followingCount, nil
+}
+
+// GetFollowingsOfAccount returns a page of the accounts an account follows, the most recently followed first,
+// starting before the before cursor.
+func (f followDataAccessor) GetFollowingsOfAccount(ctx context.Context, account_id uint64, before FollowCursor, limit uint64) ([]Follow, error) {
+	logger := utils.LoggerWithContext(ctx, f.logger)
+
+	query := f.database.
+		From(TabNameFollows).
+		Where(goqu.C(ColNameFollowsAccountID).Eq(account_id))
+	if before.AccountID != 0 {
+		query = query.Where(goqu.Or(
+			goqu.C(ColNameFollowsCreatedAt).Lt(before.CreatedAt),
+			goqu.And(
+				goqu.C(ColNameFollowsCreatedAt).Eq(before.CreatedAt),
+				goqu.C(ColNameFollowsFollowingID).Lt(before.AccountID),
+			),
+		))
+	}
+
+	followings := make([]Follow, 0)
+	err := query.
+		Order(goqu.C(ColNameFollowsCreatedAt).Desc(), goqu.C(ColNameFollowsFollowingID).Desc()).
+		Limit(uint(limit)).
+		ScanStructsContext(ctx, &followings)
 
 	if err != nil {
 		logger.With(zap.Error(err)).Error("failed to get followers of account")
-		return 0, status.Error(codes.Internal, "failed to get followers of account")
+		return nil, status.Error(codes.Internal, "failed to get followers of account")
 	}
-	return len(followings), nil
+	return followings, nil
 }
 
-func (f followDataAccessor) GetFollowingsOfAccount(ctx context.Context, account_id uint64) ([]uint64, error) {
+func (f followDataAccessor) IsFollowing(ctx context.Context, account_id uint64, following_id uint64) (bool, error) {
 	logger := utils.LoggerWithContext(ctx, f.logger)
 
-	var followings []uint64
+	var followingID uint64
+	found, err := f.database.
+		Select(ColNameFollowsFollowingID).
+		From(TabNameFollows).
+		Where(
+			goqu.C(ColNameFollowsAccountID).Eq(account_id),
+			goqu.C(ColNameFollowsFollowingID).Eq(following_id),
+		).
+		ScanValContext(ctx, &followingID)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to check follow")
+		return false, status.Error(codes.Internal, "failed to check follow")
+	}
+	return found, nil
+}
+
+// GetFollowingIDsAmong returns the accounts of other_ids that account_id follows.
+func (f followDataAccessor) GetFollowingIDsAmong(ctx context.Context, account_id uint64, other_ids []uint64) ([]uint64, error) {
+	logger := utils.LoggerWithContext(ctx, f.logger)
+
+	followings := make([]uint64, 0)
+	if len(other_ids) == 0 {
+		return followings, nil
+	}
 	err := f.database.
 		Select(ColNameFollowsFollowingID).
 		From(TabNameFollows).
-		Where(goqu.C(ColNameFollowsAccountID).Eq(account_id)).
+		Where(
+			goqu.C(ColNameFollowsAccountID).Eq(account_id),
+			goqu.C(ColNameFollowsFollowingID).In(other_ids),
+		).
 		ScanValsContext(ctx, &followings)
 
 	if err != nil {
-		logger.With(zap.Error(err)).Error("failed to get followers of account")
-		return nil, status.Error(codes.Internal, "failed to get followers of account")
+		logger.With(zap.Error(err)).Error("failed to get followings among accounts")
+		return nil, status.Error(codes.Internal, "failed to get followings among accounts")
 	}
 	return followings, nil
 }
 
-func (f followDataAccessor) DeleteFollow(ctx context.Context, follow Follow) error {
+// GetFollowerIDsAmong returns the accounts of other_ids that follow account_id.
+func (f followDataAccessor) GetFollowerIDsAmong(ctx context.Context, account_id uint64, other_ids []uint64) ([]uint64, error) {
 	logger := utils.LoggerWithContext(ctx, f.logger)
 
-	_, err := f.database.
+	followers := make([]uint64, 0)
+	if len(other_ids) == 0 {
+		return followers, nil
+	}
+	err := f.database.
+		Select(ColNameFollowsAccountID).
+		From(TabNameFollows).
+		Where(
+			goqu.C(ColNameFollowsAccountID).In(other_ids),
+			goqu.C(ColNameFollowsFollowingID).Eq(account_id),
+		).
+		ScanValsContext(ctx, &followers)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get followers among accounts")
+		return nil, status.Error(codes.Internal, "failed to get followers among accounts")
+	}
+	return followers, nil
+}
+
+// GetFollowSuggestions returns the accounts followed by the accounts account_id follows, ranked by how many of them
+// follow each one. Accounts account_id already follows and account_id itself are left out.
+func (f followDataAccessor) GetFollowSuggestions(ctx context.Context, account_id uint64, limit uint64) ([]FollowSuggestion, error) {
+	logger := utils.LoggerWithContext(ctx, f.logger)
+
+	followed := goqu.T("followed")
+	followedOfFollowed := goqu.T("followed_of_followed")
+	suggestions := make([]FollowSuggestion, 0)
+	err := f.database.
+		From(TabNameFollows.As(followed.GetTable())).
+		Join(
+			TabNameFollows.As(followedOfFollowed.GetTable()),
+			goqu.On(followed.Col(ColNameFollowsFollowingID).Eq(followedOfFollowed.Col(ColNameFollowsAccountID))),
+		).
+		Select(
+			followedOfFollowed.Col(ColNameFollowsFollowingID).As("account_id"),
+			goqu.COUNT(goqu.Star()).As("mutual_count"),
+		).
+		Where(
+			followed.Col(ColNameFollowsAccountID).Eq(account_id),
+			followedOfFollowed.Col(ColNameFollowsFollowingID).Neq(account_id),
+			followedOfFollowed.Col(ColNameFollowsFollowingID).NotIn(
+				f.database.
+					Select(ColNameFollowsFollowingID).
+					From(TabNameFollows).
+					Where(goqu.C(ColNameFollowsAccountID).Eq(account_id)),
+			),
+		).
+		GroupBy(followedOfFollowed.Col(ColNameFollowsFollowingID)).
+		Order(goqu.I("mutual_count").Desc(), goqu.I("account_id").Asc()).
+		Limit(uint(limit)).
+		ScanStructsContext(ctx, &suggestions)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get follow suggestions")
+		return nil, status.Error(codes.Internal, "failed to get follow suggestions")
+	}
+	return suggestions, nil
+}
+
+// DeleteFollow unfollows an account and reports whether there was a follow to delete.
+func (f followDataAccessor) DeleteFollow(ctx context.Context, follow Follow) (bool, error) {
+	logger := utils.LoggerWithContext(ctx, f.logger)
+
+	result, err := f.database.
 		Delete(TabNameFollows).
 		Where(
 			goqu.C(ColNameFollowsAccountID).Eq(follow.AccountID),
 			goqu.C(ColNameFollowsFollowingID).Eq(follow.FollowingID),
-		).Executor().Exec()
+		).
+		Executor().
+		ExecContext(ctx)
 
 	if err != nil {
 		logger.With(zap.Error(err)).Error("failed to delete follow")
-		return status.Error(codes.Internal, "failed to delete follow")
+		return false, status.Error(codes.Internal, "failed to delete follow")
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to delete follow")
+		return false, status.Error(codes.Internal, "failed to delete follow")
 	}
-	return nil
+	return rowsAffected > 0, nil
 }
 
 func (f followDataAccessor) WithDatabase(database Database) FollowDataAccessor {
 	return &followDataAccessor{
 		database: database,
+		logger:   f.logger,
 	}
 }
@@ -0,0 +1,213 @@
+package database
+
+import (
+	"GoFeed/internal/utils"
+	"context"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	TabNameFollowRequests = goqu.T("follow_requests")
+
+	ErrFollowRequestNotFound = status.Error(codes.NotFound, "follow request not found")
+)
+
+const (
+	ColNameFollowRequestsAccountID   = "account_id"
+	ColNameFollowRequestsFollowingID = "following_id"
+	ColNameFollowRequestsCreatedAt   = "created_at"
+)
+
+// FollowRequest is a pending request of AccountID to follow the private account FollowingID.
+type FollowRequest struct {
+	AccountID   uint64    `db:"account_id"`
+	FollowingID uint64    `db:"following_id"`
+	CreatedAt   time.Time `db:"created_at"`
+}
+
+// FollowRequestCursor is the position of the last request of the previous page, the zero value starts from the first
+// page.
+type FollowRequestCursor struct {
+	CreatedAt time.Time
+	AccountID uint64
+}
+
+type FollowRequestDataAccessor interface {
+	CreateFollowRequest(ctx context.Context, followRequest FollowRequest) error
+	HasFollowRequest(ctx context.Context, account_id uint64, following_id uint64) (bool, error)
+	GetFollowRequestsOfAccount(ctx context.Context, following_id uint64, before FollowRequestCursor, limit uint64) ([]FollowRequest, error)
+	GetRequestedFollowingIDsAmong(ctx context.Context, account_id uint64, other_ids []uint64) ([]uint64, error)
+	GetRequestingAccountIDsAmong(ctx context.Context, following_id uint64, other_ids []uint64) ([]uint64, error)
+	DeleteFollowRequest(ctx context.Context, account_id uint64, following_id uint64) (bool, error)
+	WithDatabase(database Database) FollowRequestDataAccessor
+}
+
+type followRequestDataAccessor struct {
+	database Database
+	logger   *zap.Logger
+}
+
+func NewFollowRequestDataAccessor(database *goqu.Database, logger *zap.Logger) FollowRequestDataAccessor {
+	return &followRequestDataAccessor{
+		database: database,
+		logger:   logger,
+	}
+}
+
+// CreateFollowRequest requests to follow an account, requesting twice keeps the first request.
+func (f followRequestDataAccessor) CreateFollowRequest(ctx context.Context, followRequest FollowRequest) error {
+	logger := utils.LoggerWithContext(ctx, f.logger)
+
+	_, err := f.database.
+		Insert(TabNameFollowRequests).
+		Rows(goqu.Record{
+			ColNameFollowRequestsAccountID:   followRequest.AccountID,
+			ColNameFollowRequestsFollowingID: followRequest.FollowingID,
+			ColNameFollowRequestsCreatedAt:   followRequest.CreatedAt,
+		}).
+		OnConflict(goqu.DoNothing()).
+		Executor().
+		ExecContext(ctx)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to create follow request")
+		return status.Error(codes.Internal, "failed to create follow request")
+	}
+	return nil
+}
+
+func (f followRequestDataAccessor) HasFollowRequest(ctx context.Context, account_id uint64, following_id uint64) (bool, error) {
+	logger := utils.LoggerWithContext(ctx, f.logger)
+
+	var followingID uint64
+	found, err := f.database.
+		Select(ColNameFollowRequestsFollowingID).
+		From(TabNameFollowRequests).
+		Where(
+			goqu.C(ColNameFollowRequestsAccountID).Eq(account_id),
+			goqu.C(ColNameFollowRequestsFollowingID).Eq(following_id),
+		).
+		ScanValContext(ctx, &followingID)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to check follow request")
+		return false, status.Error(codes.Internal, "failed to check follow request")
+	}
+	return found, nil
+}
+
+// GetFollowRequestsOfAccount returns a page of the pending requests to follow an account, the most recent first,
+// starting before the before cursor.
+func (f followRequestDataAccessor) GetFollowRequestsOfAccount(ctx context.Context, following_id uint64, before FollowRequestCursor, limit uint64) ([]FollowRequest, error) {
+	logger := utils.LoggerWithContext(ctx, f.logger)
+
+	query := f.database.
+		From(TabNameFollowRequests).
+		Where(goqu.C(ColNameFollowRequestsFollowingID).Eq(following_id))
+	if before.AccountID != 0 {
+		query = query.Where(goqu.Or(
+			goqu.C(ColNameFollowRequestsCreatedAt).Lt(before.CreatedAt),
+			goqu.And(
+				goqu.C(ColNameFollowRequestsCreatedAt).Eq(before.CreatedAt),
+				goqu.C(ColNameFollowRequestsAccountID).Lt(before.AccountID),
+			),
+		))
+	}
+
+	followRequests := make([]FollowRequest, 0)
+	err := query.
+		Order(goqu.C(ColNameFollowRequestsCreatedAt).Desc(), goqu.C(ColNameFollowRequestsAccountID).Desc()).
+		Limit(uint(limit)).
+		ScanStructsContext(ctx, &followRequests)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get follow requests of account")
+		return nil, status.Error(codes.Internal, "failed to get follow requests of account")
+	}
+	return followRequests, nil
+}
+
+// GetRequestedFollowingIDsAmong returns the accounts of other_ids that account_id has a pending request to follow.
+func (f followRequestDataAccessor) GetRequestedFollowingIDsAmong(ctx context.Context, account_id uint64, other_ids []uint64) ([]uint64, error) {
+	logger := utils.LoggerWithContext(ctx, f.logger)
+
+	followingIDs := make([]uint64, 0)
+	if len(other_ids) == 0 {
+		return followingIDs, nil
+	}
+	err := f.database.
+		Select(ColNameFollowRequestsFollowingID).
+		From(TabNameFollowRequests).
+		Where(
+			goqu.C(ColNameFollowRequestsAccountID).Eq(account_id),
+			goqu.C(ColNameFollowRequestsFollowingID).In(other_ids),
+		).
+		ScanValsContext(ctx, &followingIDs)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get requested followings among accounts")
+		return nil, status.Error(codes.Internal, "failed to get requested followings among accounts")
+	}
+	return followingIDs, nil
+}
+
+// GetRequestingAccountIDsAmong returns the accounts of other_ids that have a pending request to follow following_id.
+func (f followRequestDataAccessor) GetRequestingAccountIDsAmong(ctx context.Context, following_id uint64, other_ids []uint64) ([]uint64, error) {
+	logger := utils.LoggerWithContext(ctx, f.logger)
+
+	accountIDs := make([]uint64, 0)
+	if len(other_ids) == 0 {
+		return accountIDs, nil
+	}
+	err := f.database.
+		Select(ColNameFollowRequestsAccountID).
+		From(TabNameFollowRequests).
+		Where(
+			goqu.C(ColNameFollowRequestsAccountID).In(other_ids),
+			goqu.C(ColNameFollowRequestsFollowingID).Eq(following_id),
+		).
+		ScanValsContext(ctx, &accountIDs)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get requesting accounts among accounts")
+		return nil, status.Error(codes.Internal, "failed to get requesting accounts among accounts")
+	}
+	return accountIDs, nil
+}
+
+// DeleteFollowRequest removes a pending request and reports whether there was one to remove.
+func (f followRequestDataAccessor) DeleteFollowRequest(ctx context.Context, account_id uint64, following_id uint64) (bool, error) {
+	logger := utils.LoggerWithContext(ctx, f.logger)
+
+	result, err := f.database.
+		Delete(TabNameFollowRequests).
+		Where(
+			goqu.C(ColNameFollowRequestsAccountID).Eq(account_id),
+			goqu.C(ColNameFollowRequestsFollowingID).Eq(following_id),
+		).
+		Executor().
+		ExecContext(ctx)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to delete follow request")
+		return false, status.Error(codes.Internal, "failed to delete follow request")
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to delete follow request")
+		return false, status.Error(codes.Internal, "failed to delete follow request")
+	}
+	return rowsAffected > 0, nil
+}
+
+func (f followRequestDataAccessor) WithDatabase(database Database) FollowRequestDataAccessor {
+	return &followRequestDataAccessor{
+		database: database,
+		logger:   f.logger,
+	}
+}
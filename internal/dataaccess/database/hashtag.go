@@ -0,0 +1,130 @@
+package database
+
+import (
+	"GoFeed/internal/utils"
+	"context"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	TabNamePostHashtags = goqu.T("post_hashtags")
+)
+
+const (
+	ColNamePostHashtagsPostID  = "post_id"
+	ColNamePostHashtagsHashtag = "hashtag"
+)
+
+type PostHashtag struct {
+	PostID  uint64 `db:"post_id"`
+	Hashtag string `db:"hashtag"`
+}
+
+type HashtagDataAccessor interface {
+	CreatePostHashtags(ctx context.Context, post_id uint64, hashtags []string) error
+	GetPostIDsByHashtag(ctx context.Context, hashtag string, offset uint64, limit uint64) ([]uint64, error)
+	GetHashtagsOfPost(ctx context.Context, post_id uint64) ([]string, error)
+	DeleteHashtagsOfPost(ctx context.Context, post_id uint64) error
+	WithDatabase(database Database) HashtagDataAccessor
+}
+
+type hashtagDataAccessor struct {
+	database Database
+	logger   *zap.Logger
+}
+
+func NewHashtagDataAccessor(database *goqu.Database, logger *zap.Logger) HashtagDataAccessor {
+	return &hashtagDataAccessor{
+		database: database,
+		logger:   logger,
+	}
+}
+
+func (h hashtagDataAccessor) CreatePostHashtags(ctx context.Context, post_id uint64, hashtags []string) error {
+	logger := utils.LoggerWithContext(ctx, h.logger)
+
+	if len(hashtags) == 0 {
+		return nil
+	}
+	_, err := h.database.
+		Insert(TabNamePostHashtags).
+		Rows(lo.Map(hashtags, func(item string, _ int) goqu.Record {
+			return goqu.Record{
+				ColNamePostHashtagsPostID:  post_id,
+				ColNamePostHashtagsHashtag: item,
+			}
+		})).
+		Executor().
+		ExecContext(ctx)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to create post hashtags")
+		return status.Error(codes.Internal, "failed to create post hashtags")
+	}
+	return nil
+}
+
+func (h hashtagDataAccessor) GetPostIDsByHashtag(ctx context.Context, hashtag string, offset uint64, limit uint64) ([]uint64, error) {
+	logger := utils.LoggerWithContext(ctx, h.logger)
+
+	var postIDs []uint64
+	err := h.database.
+		From(TabNamePostHashtags).
+		Select(ColNamePostHashtagsPostID).
+		Where(goqu.C(ColNamePostHashtagsHashtag).Eq(hashtag)).
+		Order(goqu.C(ColNamePostHashtagsPostID).Desc()).
+		Offset(uint(offset)).
+		Limit(uint(limit)).
+		ScanValsContext(ctx, &postIDs)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get posts by hashtag")
+		return nil, status.Error(codes.Internal, "failed to get posts by hashtag")
+	}
+	return postIDs, nil
+}
+
+func (h hashtagDataAccessor) GetHashtagsOfPost(ctx context.Context, post_id uint64) ([]string, error) {
+	logger := utils.LoggerWithContext(ctx, h.logger)
+
+	var hashtags []string
+	err := h.database.
+		From(TabNamePostHashtags).
+		Select(ColNamePostHashtagsHashtag).
+		Where(goqu.C(ColNamePostHashtagsPostID).Eq(post_id)).
+		ScanValsContext(ctx, &hashtags)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get hashtags of post")
+		return nil, status.Error(codes.Internal, "failed to get hashtags of post")
+	}
+	return hashtags, nil
+}
+
+func (h hashtagDataAccessor) DeleteHashtagsOfPost(ctx context.Context, post_id uint64) error {
+	logger := utils.LoggerWithContext(ctx, h.logger)
+
+	_, err := h.database.
+		Delete(TabNamePostHashtags).
+		Where(goqu.C(ColNamePostHashtagsPostID).Eq(post_id)).
+		Executor().
+		ExecContext(ctx)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to delete hashtags of post")
+		return status.Error(codes.Internal, "failed to delete hashtags of post")
+	}
+	return nil
+}
+
+func (h hashtagDataAccessor) WithDatabase(database Database) HashtagDataAccessor {
+	return &hashtagDataAccessor{
+		database: database,
+		logger:   h.logger,
+	}
+}
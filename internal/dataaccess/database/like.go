@@ -3,6 +3,7 @@ package database
 import (
 	"GoFeed/internal/utils"
 	"context"
+	"time"
 
 	"github.com/doug-martin/goqu/v9"
 	"go.uber.org/zap"
@@ -11,24 +12,90 @@ import (
 )
 
 var (
-	TabNameLikes = goqu.T("likes")
+	TabNameLikes              = goqu.T("likes")
+	TabNameCommentLikes       = goqu.T("comment_likes")
+	TabNamePostReactionCounts = goqu.T("post_reaction_counts")
 )
 
 const (
-	ColNameLikesAccountID = "account_id"
-	ColNameLikesPostID    = "post_id"
+	ColNameLikesAccountID    = "account_id"
+	ColNameLikesPostID       = "post_id"
+	ColNameLikesReactionType = "reaction_type"
+	ColNameLikesCreatedAt    = "created_at"
+
+	ColNameCommentLikesAccountID = "account_id"
+	ColNameCommentLikesCommentID = "comment_id"
+	ColNameCommentLikesPostID    = "post_id"
+	ColNameCommentLikesCreatedAt = "created_at"
+
+	ColNamePostReactionCountsPostID       = "post_id"
+	ColNamePostReactionCountsReactionType = "reaction_type"
+	ColNamePostReactionCountsCount        = "count"
 )
 
+type ReactionType string
+
+const (
+	ReactionTypeLike  ReactionType = "like"
+	ReactionTypeLove  ReactionType = "love"
+	ReactionTypeLaugh ReactionType = "laugh"
+	ReactionTypeWow   ReactionType = "wow"
+	ReactionTypeSad   ReactionType = "sad"
+	ReactionTypeAngry ReactionType = "angry"
+)
+
+// Like is the reaction of an account to a post, an account has at most one reaction per post. CreatedAt is when the
+// account first reacted, switching the type of the reaction keeps it.
 type Like struct {
-	AccountID uint64
+	AccountID    uint64       `db:"account_id"`
+	PostID       uint64       `db:"post_id"`
+	ReactionType ReactionType `db:"reaction_type"`
+	CreatedAt    time.Time    `db:"created_at"`
+}
+
+// LikeCursor is the position of the last like of the previous page, the zero value starts from the first page.
+type LikeCursor struct {
+	CreatedAt time.Time
 	PostID    uint64
 }
 
+// LikeAccountCursor is the position of the last like of the previous page of the accounts that reacted to a post, the
+// zero value starts from the first page.
+type LikeAccountCursor struct {
+	CreatedAt time.Time
+	AccountID uint64
+}
+
+type ReactionCount struct {
+	ReactionType ReactionType `db:"reaction_type"`
+	Count        uint64       `db:"count"`
+}
+
+// CommentLike keeps the post of the comment so that purging a post can drop the likes of all its comments at once.
+type CommentLike struct {
+	AccountID uint64    `db:"account_id"`
+	CommentID uint64    `db:"comment_id"`
+	PostID    uint64    `db:"post_id"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
 type LikeDataAccessor interface {
-	CreateLike(ctx context.Context, like Like) error
-	GetLikeCountOfPost(ctx context.Context, post_id uint64) (int, error)
-	GetLikeAccountsOfPost(ctx context.Context, post_id uint64) ([]uint64, error)
-	DeleteLike(ctx context.Context, account_id uint64, post_id uint64) error
+	SetReaction(ctx context.Context, like Like) (ReactionType, error)
+	GetReactionCountsOfPost(ctx context.Context, post_id uint64) (map[ReactionType]uint64, error)
+	GetStoredReactionCountsOfPost(ctx context.Context, post_id uint64) (map[ReactionType]uint64, error)
+	UpdateStoredReactionCountsOfPost(ctx context.Context, post_id uint64, reaction_counts map[ReactionType]uint64) error
+	DeleteStoredReactionCountsOfPost(ctx context.Context, post_id uint64) error
+	GetLikeAccountsOfPost(ctx context.Context, post_id uint64, reaction_type ReactionType, before LikeAccountCursor, limit uint64) ([]Like, error)
+	GetReactionsOfAccount(ctx context.Context, account_id uint64, post_ids []uint64) ([]Like, error)
+	GetLikesOfAccount(ctx context.Context, account_id uint64, reaction_type ReactionType, before LikeCursor, limit uint64) ([]Like, error)
+	DeleteReaction(ctx context.Context, account_id uint64, post_id uint64, reaction_type ReactionType) (ReactionType, error)
+	DeleteLikesOfPost(ctx context.Context, post_id uint64) error
+	CreateCommentLike(ctx context.Context, commentLike CommentLike) error
+	HasLikedComment(ctx context.Context, account_id uint64, comment_id uint64) (bool, error)
+	GetLikedCommentIDs(ctx context.Context, account_id uint64, comment_ids []uint64) ([]uint64, error)
+	DeleteCommentLike(ctx context.Context, account_id uint64, comment_id uint64) (bool, error)
+	DeleteLikesOfComment(ctx context.Context, comment_id uint64) error
+	DeleteCommentLikesOfPost(ctx context.Context, post_id uint64) error
 	WithDatabase(database Database) LikeDataAccessor
 }
 
@@ -44,67 +111,412 @@ func NewLikeDataAccessor(database *goqu.Database, logger *zap.Logger) LikeDataAc
 	}
 }
 
-func (l likeDataAccessor) CreateLike(ctx context.Context, like Like) error {
+// likeUpsertResult is the reaction left in place by the upsert of SetReaction, Inserted tells whether it was created
+// by it.
+type likeUpsertResult struct {
+	ReactionType ReactionType `db:"reaction_type"`
+	Inserted     bool         `db:"inserted"`
+}
+
+// SetReaction reacts to a post and returns the type of the reaction it replaced, empty when the account had not
+// reacted to the post yet. A single upsert either creates the reaction or locks and returns the existing one, the
+// unique key on (account_id, post_id) makes concurrent first reactions of an account wait for each other instead of
+// both being inserted. The lock is held until the end of the transaction it must be run in.
+func (l likeDataAccessor) SetReaction(ctx context.Context, like Like) (ReactionType, error) {
 	logger := utils.LoggerWithContext(ctx, l.logger)
 
+	var result likeUpsertResult
 	_, err := l.database.
 		Insert(TabNameLikes).
 		Rows(goqu.Record{
-			ColNameLikesAccountID: like.AccountID,
-			ColNameLikesPostID:    like.PostID,
+			ColNameLikesAccountID:    like.AccountID,
+			ColNameLikesPostID:       like.PostID,
+			ColNameLikesReactionType: like.ReactionType,
+			ColNameLikesCreatedAt:    like.CreatedAt,
 		}).
-		Executor().Exec()
+		// The no-op update locks the existing reaction and lets RETURNING report its type.
+		OnConflict(goqu.DoUpdate(
+			ColNameLikesAccountID+", "+ColNameLikesPostID,
+			goqu.Record{ColNameLikesReactionType: TabNameLikes.Col(ColNameLikesReactionType)},
+		)).
+		Returning(goqu.C(ColNameLikesReactionType), goqu.L("(xmax = 0)").As("inserted")).
+		Executor().
+		ScanStructContext(ctx, &result)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to set reaction")
+		return "", status.Error(codes.Internal, "failed to set reaction")
+	}
+	if result.Inserted {
+		return "", nil
+	}
+	if result.ReactionType == like.ReactionType {
+		return result.ReactionType, nil
+	}
 
+	_, err = l.database.
+		Update(TabNameLikes).
+		Set(goqu.Record{ColNameLikesReactionType: like.ReactionType}).
+		Where(
+			goqu.C(ColNameLikesAccountID).Eq(like.AccountID),
+			goqu.C(ColNameLikesPostID).Eq(like.PostID),
+		).
+		Executor().
+		ExecContext(ctx)
 	if err != nil {
-		logger.With(zap.Error(err)).Error("failed to create like")
-		return status.Error(codes.Internal, "failed to create like")
+		logger.With(zap.Error(err)).Error("failed to update reaction")
+		return "", status.Error(codes.Internal, "failed to set reaction")
 	}
-	return nil
+	return result.ReactionType, nil
 }
 
-func (l likeDataAccessor) GetLikeCountOfPost(ctx context.Context, post_id uint64) (int, error) {
+// GetReactionCountsOfPost counts the reactions to a post by type from the likes table, types nobody used are left
+// out. It is only meant to rebuild the counters, GetStoredReactionCountsOfPost reads the stored counts.
+func (l likeDataAccessor) GetReactionCountsOfPost(ctx context.Context, post_id uint64) (map[ReactionType]uint64, error) {
 	logger := utils.LoggerWithContext(ctx, l.logger)
 
-	var accounts []uint64
+	var reactionCountList []ReactionCount
 	err := l.database.
-		Select(ColNameLikesAccountID).
 		From(TabNameLikes).
+		Select(
+			goqu.C(ColNameLikesReactionType),
+			goqu.COUNT(goqu.Star()).As("count"),
+		).
 		Where(goqu.C(ColNameLikesPostID).Eq(post_id)).
-		ScanValsContext(ctx, &accounts)
+		GroupBy(goqu.C(ColNameLikesReactionType)).
+		ScanStructsContext(ctx, &reactionCountList)
 	if err != nil {
-		logger.With(zap.Error(err)).Error("failed to get like count of post")
-		return 0, status.Error(codes.Internal, "failed to get like count of post")
+		logger.With(zap.Error(err)).Error("failed to get reaction counts of post")
+		return nil, status.Error(codes.Internal, "failed to get reaction counts of post")
 	}
-	return len(accounts), nil
+
+	reactionCounts := make(map[ReactionType]uint64, len(reactionCountList))
+	for _, reactionCount := range reactionCountList {
+		reactionCounts[reactionCount.ReactionType] = reactionCount.Count
+	}
+	return reactionCounts, nil
 }
 
-func (l likeDataAccessor) GetLikeAccountsOfPost(ctx context.Context, post_id uint64) ([]uint64, error) {
+func (l likeDataAccessor) GetStoredReactionCountsOfPost(ctx context.Context, post_id uint64) (map[ReactionType]uint64, error) {
 	logger := utils.LoggerWithContext(ctx, l.logger)
 
-	var accounts []uint64
+	var reactionCountList []ReactionCount
 	err := l.database.
+		From(TabNamePostReactionCounts).
+		Select(
+			goqu.C(ColNamePostReactionCountsReactionType),
+			goqu.C(ColNamePostReactionCountsCount),
+		).
+		Where(goqu.C(ColNamePostReactionCountsPostID).Eq(post_id)).
+		ScanStructsContext(ctx, &reactionCountList)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get stored reaction counts of post")
+		return nil, status.Error(codes.Internal, "failed to get stored reaction counts of post")
+	}
+
+	reactionCounts := make(map[ReactionType]uint64, len(reactionCountList))
+	for _, reactionCount := range reactionCountList {
+		reactionCounts[reactionCount.ReactionType] = reactionCount.Count
+	}
+	return reactionCounts, nil
+}
+
+// UpdateStoredReactionCountsOfPost stores the reaction counts of a post, the types missing from reaction_counts keep
+// their stored count.
+func (l likeDataAccessor) UpdateStoredReactionCountsOfPost(ctx context.Context, post_id uint64, reaction_counts map[ReactionType]uint64) error {
+	logger := utils.LoggerWithContext(ctx, l.logger)
+
+	if len(reaction_counts) == 0 {
+		return nil
+	}
+	rows := make([]any, 0, len(reaction_counts))
+	for reactionType, count := range reaction_counts {
+		rows = append(rows, goqu.Record{
+			ColNamePostReactionCountsPostID:       post_id,
+			ColNamePostReactionCountsReactionType: reactionType,
+			ColNamePostReactionCountsCount:        count,
+		})
+	}
+	_, err := l.database.
+		Insert(TabNamePostReactionCounts).
+		Rows(rows...).
+		OnConflict(goqu.DoUpdate(
+			ColNamePostReactionCountsPostID+", "+ColNamePostReactionCountsReactionType,
+			goqu.Record{ColNamePostReactionCountsCount: goqu.L("EXCLUDED." + ColNamePostReactionCountsCount)},
+		)).
+		Executor().
+		ExecContext(ctx)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to update stored reaction counts of post")
+		return status.Error(codes.Internal, "failed to update stored reaction counts of post")
+	}
+	return nil
+}
+
+func (l likeDataAccessor) DeleteStoredReactionCountsOfPost(ctx context.Context, post_id uint64) error {
+	logger := utils.LoggerWithContext(ctx, l.logger)
+
+	_, err := l.database.
+		Delete(TabNamePostReactionCounts).
+		Where(goqu.C(ColNamePostReactionCountsPostID).Eq(post_id)).
+		Executor().
+		ExecContext(ctx)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to delete stored reaction counts of post")
+		return status.Error(codes.Internal, "failed to delete stored reaction counts of post")
+	}
+	return nil
+}
+
+// GetLikeAccountsOfPost returns a page of the reactions to a post with reaction_type, or with any type when it is
+// empty, the most recent first, starting before the before cursor.
+func (l likeDataAccessor) GetLikeAccountsOfPost(ctx context.Context, post_id uint64, reaction_type ReactionType, before LikeAccountCursor, limit uint64) ([]Like, error) {
+	logger := utils.LoggerWithContext(ctx, l.logger)
+
+	query := l.database.
 		From(TabNameLikes).
-		Select(ColNameLikesAccountID).
-		Where(goqu.C(ColNameLikesPostID).Eq(post_id)).
-		ScanValsContext(ctx, &accounts)
+		Where(goqu.C(ColNameLikesPostID).Eq(post_id))
+	if reaction_type != "" {
+		query = query.Where(goqu.C(ColNameLikesReactionType).Eq(reaction_type))
+	}
+	if before.AccountID != 0 {
+		query = query.Where(goqu.Or(
+			goqu.C(ColNameLikesCreatedAt).Lt(before.CreatedAt),
+			goqu.And(
+				goqu.C(ColNameLikesCreatedAt).Eq(before.CreatedAt),
+				goqu.C(ColNameLikesAccountID).Lt(before.AccountID),
+			),
+		))
+	}
+
+	likes := make([]Like, 0)
+	err := query.
+		Order(goqu.C(ColNameLikesCreatedAt).Desc(), goqu.C(ColNameLikesAccountID).Desc()).
+		Limit(uint(limit)).
+		ScanStructsContext(ctx, &likes)
 	if err != nil {
 		logger.With(zap.Error(err)).Error("failed to get accounts who like post")
 		return nil, status.Error(codes.Internal, "failed to get accounts who like post")
 	}
-	return accounts, nil
+	return likes, nil
 }
 
-func (l likeDataAccessor) DeleteLike(ctx context.Context, account_id uint64, post_id uint64) error {
+// GetReactionsOfAccount returns the reactions of an account to the posts of post_ids it reacted to.
+func (l likeDataAccessor) GetReactionsOfAccount(ctx context.Context, account_id uint64, post_ids []uint64) ([]Like, error) {
+	logger := utils.LoggerWithContext(ctx, l.logger)
+
+	likes := make([]Like, 0)
+	if len(post_ids) == 0 {
+		return likes, nil
+	}
+	err := l.database.
+		From(TabNameLikes).
+		Where(
+			goqu.C(ColNameLikesAccountID).Eq(account_id),
+			goqu.C(ColNameLikesPostID).In(post_ids),
+		).
+		ScanStructsContext(ctx, &likes)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get reactions of account")
+		return nil, status.Error(codes.Internal, "failed to get reactions of account")
+	}
+	return likes, nil
+}
+
+// GetLikesOfAccount returns a page of the reactions of an account of reaction_type, the most recent first, starting
+// before the before cursor.
+func (l likeDataAccessor) GetLikesOfAccount(ctx context.Context, account_id uint64, reaction_type ReactionType, before LikeCursor, limit uint64) ([]Like, error) {
+	logger := utils.LoggerWithContext(ctx, l.logger)
+
+	query := l.database.
+		From(TabNameLikes).
+		Where(
+			goqu.C(ColNameLikesAccountID).Eq(account_id),
+			goqu.C(ColNameLikesReactionType).Eq(reaction_type),
+		)
+	if before.PostID != 0 {
+		query = query.Where(goqu.Or(
+			goqu.C(ColNameLikesCreatedAt).Lt(before.CreatedAt),
+			goqu.And(
+				goqu.C(ColNameLikesCreatedAt).Eq(before.CreatedAt),
+				goqu.C(ColNameLikesPostID).Lt(before.PostID),
+			),
+		))
+	}
+
+	likes := make([]Like, 0)
+	err := query.
+		Order(goqu.C(ColNameLikesCreatedAt).Desc(), goqu.C(ColNameLikesPostID).Desc()).
+		Limit(uint(limit)).
+		ScanStructsContext(ctx, &likes)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get likes of account")
+		return nil, status.Error(codes.Internal, "failed to get likes of account")
+	}
+	return likes, nil
+}
+
+// DeleteReaction removes the reaction of an account to a post when it is of reaction_type, or whatever its type when
+// reaction_type is empty. It returns the type of the deleted reaction, empty when nothing was deleted.
+func (l likeDataAccessor) DeleteReaction(ctx context.Context, account_id uint64, post_id uint64, reaction_type ReactionType) (ReactionType, error) {
+	logger := utils.LoggerWithContext(ctx, l.logger)
+
+	query := l.database.
+		Delete(TabNameLikes).
+		Where(goqu.C(ColNameLikesAccountID).Eq(account_id), goqu.C(ColNameLikesPostID).Eq(post_id))
+	if reaction_type != "" {
+		query = query.Where(goqu.C(ColNameLikesReactionType).Eq(reaction_type))
+	}
+
+	var deletedReactionType ReactionType
+	_, err := query.
+		Returning(ColNameLikesReactionType).
+		Executor().
+		ScanValContext(ctx, &deletedReactionType)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to delete reaction")
+		return "", status.Error(codes.Internal, "failed to delete reaction")
+	}
+	return deletedReactionType, nil
+}
+
+func (l likeDataAccessor) DeleteLikesOfPost(ctx context.Context, post_id uint64) error {
 	logger := utils.LoggerWithContext(ctx, l.logger)
 
 	_, err := l.database.
 		Delete(TabNameLikes).
-		Where(goqu.C(ColNameLikesAccountID).Eq(account_id), goqu.C(ColNameLikesPostID).Eq(post_id)).
-		Executor().Exec()
+		Where(goqu.C(ColNameLikesPostID).Eq(post_id)).
+		Executor().
+		ExecContext(ctx)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to delete likes of post")
+		return status.Error(codes.Internal, "failed to delete likes of post")
+	}
+	return nil
+}
+
+func (l likeDataAccessor) CreateCommentLike(ctx context.Context, commentLike CommentLike) error {
+	logger := utils.LoggerWithContext(ctx, l.logger)
+
+	_, err := l.database.
+		Insert(TabNameCommentLikes).
+		Rows(goqu.Record{
+			ColNameCommentLikesAccountID: commentLike.AccountID,
+			ColNameCommentLikesCommentID: commentLike.CommentID,
+			ColNameCommentLikesPostID:    commentLike.PostID,
+			ColNameCommentLikesCreatedAt: commentLike.CreatedAt,
+		}).
+		Executor().
+		ExecContext(ctx)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to create comment like")
+		return status.Error(codes.Internal, "failed to create comment like")
+	}
+	return nil
+}
+
+func (l likeDataAccessor) HasLikedComment(ctx context.Context, account_id uint64, comment_id uint64) (bool, error) {
+	logger := utils.LoggerWithContext(ctx, l.logger)
+
+	var likedCommentID uint64
+	found, err := l.database.
+		From(TabNameCommentLikes).
+		Select(ColNameCommentLikesCommentID).
+		Where(
+			goqu.C(ColNameCommentLikesAccountID).Eq(account_id),
+			goqu.C(ColNameCommentLikesCommentID).Eq(comment_id),
+		).
+		ScanValContext(ctx, &likedCommentID)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get comment like")
+		return false, status.Error(codes.Internal, "failed to get comment like")
+	}
+	return found, nil
+}
+
+// GetLikedCommentIDs returns which of comment_ids the account has liked.
+func (l likeDataAccessor) GetLikedCommentIDs(ctx context.Context, account_id uint64, comment_ids []uint64) ([]uint64, error) {
+	logger := utils.LoggerWithContext(ctx, l.logger)
+
+	var likedCommentIDs []uint64
+	if len(comment_ids) == 0 {
+		return likedCommentIDs, nil
+	}
+	err := l.database.
+		From(TabNameCommentLikes).
+		Select(ColNameCommentLikesCommentID).
+		Where(
+			goqu.C(ColNameCommentLikesAccountID).Eq(account_id),
+			goqu.C(ColNameCommentLikesCommentID).In(comment_ids),
+		).
+		ScanValsContext(ctx, &likedCommentIDs)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get liked comments")
+		return nil, status.Error(codes.Internal, "failed to get liked comments")
+	}
+	return likedCommentIDs, nil
+}
+
+// DeleteCommentLike removes the like of an account on a comment and reports whether there was one.
+func (l likeDataAccessor) DeleteCommentLike(ctx context.Context, account_id uint64, comment_id uint64) (bool, error) {
+	logger := utils.LoggerWithContext(ctx, l.logger)
+
+	result, err := l.database.
+		Delete(TabNameCommentLikes).
+		Where(
+			goqu.C(ColNameCommentLikesAccountID).Eq(account_id),
+			goqu.C(ColNameCommentLikesCommentID).Eq(comment_id),
+		).
+		Executor().
+		ExecContext(ctx)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to unlike comment")
+		return false, status.Error(codes.Internal, "failed to unlike comment")
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to unlike comment")
+		return false, status.Error(codes.Internal, "failed to unlike comment")
+	}
+	return rowsAffected > 0, nil
+}
+
+func (l likeDataAccessor) DeleteLikesOfComment(ctx context.Context, comment_id uint64) error {
+	logger := utils.LoggerWithContext(ctx, l.logger)
+
+	_, err := l.database.
+		Delete(TabNameCommentLikes).
+		Where(goqu.C(ColNameCommentLikesCommentID).Eq(comment_id)).
+		Executor().
+		ExecContext(ctx)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to delete likes of comment")
+		return status.Error(codes.Internal, "failed to delete likes of comment")
+	}
+	return nil
+}
+
+func (l likeDataAccessor) DeleteCommentLikesOfPost(ctx context.Context, post_id uint64) error {
+	logger := utils.LoggerWithContext(ctx, l.logger)
+
+	_, err := l.database.
+		Delete(TabNameCommentLikes).
+		Where(goqu.C(ColNameCommentLikesPostID).Eq(post_id)).
+		Executor().
+		ExecContext(ctx)
 
 	if err != nil {
-		logger.With(zap.Error(err)).Error("failed to unlike post")
-		return status.Error(codes.Internal, "failed to unlike post")
+		logger.With(zap.Error(err)).Error("failed to delete comment likes of post")
+		return status.Error(codes.Internal, "failed to delete comment likes of post")
 	}
 	return nil
 }
@@ -112,5 +524,6 @@ func (l likeDataAccessor) DeleteLike(ctx context.Context, account_id uint64, pos
 func (l likeDataAccessor) WithDatabase(database Database) LikeDataAccessor {
 	return &likeDataAccessor{
 		database: database,
+		logger:   l.logger,
 	}
 }
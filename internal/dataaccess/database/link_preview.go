@@ -0,0 +1,121 @@
+package database
+
+import (
+	"GoFeed/internal/utils"
+	"context"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	TabNamePostLinkPreviews = goqu.T("post_link_previews")
+)
+
+const (
+	ColNamePostLinkPreviewsPostID      = "post_id"
+	ColNamePostLinkPreviewsURL         = "url"
+	ColNamePostLinkPreviewsTitle       = "title"
+	ColNamePostLinkPreviewsDescription = "description"
+	ColNamePostLinkPreviewsImageURL    = "image_url"
+	ColNamePostLinkPreviewsSiteName    = "site_name"
+	ColNamePostLinkPreviewsFetchedAt   = "fetched_at"
+)
+
+type LinkPreview struct {
+	PostID      uint64    `db:"post_id"`
+	URL         string    `db:"url"`
+	Title       string    `db:"title"`
+	Description string    `db:"description"`
+	ImageURL    string    `db:"image_url"`
+	SiteName    string    `db:"site_name"`
+	FetchedAt   time.Time `db:"fetched_at"`
+}
+
+type LinkPreviewDataAccessor interface {
+	CreateLinkPreview(ctx context.Context, linkPreview LinkPreview) error
+	GetLinkPreviewsOfPosts(ctx context.Context, post_ids []uint64) ([]LinkPreview, error)
+	DeleteLinkPreviewOfPost(ctx context.Context, post_id uint64) error
+	WithDatabase(database Database) LinkPreviewDataAccessor
+}
+
+type linkPreviewDataAccessor struct {
+	database Database
+	logger   *zap.Logger
+}
+
+func NewLinkPreviewDataAccessor(database *goqu.Database, logger *zap.Logger) LinkPreviewDataAccessor {
+	return &linkPreviewDataAccessor{
+		database: database,
+		logger:   logger,
+	}
+}
+
+func (l linkPreviewDataAccessor) CreateLinkPreview(ctx context.Context, linkPreview LinkPreview) error {
+	logger := utils.LoggerWithContext(ctx, l.logger)
+
+	_, err := l.database.
+		Insert(TabNamePostLinkPreviews).
+		Rows(goqu.Record{
+			ColNamePostLinkPreviewsPostID:      linkPreview.PostID,
+			ColNamePostLinkPreviewsURL:         linkPreview.URL,
+			ColNamePostLinkPreviewsTitle:       linkPreview.Title,
+			ColNamePostLinkPreviewsDescription: linkPreview.Description,
+			ColNamePostLinkPreviewsImageURL:    linkPreview.ImageURL,
+			ColNamePostLinkPreviewsSiteName:    linkPreview.SiteName,
+			ColNamePostLinkPreviewsFetchedAt:   linkPreview.FetchedAt,
+		}).
+		Executor().
+		ExecContext(ctx)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to create link preview")
+		return status.Error(codes.Internal, "failed to create link preview")
+	}
+	return nil
+}
+
+func (l linkPreviewDataAccessor) GetLinkPreviewsOfPosts(ctx context.Context, post_ids []uint64) ([]LinkPreview, error) {
+	logger := utils.LoggerWithContext(ctx, l.logger)
+
+	var linkPreviews []LinkPreview
+	if len(post_ids) == 0 {
+		return linkPreviews, nil
+	}
+	err := l.database.
+		From(TabNamePostLinkPreviews).
+		Where(goqu.C(ColNamePostLinkPreviewsPostID).In(post_ids)).
+		ScanStructsContext(ctx, &linkPreviews)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get link previews of posts")
+		return nil, status.Error(codes.Internal, "failed to get link previews of posts")
+	}
+	return linkPreviews, nil
+}
+
+func (l linkPreviewDataAccessor) DeleteLinkPreviewOfPost(ctx context.Context, post_id uint64) error {
+	logger := utils.LoggerWithContext(ctx, l.logger)
+
+	_, err := l.database.
+		Delete(TabNamePostLinkPreviews).
+		Where(goqu.C(ColNamePostLinkPreviewsPostID).Eq(post_id)).
+		Executor().
+		ExecContext(ctx)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to delete link preview of post")
+		return status.Error(codes.Internal, "failed to delete link preview of post")
+	}
+	return nil
+}
+
+func (l linkPreviewDataAccessor) WithDatabase(database Database) LinkPreviewDataAccessor {
+	return &linkPreviewDataAccessor{
+		database: database,
+		logger:   l.logger,
+	}
+}
@@ -0,0 +1,132 @@
+package database
+
+import (
+	"GoFeed/internal/utils"
+	"context"
+
+	"github.com/doug-martin/goqu/v9"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	TabNamePostMentions = goqu.T("post_mentions")
+)
+
+const (
+	ColNamePostMentionsPostID      = "post_id"
+	ColNamePostMentionsAccountID   = "account_id"
+	ColNamePostMentionsStartOffset = "start_offset"
+	ColNamePostMentionsEndOffset   = "end_offset"
+)
+
+type PostMention struct {
+	PostID      uint64 `db:"post_id"`
+	AccountID   uint64 `db:"account_id"`
+	StartOffset uint32 `db:"start_offset"`
+	EndOffset   uint32 `db:"end_offset"`
+}
+
+type MentionDataAccessor interface {
+	CreatePostMentions(ctx context.Context, mentions []PostMention) error
+	GetMentionsOfPosts(ctx context.Context, post_ids []uint64) ([]PostMention, error)
+	GetPostIDsMentioningAccount(ctx context.Context, account_id uint64, offset uint64, limit uint64) ([]uint64, error)
+	DeleteMentionsOfPost(ctx context.Context, post_id uint64) error
+	WithDatabase(database Database) MentionDataAccessor
+}
+
+type mentionDataAccessor struct {
+	database Database
+	logger   *zap.Logger
+}
+
+func NewMentionDataAccessor(database *goqu.Database, logger *zap.Logger) MentionDataAccessor {
+	return &mentionDataAccessor{
+		database: database,
+		logger:   logger,
+	}
+}
+
+func (m mentionDataAccessor) CreatePostMentions(ctx context.Context, mentions []PostMention) error {
+	logger := utils.LoggerWithContext(ctx, m.logger)
+
+	if len(mentions) == 0 {
+		return nil
+	}
+	_, err := m.database.
+		Insert(TabNamePostMentions).
+		Rows(mentions).
+		Executor().
+		ExecContext(ctx)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to create post mentions")
+		return status.Error(codes.Internal, "failed to create post mentions")
+	}
+	return nil
+}
+
+func (m mentionDataAccessor) GetMentionsOfPosts(ctx context.Context, post_ids []uint64) ([]PostMention, error) {
+	logger := utils.LoggerWithContext(ctx, m.logger)
+
+	var mentions []PostMention
+	if len(post_ids) == 0 {
+		return mentions, nil
+	}
+	err := m.database.
+		From(TabNamePostMentions).
+		Where(goqu.C(ColNamePostMentionsPostID).In(post_ids)).
+		Order(goqu.C(ColNamePostMentionsStartOffset).Asc()).
+		ScanStructsContext(ctx, &mentions)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get mentions of posts")
+		return nil, status.Error(codes.Internal, "failed to get mentions of posts")
+	}
+	return mentions, nil
+}
+
+func (m mentionDataAccessor) GetPostIDsMentioningAccount(ctx context.Context, account_id uint64, offset uint64, limit uint64) ([]uint64, error) {
+	logger := utils.LoggerWithContext(ctx, m.logger)
+
+	var postIDs []uint64
+	err := m.database.
+		From(TabNamePostMentions).
+		Select(ColNamePostMentionsPostID).
+		Distinct().
+		Where(goqu.C(ColNamePostMentionsAccountID).Eq(account_id)).
+		Order(goqu.C(ColNamePostMentionsPostID).Desc()).
+		Offset(uint(offset)).
+		Limit(uint(limit)).
+		ScanValsContext(ctx, &postIDs)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get posts mentioning account")
+		return nil, status.Error(codes.Internal, "failed to get posts mentioning account")
+	}
+	return postIDs, nil
+}
+
+func (m mentionDataAccessor) DeleteMentionsOfPost(ctx context.Context, post_id uint64) error {
+	logger := utils.LoggerWithContext(ctx, m.logger)
+
+	_, err := m.database.
+		Delete(TabNamePostMentions).
+		Where(goqu.C(ColNamePostMentionsPostID).Eq(post_id)).
+		Executor().
+		ExecContext(ctx)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to delete mentions of post")
+		return status.Error(codes.Internal, "failed to delete mentions of post")
+	}
+	return nil
+}
+
+func (m mentionDataAccessor) WithDatabase(database Database) MentionDataAccessor {
+	return &mentionDataAccessor{
+		database: database,
+		logger:   m.logger,
+	}
+}
@@ -0,0 +1,23 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS post_hashtags (
+    post_id BIGINT NOT NULL,
+    hashtag VARCHAR(256) NOT NULL,
+    PRIMARY KEY (post_id, hashtag)
+);
+
+CREATE INDEX IF NOT EXISTS post_hashtags_hashtag_post_id_idx ON post_hashtags (hashtag, post_id DESC);
+
+CREATE TABLE IF NOT EXISTS post_mentions (
+    post_id BIGINT NOT NULL,
+    account_id BIGINT NOT NULL,
+    start_offset INTEGER NOT NULL,
+    end_offset INTEGER NOT NULL,
+    PRIMARY KEY (post_id, start_offset)
+);
+
+CREATE INDEX IF NOT EXISTS post_mentions_account_id_post_id_idx ON post_mentions (account_id, post_id DESC);
+
+-- +migrate Down
+DROP TABLE IF EXISTS post_mentions;
+
+DROP TABLE IF EXISTS post_hashtags;
@@ -0,0 +1,21 @@
+-- +migrate Up
+ALTER TABLE posts
+    ADD COLUMN IF NOT EXISTS content_tsv TSVECTOR
+    GENERATED ALWAYS AS (to_tsvector('simple', COALESCE(content, ''))) STORED;
+
+CREATE INDEX IF NOT EXISTS posts_content_tsv_idx ON posts USING GIN (content_tsv);
+
+ALTER TABLE accounts
+    ADD COLUMN IF NOT EXISTS account_name_tsv TSVECTOR
+    GENERATED ALWAYS AS (to_tsvector('simple', COALESCE(account_name, ''))) STORED;
+
+CREATE INDEX IF NOT EXISTS accounts_account_name_tsv_idx ON accounts USING GIN (account_name_tsv);
+
+-- +migrate Down
+DROP INDEX IF EXISTS accounts_account_name_tsv_idx;
+
+ALTER TABLE accounts DROP COLUMN IF EXISTS account_name_tsv;
+
+DROP INDEX IF EXISTS posts_content_tsv_idx;
+
+ALTER TABLE posts DROP COLUMN IF EXISTS content_tsv;
@@ -0,0 +1,17 @@
+-- +migrate Up
+ALTER TABLE posts
+    ADD COLUMN IF NOT EXISTS status VARCHAR(16) NOT NULL DEFAULT 'published',
+    ADD COLUMN IF NOT EXISTS publish_at TIMESTAMPTZ NULL;
+
+CREATE INDEX IF NOT EXISTS posts_account_id_status_idx ON posts (account_id, status);
+
+CREATE INDEX IF NOT EXISTS posts_draft_publish_at_idx ON posts (publish_at) WHERE status = 'draft';
+
+-- +migrate Down
+DROP INDEX IF EXISTS posts_draft_publish_at_idx;
+
+DROP INDEX IF EXISTS posts_account_id_status_idx;
+
+ALTER TABLE posts
+    DROP COLUMN IF EXISTS publish_at,
+    DROP COLUMN IF EXISTS status;
@@ -0,0 +1,12 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS post_pins (
+    account_id BIGINT NOT NULL,
+    post_id BIGINT NOT NULL,
+    pinned_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (account_id, post_id)
+);
+
+CREATE INDEX IF NOT EXISTS post_pins_post_id_idx ON post_pins (post_id);
+
+-- +migrate Down
+DROP TABLE IF EXISTS post_pins;
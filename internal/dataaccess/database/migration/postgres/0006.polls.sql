@@ -0,0 +1,46 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS polls (
+    id BIGINT PRIMARY KEY,
+    post_id BIGINT NOT NULL UNIQUE,
+    multiple_choice BOOLEAN NOT NULL DEFAULT FALSE,
+    closes_at TIMESTAMPTZ NOT NULL,
+    closed BOOLEAN NOT NULL DEFAULT FALSE,
+    voter_count BIGINT NOT NULL DEFAULT 0
+);
+
+CREATE INDEX IF NOT EXISTS polls_open_closes_at_idx ON polls (closes_at) WHERE closed = FALSE;
+
+CREATE TABLE IF NOT EXISTS poll_options (
+    id BIGINT PRIMARY KEY,
+    poll_id BIGINT NOT NULL,
+    position SMALLINT NOT NULL,
+    text VARCHAR(256) NOT NULL,
+    vote_count BIGINT NOT NULL DEFAULT 0,
+    UNIQUE (poll_id, position)
+);
+
+-- poll_voters enforces a single ballot per account, poll_votes holds the options picked on that ballot.
+CREATE TABLE IF NOT EXISTS poll_voters (
+    poll_id BIGINT NOT NULL,
+    account_id BIGINT NOT NULL,
+    voted_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (poll_id, account_id)
+);
+
+CREATE TABLE IF NOT EXISTS poll_votes (
+    poll_id BIGINT NOT NULL,
+    option_id BIGINT NOT NULL,
+    account_id BIGINT NOT NULL,
+    PRIMARY KEY (poll_id, account_id, option_id)
+);
+
+CREATE INDEX IF NOT EXISTS poll_votes_option_id_idx ON poll_votes (option_id);
+
+-- +migrate Down
+DROP TABLE IF EXISTS poll_votes;
+
+DROP TABLE IF EXISTS poll_voters;
+
+DROP TABLE IF EXISTS poll_options;
+
+DROP TABLE IF EXISTS polls;
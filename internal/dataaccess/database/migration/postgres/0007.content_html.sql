@@ -0,0 +1,13 @@
+-- +migrate Up
+ALTER TABLE posts
+    ADD COLUMN IF NOT EXISTS content_html TEXT NOT NULL DEFAULT '';
+
+ALTER TABLE comments
+    ADD COLUMN IF NOT EXISTS content_html TEXT NOT NULL DEFAULT '';
+
+-- +migrate Down
+ALTER TABLE comments
+    DROP COLUMN IF EXISTS content_html;
+
+ALTER TABLE posts
+    DROP COLUMN IF EXISTS content_html;
@@ -0,0 +1,37 @@
+-- +migrate Up
+ALTER TABLE posts
+    ADD COLUMN IF NOT EXISTS removed_at TIMESTAMPTZ NULL,
+    ADD COLUMN IF NOT EXISTS removed_reason TEXT NOT NULL DEFAULT '';
+
+ALTER TABLE comments
+    ADD COLUMN IF NOT EXISTS removed_at TIMESTAMPTZ NULL,
+    ADD COLUMN IF NOT EXISTS removed_reason TEXT NOT NULL DEFAULT '';
+
+CREATE TABLE IF NOT EXISTS content_reports (
+    id BIGINT PRIMARY KEY,
+    reporter_account_id BIGINT NOT NULL,
+    content_type VARCHAR(16) NOT NULL,
+    content_id BIGINT NOT NULL,
+    reason TEXT NOT NULL,
+    status VARCHAR(16) NOT NULL DEFAULT 'open',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    resolved_by_account_id BIGINT NULL,
+    resolved_at TIMESTAMPTZ NULL,
+    resolution_note TEXT NOT NULL DEFAULT '',
+    UNIQUE (reporter_account_id, content_type, content_id)
+);
+
+CREATE INDEX IF NOT EXISTS content_reports_status_id_idx ON content_reports (status, id DESC);
+
+CREATE INDEX IF NOT EXISTS content_reports_content_idx ON content_reports (content_type, content_id);
+
+-- +migrate Down
+DROP TABLE IF EXISTS content_reports;
+
+ALTER TABLE comments
+    DROP COLUMN IF EXISTS removed_reason,
+    DROP COLUMN IF EXISTS removed_at;
+
+ALTER TABLE posts
+    DROP COLUMN IF EXISTS removed_reason,
+    DROP COLUMN IF EXISTS removed_at;
@@ -0,0 +1,21 @@
+-- +migrate Up
+ALTER TABLE posts
+    ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ NULL;
+
+ALTER TABLE comments
+    ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ NULL;
+
+CREATE INDEX IF NOT EXISTS posts_deleted_at_idx ON posts (deleted_at) WHERE deleted_at IS NOT NULL;
+
+CREATE INDEX IF NOT EXISTS comments_deleted_at_idx ON comments (deleted_at) WHERE deleted_at IS NOT NULL;
+
+-- +migrate Down
+DROP INDEX IF EXISTS comments_deleted_at_idx;
+
+DROP INDEX IF EXISTS posts_deleted_at_idx;
+
+ALTER TABLE comments
+    DROP COLUMN IF EXISTS deleted_at;
+
+ALTER TABLE posts
+    DROP COLUMN IF EXISTS deleted_at;
@@ -0,0 +1,15 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS bookmarks (
+    id BIGINT PRIMARY KEY,
+    account_id BIGINT NOT NULL,
+    post_id BIGINT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (account_id, post_id)
+);
+
+CREATE INDEX IF NOT EXISTS bookmarks_account_id_id_idx ON bookmarks (account_id, id DESC);
+
+CREATE INDEX IF NOT EXISTS bookmarks_post_id_idx ON bookmarks (post_id);
+
+-- +migrate Down
+DROP TABLE IF EXISTS bookmarks;
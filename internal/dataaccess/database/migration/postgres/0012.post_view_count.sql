@@ -0,0 +1,7 @@
+-- +migrate Up
+ALTER TABLE posts
+    ADD COLUMN IF NOT EXISTS view_count BIGINT NOT NULL DEFAULT 0;
+
+-- +migrate Down
+ALTER TABLE posts
+    DROP COLUMN IF EXISTS view_count;
@@ -0,0 +1,15 @@
+-- +migrate Up
+ALTER TABLE comments
+    ADD COLUMN IF NOT EXISTS parent_comment_id BIGINT NULL,
+    ADD COLUMN IF NOT EXISTS depth INT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS tombstoned_at TIMESTAMPTZ NULL;
+
+CREATE INDEX IF NOT EXISTS comments_parent_comment_id_idx ON comments (parent_comment_id, id) WHERE parent_comment_id IS NOT NULL;
+
+-- +migrate Down
+DROP INDEX IF EXISTS comments_parent_comment_id_idx;
+
+ALTER TABLE comments
+    DROP COLUMN IF EXISTS tombstoned_at,
+    DROP COLUMN IF EXISTS depth,
+    DROP COLUMN IF EXISTS parent_comment_id;
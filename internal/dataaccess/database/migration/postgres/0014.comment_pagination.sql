@@ -0,0 +1,19 @@
+-- +migrate Up
+ALTER TABLE comments
+    ADD COLUMN IF NOT EXISTS like_count BIGINT NOT NULL DEFAULT 0;
+
+-- Serves the oldest sort by scanning forward and the newest sort by scanning backward.
+CREATE INDEX IF NOT EXISTS comments_post_id_id_idx ON comments (post_id, id)
+    WHERE removed_at IS NULL AND deleted_at IS NULL;
+
+-- Serves the top sort.
+CREATE INDEX IF NOT EXISTS comments_post_id_like_count_id_idx ON comments (post_id, like_count DESC, id DESC)
+    WHERE removed_at IS NULL AND deleted_at IS NULL;
+
+-- +migrate Down
+DROP INDEX IF EXISTS comments_post_id_like_count_id_idx;
+
+DROP INDEX IF EXISTS comments_post_id_id_idx;
+
+ALTER TABLE comments
+    DROP COLUMN IF EXISTS like_count;
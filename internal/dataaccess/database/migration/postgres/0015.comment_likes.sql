@@ -0,0 +1,15 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS comment_likes (
+    account_id BIGINT NOT NULL,
+    comment_id BIGINT NOT NULL,
+    post_id BIGINT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (account_id, comment_id)
+);
+
+CREATE INDEX IF NOT EXISTS comment_likes_comment_id_idx ON comment_likes (comment_id);
+
+CREATE INDEX IF NOT EXISTS comment_likes_post_id_idx ON comment_likes (post_id);
+
+-- +migrate Down
+DROP TABLE IF EXISTS comment_likes;
@@ -0,0 +1,13 @@
+-- +migrate Up
+ALTER TABLE posts
+    ADD COLUMN IF NOT EXISTS comment_policy TEXT NOT NULL DEFAULT 'everyone';
+
+ALTER TABLE comments
+    ADD COLUMN IF NOT EXISTS hidden_at TIMESTAMPTZ NULL;
+
+-- +migrate Down
+ALTER TABLE comments
+    DROP COLUMN IF EXISTS hidden_at;
+
+ALTER TABLE posts
+    DROP COLUMN IF EXISTS comment_policy;
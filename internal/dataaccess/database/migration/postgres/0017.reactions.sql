@@ -0,0 +1,11 @@
+-- +migrate Up
+ALTER TABLE likes
+    ADD COLUMN IF NOT EXISTS reaction_type TEXT NOT NULL DEFAULT 'like';
+
+CREATE INDEX IF NOT EXISTS likes_post_id_reaction_type_idx ON likes (post_id, reaction_type);
+
+-- +migrate Down
+DROP INDEX IF EXISTS likes_post_id_reaction_type_idx;
+
+ALTER TABLE likes
+    DROP COLUMN IF EXISTS reaction_type;
@@ -0,0 +1,48 @@
+-- +migrate Up
+ALTER TABLE posts
+    ADD COLUMN IF NOT EXISTS comment_count BIGINT NOT NULL DEFAULT 0;
+
+ALTER TABLE accounts
+    ADD COLUMN IF NOT EXISTS follower_count BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS following_count BIGINT NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS post_reaction_counts (
+    post_id BIGINT NOT NULL,
+    reaction_type TEXT NOT NULL,
+    count BIGINT NOT NULL DEFAULT 0,
+    PRIMARY KEY (post_id, reaction_type)
+);
+
+UPDATE posts
+SET comment_count = counts.count
+FROM (
+    SELECT post_id, COUNT(*) AS count
+    FROM comments
+    WHERE removed_at IS NULL AND deleted_at IS NULL AND tombstoned_at IS NULL AND hidden_at IS NULL
+    GROUP BY post_id
+) AS counts
+WHERE posts.id = counts.post_id;
+
+UPDATE accounts
+SET follower_count = counts.count
+FROM (SELECT following_id, COUNT(*) AS count FROM follows GROUP BY following_id) AS counts
+WHERE accounts.id = counts.following_id;
+
+UPDATE accounts
+SET following_count = counts.count
+FROM (SELECT account_id, COUNT(*) AS count FROM follows GROUP BY account_id) AS counts
+WHERE accounts.id = counts.account_id;
+
+INSERT INTO post_reaction_counts (post_id, reaction_type, count)
+SELECT post_id, reaction_type, COUNT(*) FROM likes GROUP BY post_id, reaction_type
+ON CONFLICT (post_id, reaction_type) DO UPDATE SET count = EXCLUDED.count;
+
+-- +migrate Down
+DROP TABLE IF EXISTS post_reaction_counts;
+
+ALTER TABLE accounts
+    DROP COLUMN IF EXISTS following_count,
+    DROP COLUMN IF EXISTS follower_count;
+
+ALTER TABLE posts
+    DROP COLUMN IF EXISTS comment_count;
@@ -0,0 +1,17 @@
+-- +migrate Up
+ALTER TABLE likes
+    ADD COLUMN IF NOT EXISTS created_at TIMESTAMPTZ NOT NULL DEFAULT NOW();
+
+CREATE INDEX IF NOT EXISTS likes_account_id_created_at_idx ON likes (account_id, created_at DESC, post_id DESC);
+
+ALTER TABLE accounts
+    ADD COLUMN IF NOT EXISTS likes_hidden BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- +migrate Down
+ALTER TABLE accounts
+    DROP COLUMN IF EXISTS likes_hidden;
+
+DROP INDEX IF EXISTS likes_account_id_created_at_idx;
+
+ALTER TABLE likes
+    DROP COLUMN IF EXISTS created_at;
@@ -0,0 +1,20 @@
+-- +migrate Up
+ALTER TABLE accounts
+    ADD COLUMN IF NOT EXISTS is_private BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE TABLE IF NOT EXISTS follow_requests (
+    account_id BIGINT NOT NULL,
+    following_id BIGINT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (account_id, following_id)
+);
+
+CREATE INDEX IF NOT EXISTS follow_requests_following_id_created_at_idx ON follow_requests (following_id, created_at DESC, account_id DESC);
+
+-- +migrate Down
+DROP INDEX IF EXISTS follow_requests_following_id_created_at_idx;
+
+DROP TABLE IF EXISTS follow_requests;
+
+ALTER TABLE accounts
+    DROP COLUMN IF EXISTS is_private;
@@ -0,0 +1,29 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS account_blocks (
+    account_id BIGINT NOT NULL,
+    blocked_id BIGINT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (account_id, blocked_id)
+);
+
+CREATE INDEX IF NOT EXISTS account_blocks_blocked_id_idx ON account_blocks (blocked_id);
+CREATE INDEX IF NOT EXISTS account_blocks_account_id_created_at_idx ON account_blocks (account_id, created_at DESC, blocked_id DESC);
+
+CREATE TABLE IF NOT EXISTS account_mutes (
+    account_id BIGINT NOT NULL,
+    muted_id BIGINT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (account_id, muted_id)
+);
+
+CREATE INDEX IF NOT EXISTS account_mutes_account_id_created_at_idx ON account_mutes (account_id, created_at DESC, muted_id DESC);
+
+-- +migrate Down
+DROP INDEX IF EXISTS account_mutes_account_id_created_at_idx;
+
+DROP TABLE IF EXISTS account_mutes;
+
+DROP INDEX IF EXISTS account_blocks_account_id_created_at_idx;
+DROP INDEX IF EXISTS account_blocks_blocked_id_idx;
+
+DROP TABLE IF EXISTS account_blocks;
@@ -0,0 +1,5 @@
+-- +migrate Up
+CREATE INDEX IF NOT EXISTS accounts_follower_count_idx ON accounts (follower_count DESC, id);
+
+-- +migrate Down
+DROP INDEX IF EXISTS accounts_follower_count_idx;
@@ -0,0 +1,21 @@
+-- +migrate Up
+ALTER TABLE follows
+    ADD COLUMN IF NOT EXISTS created_at TIMESTAMPTZ NOT NULL DEFAULT NOW();
+
+CREATE INDEX IF NOT EXISTS follows_following_id_created_at_idx ON follows (following_id, created_at DESC, account_id DESC);
+CREATE INDEX IF NOT EXISTS follows_account_id_created_at_idx ON follows (account_id, created_at DESC, following_id DESC);
+
+CREATE INDEX IF NOT EXISTS likes_post_id_created_at_idx ON likes (post_id, created_at DESC, account_id DESC);
+
+CREATE INDEX IF NOT EXISTS posts_account_id_id_idx ON posts (account_id, id DESC);
+
+-- +migrate Down
+DROP INDEX IF EXISTS posts_account_id_id_idx;
+
+DROP INDEX IF EXISTS likes_post_id_created_at_idx;
+
+DROP INDEX IF EXISTS follows_account_id_created_at_idx;
+DROP INDEX IF EXISTS follows_following_id_created_at_idx;
+
+ALTER TABLE follows
+    DROP COLUMN IF EXISTS created_at;
@@ -0,0 +1,12 @@
+-- +migrate Up
+-- Concurrent first reactions could insert the same reaction twice, keep the oldest one of each account and post.
+DELETE FROM likes duplicate
+    USING likes kept
+    WHERE duplicate.account_id = kept.account_id
+        AND duplicate.post_id = kept.post_id
+        AND (duplicate.created_at, duplicate.ctid) > (kept.created_at, kept.ctid);
+
+CREATE UNIQUE INDEX IF NOT EXISTS likes_account_id_post_id_idx ON likes (account_id, post_id);
+
+-- +migrate Down
+DROP INDEX IF EXISTS likes_account_id_post_id_idx;
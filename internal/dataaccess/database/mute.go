@@ -0,0 +1,159 @@
+package database
+
+import (
+	"GoFeed/internal/utils"
+	"context"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	TabNameAccountMutes = goqu.T("account_mutes")
+)
+
+const (
+	ColNameAccountMutesAccountID = "account_id"
+	ColNameAccountMutesMutedID   = "muted_id"
+	ColNameAccountMutesCreatedAt = "created_at"
+)
+
+// Mute is AccountID muting MutedID. Unlike a block, the muted account is not told and can still interact with the
+// posts of the muter.
+type Mute struct {
+	AccountID uint64    `db:"account_id"`
+	MutedID   uint64    `db:"muted_id"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+// MuteCursor is the position of the last mute of the previous page, the zero value starts from the first page.
+type MuteCursor struct {
+	CreatedAt time.Time
+	MutedID   uint64
+}
+
+type MuteDataAccessor interface {
+	CreateMute(ctx context.Context, mute Mute) error
+	GetMutesOfAccount(ctx context.Context, account_id uint64, before MuteCursor, limit uint64) ([]Mute, error)
+	GetMutedAccountIDs(ctx context.Context, account_id uint64, other_ids []uint64) ([]uint64, error)
+	DeleteMute(ctx context.Context, account_id uint64, muted_id uint64) error
+	WithDatabase(database Database) MuteDataAccessor
+}
+
+type muteDataAccessor struct {
+	database Database
+	logger   *zap.Logger
+}
+
+func NewMuteDataAccessor(database *goqu.Database, logger *zap.Logger) MuteDataAccessor {
+	return &muteDataAccessor{
+		database: database,
+		logger:   logger,
+	}
+}
+
+// CreateMute mutes an account, muting it twice keeps the first mute.
+func (m muteDataAccessor) CreateMute(ctx context.Context, mute Mute) error {
+	logger := utils.LoggerWithContext(ctx, m.logger)
+
+	_, err := m.database.
+		Insert(TabNameAccountMutes).
+		Rows(goqu.Record{
+			ColNameAccountMutesAccountID: mute.AccountID,
+			ColNameAccountMutesMutedID:   mute.MutedID,
+			ColNameAccountMutesCreatedAt: mute.CreatedAt,
+		}).
+		OnConflict(goqu.DoNothing()).
+		Executor().
+		ExecContext(ctx)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to create mute")
+		return status.Error(codes.Internal, "failed to create mute")
+	}
+	return nil
+}
+
+// GetMutesOfAccount returns a page of the accounts muted by an account, the most recently muted first, starting before
+// the before cursor.
+func (m muteDataAccessor) GetMutesOfAccount(ctx context.Context, account_id uint64, before MuteCursor, limit uint64) ([]Mute, error) {
+	logger := utils.LoggerWithContext(ctx, m.logger)
+
+	query := m.database.
+		From(TabNameAccountMutes).
+		Where(goqu.C(ColNameAccountMutesAccountID).Eq(account_id))
+	if before.MutedID != 0 {
+		query = query.Where(goqu.Or(
+			goqu.C(ColNameAccountMutesCreatedAt).Lt(before.CreatedAt),
+			goqu.And(
+				goqu.C(ColNameAccountMutesCreatedAt).Eq(before.CreatedAt),
+				goqu.C(ColNameAccountMutesMutedID).Lt(before.MutedID),
+			),
+		))
+	}
+
+	mutes := make([]Mute, 0)
+	err := query.
+		Order(goqu.C(ColNameAccountMutesCreatedAt).Desc(), goqu.C(ColNameAccountMutesMutedID).Desc()).
+		Limit(uint(limit)).
+		ScanStructsContext(ctx, &mutes)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get mutes of account")
+		return nil, status.Error(codes.Internal, "failed to get mutes of account")
+	}
+	return mutes, nil
+}
+
+// GetMutedAccountIDs returns the accounts of other_ids that account_id muted.
+func (m muteDataAccessor) GetMutedAccountIDs(ctx context.Context, account_id uint64, other_ids []uint64) ([]uint64, error) {
+	logger := utils.LoggerWithContext(ctx, m.logger)
+
+	mutedIDs := make([]uint64, 0)
+	if len(other_ids) == 0 {
+		return mutedIDs, nil
+	}
+	err := m.database.
+		Select(ColNameAccountMutesMutedID).
+		From(TabNameAccountMutes).
+		Where(
+			goqu.C(ColNameAccountMutesAccountID).Eq(account_id),
+			goqu.C(ColNameAccountMutesMutedID).In(other_ids),
+		).
+		ScanValsContext(ctx, &mutedIDs)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get muted accounts")
+		return nil, status.Error(codes.Internal, "failed to get muted accounts")
+	}
+	return mutedIDs, nil
+}
+
+func (m muteDataAccessor) DeleteMute(ctx context.Context, account_id uint64, muted_id uint64) error {
+	logger := utils.LoggerWithContext(ctx, m.logger)
+
+	_, err := m.database.
+		Delete(TabNameAccountMutes).
+		Where(
+			goqu.C(ColNameAccountMutesAccountID).Eq(account_id),
+			goqu.C(ColNameAccountMutesMutedID).Eq(muted_id),
+		).
+		Executor().
+		ExecContext(ctx)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to delete mute")
+		return status.Error(codes.Internal, "failed to delete mute")
+	}
+	return nil
+}
+
+func (m muteDataAccessor) WithDatabase(database Database) MuteDataAccessor {
+	return &muteDataAccessor{
+		database: database,
+		logger:   m.logger,
+	}
+}
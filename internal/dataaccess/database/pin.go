@@ -0,0 +1,123 @@
+package database
+
+import (
+	"GoFeed/internal/utils"
+	"context"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	TabNamePostPins = goqu.T("post_pins")
+)
+
+const (
+	ColNamePostPinsAccountID = "account_id"
+	ColNamePostPinsPostID    = "post_id"
+	ColNamePostPinsPinnedAt  = "pinned_at"
+)
+
+type PinDataAccessor interface {
+	CreatePin(ctx context.Context, account_id uint64, post_id uint64, pinned_at time.Time) error
+	GetPinnedPostIDsOfAccount(ctx context.Context, account_id uint64) ([]uint64, error)
+	DeletePin(ctx context.Context, account_id uint64, post_id uint64) error
+	DeletePinsOfPost(ctx context.Context, post_id uint64) error
+	WithDatabase(database Database) PinDataAccessor
+}
+
+type pinDataAccessor struct {
+	database Database
+	logger   *zap.Logger
+}
+
+func NewPinDataAccessor(database *goqu.Database, logger *zap.Logger) PinDataAccessor {
+	return &pinDataAccessor{
+		database: database,
+		logger:   logger,
+	}
+}
+
+func (p pinDataAccessor) CreatePin(ctx context.Context, account_id uint64, post_id uint64, pinned_at time.Time) error {
+	logger := utils.LoggerWithContext(ctx, p.logger)
+
+	_, err := p.database.
+		Insert(TabNamePostPins).
+		Rows(goqu.Record{
+			ColNamePostPinsAccountID: account_id,
+			ColNamePostPinsPostID:    post_id,
+			ColNamePostPinsPinnedAt:  pinned_at,
+		}).
+		Executor().
+		ExecContext(ctx)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to create pin")
+		return status.Error(codes.Internal, "failed to create pin")
+	}
+	return nil
+}
+
+// GetPinnedPostIDsOfAccount returns the pinned posts of an account, the most recently pinned first.
+func (p pinDataAccessor) GetPinnedPostIDsOfAccount(ctx context.Context, account_id uint64) ([]uint64, error) {
+	logger := utils.LoggerWithContext(ctx, p.logger)
+
+	var postIDs []uint64
+	err := p.database.
+		From(TabNamePostPins).
+		Select(ColNamePostPinsPostID).
+		Where(goqu.C(ColNamePostPinsAccountID).Eq(account_id)).
+		Order(goqu.C(ColNamePostPinsPinnedAt).Desc(), goqu.C(ColNamePostPinsPostID).Desc()).
+		ScanValsContext(ctx, &postIDs)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get pinned posts of account")
+		return nil, status.Error(codes.Internal, "failed to get pinned posts of account")
+	}
+	return postIDs, nil
+}
+
+func (p pinDataAccessor) DeletePin(ctx context.Context, account_id uint64, post_id uint64) error {
+	logger := utils.LoggerWithContext(ctx, p.logger)
+
+	_, err := p.database.
+		Delete(TabNamePostPins).
+		Where(
+			goqu.C(ColNamePostPinsAccountID).Eq(account_id),
+			goqu.C(ColNamePostPinsPostID).Eq(post_id),
+		).
+		Executor().
+		ExecContext(ctx)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to delete pin")
+		return status.Error(codes.Internal, "failed to delete pin")
+	}
+	return nil
+}
+
+func (p pinDataAccessor) DeletePinsOfPost(ctx context.Context, post_id uint64) error {
+	logger := utils.LoggerWithContext(ctx, p.logger)
+
+	_, err := p.database.
+		Delete(TabNamePostPins).
+		Where(goqu.C(ColNamePostPinsPostID).Eq(post_id)).
+		Executor().
+		ExecContext(ctx)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to delete pins of post")
+		return status.Error(codes.Internal, "failed to delete pins of post")
+	}
+	return nil
+}
+
+func (p pinDataAccessor) WithDatabase(database Database) PinDataAccessor {
+	return &pinDataAccessor{
+		database: database,
+		logger:   p.logger,
+	}
+}
@@ -0,0 +1,425 @@
+package database
+
+import (
+	"GoFeed/internal/utils"
+	"context"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	TabNamePolls       = goqu.T("polls")
+	TabNamePollOptions = goqu.T("poll_options")
+	TabNamePollVoters  = goqu.T("poll_voters")
+	TabNamePollVotes   = goqu.T("poll_votes")
+
+	ErrPollNotFound = status.Error(codes.NotFound, "poll not found")
+)
+
+const (
+	ColNamePollsID             = "id"
+	ColNamePollsPostID         = "post_id"
+	ColNamePollsMultipleChoice = "multiple_choice"
+	ColNamePollsClosesAt       = "closes_at"
+	ColNamePollsClosed         = "closed"
+	ColNamePollsVoterCount     = "voter_count"
+
+	ColNamePollOptionsID        = "id"
+	ColNamePollOptionsPollID    = "poll_id"
+	ColNamePollOptionsPosition  = "position"
+	ColNamePollOptionsText      = "text"
+	ColNamePollOptionsVoteCount = "vote_count"
+
+	ColNamePollVotersPollID    = "poll_id"
+	ColNamePollVotersAccountID = "account_id"
+	ColNamePollVotersVotedAt   = "voted_at"
+
+	ColNamePollVotesPollID    = "poll_id"
+	ColNamePollVotesOptionID  = "option_id"
+	ColNamePollVotesAccountID = "account_id"
+)
+
+// Poll.VoterCount and PollOption.VoteCount are the tallies frozen when the poll is closed, they stay at zero
+// while the poll is open.
+type Poll struct {
+	ID             uint64    `db:"id"`
+	PostID         uint64    `db:"post_id"`
+	MultipleChoice bool      `db:"multiple_choice"`
+	ClosesAt       time.Time `db:"closes_at"`
+	Closed         bool      `db:"closed"`
+	VoterCount     uint64    `db:"voter_count"`
+}
+
+type PollOption struct {
+	ID        uint64 `db:"id"`
+	PollID    uint64 `db:"poll_id"`
+	Position  uint32 `db:"position"`
+	Text      string `db:"text"`
+	VoteCount uint64 `db:"vote_count"`
+}
+
+type PollVote struct {
+	PollID    uint64 `db:"poll_id"`
+	OptionID  uint64 `db:"option_id"`
+	AccountID uint64 `db:"account_id"`
+}
+
+type PollOptionVoteCount struct {
+	OptionID uint64 `db:"option_id"`
+	Count    uint64 `db:"count"`
+}
+
+type PollVoterCount struct {
+	PollID uint64 `db:"poll_id"`
+	Count  uint64 `db:"count"`
+}
+
+type PollDataAccessor interface {
+	CreatePoll(ctx context.Context, poll Poll, options []PollOption) error
+	GetPollByID(ctx context.Context, id uint64) (Poll, error)
+	GetPollByIDWithXLock(ctx context.Context, id uint64) (Poll, error)
+	GetPollByIDWithSLock(ctx context.Context, id uint64) (Poll, error)
+	GetPollsOfPosts(ctx context.Context, post_ids []uint64) ([]Poll, error)
+	GetOptionsOfPolls(ctx context.Context, poll_ids []uint64) ([]PollOption, error)
+	GetVoteCountsOfPolls(ctx context.Context, poll_ids []uint64) ([]PollOptionVoteCount, error)
+	GetVoterCountsOfPolls(ctx context.Context, poll_ids []uint64) ([]PollVoterCount, error)
+	GetVotesOfAccount(ctx context.Context, poll_ids []uint64, account_id uint64) ([]PollVote, error)
+	CreateVote(ctx context.Context, poll_id uint64, account_id uint64, option_ids []uint64, voted_at time.Time) error
+	GetDuePollIDs(ctx context.Context, now time.Time, limit uint64) ([]uint64, error)
+	ClosePoll(ctx context.Context, poll_id uint64, voter_count uint64, vote_counts []PollOptionVoteCount) error
+	DeletePollOfPost(ctx context.Context, post_id uint64) error
+	WithDatabase(database Database) PollDataAccessor
+}
+
+type pollDataAccessor struct {
+	database Database
+	logger   *zap.Logger
+}
+
+func NewPollDataAccessor(database *goqu.Database, logger *zap.Logger) PollDataAccessor {
+	return &pollDataAccessor{
+		database: database,
+		logger:   logger,
+	}
+}
+
+func (p pollDataAccessor) CreatePoll(ctx context.Context, poll Poll, options []PollOption) error {
+	logger := utils.LoggerWithContext(ctx, p.logger)
+
+	_, err := p.database.
+		Insert(TabNamePolls).
+		Rows(goqu.Record{
+			ColNamePollsID:             poll.ID,
+			ColNamePollsPostID:         poll.PostID,
+			ColNamePollsMultipleChoice: poll.MultipleChoice,
+			ColNamePollsClosesAt:       poll.ClosesAt,
+		}).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to create poll")
+		return status.Error(codes.Internal, "failed to create poll")
+	}
+
+	_, err = p.database.
+		Insert(TabNamePollOptions).
+		Rows(lo.Map(options, func(item PollOption, _ int) goqu.Record {
+			return goqu.Record{
+				ColNamePollOptionsID:       item.ID,
+				ColNamePollOptionsPollID:   poll.ID,
+				ColNamePollOptionsPosition: item.Position,
+				ColNamePollOptionsText:     item.Text,
+			}
+		})).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to create poll options")
+		return status.Error(codes.Internal, "failed to create poll options")
+	}
+	return nil
+}
+
+func (p pollDataAccessor) getPollByID(ctx context.Context, query *goqu.SelectDataset) (Poll, error) {
+	logger := utils.LoggerWithContext(ctx, p.logger)
+
+	poll := Poll{}
+	found, err := query.ScanStructContext(ctx, &poll)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get poll by id")
+		return Poll{}, status.Error(codes.Internal, "failed to get poll by id")
+	}
+	if !found {
+		logger.Warn("cannot find poll by id")
+		return Poll{}, ErrPollNotFound
+	}
+	return poll, nil
+}
+
+func (p pollDataAccessor) GetPollByID(ctx context.Context, id uint64) (Poll, error) {
+	return p.getPollByID(ctx, p.database.
+		From(TabNamePolls).
+		Where(goqu.C(ColNamePollsID).Eq(id)))
+}
+
+func (p pollDataAccessor) GetPollByIDWithXLock(ctx context.Context, id uint64) (Poll, error) {
+	return p.getPollByID(ctx, p.database.
+		From(TabNamePolls).
+		Where(goqu.C(ColNamePollsID).Eq(id)).
+		ForUpdate(goqu.Wait))
+}
+
+// GetPollByIDWithSLock lets votes run concurrently while still waiting for a poll being closed.
+func (p pollDataAccessor) GetPollByIDWithSLock(ctx context.Context, id uint64) (Poll, error) {
+	return p.getPollByID(ctx, p.database.
+		From(TabNamePolls).
+		Where(goqu.C(ColNamePollsID).Eq(id)).
+		ForShare(goqu.Wait))
+}
+
+func (p pollDataAccessor) GetPollsOfPosts(ctx context.Context, post_ids []uint64) ([]Poll, error) {
+	logger := utils.LoggerWithContext(ctx, p.logger)
+
+	var polls []Poll
+	if len(post_ids) == 0 {
+		return polls, nil
+	}
+	err := p.database.
+		From(TabNamePolls).
+		Where(goqu.C(ColNamePollsPostID).In(post_ids)).
+		ScanStructsContext(ctx, &polls)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get polls of posts")
+		return nil, status.Error(codes.Internal, "failed to get polls of posts")
+	}
+	return polls, nil
+}
+
+func (p pollDataAccessor) GetOptionsOfPolls(ctx context.Context, poll_ids []uint64) ([]PollOption, error) {
+	logger := utils.LoggerWithContext(ctx, p.logger)
+
+	var options []PollOption
+	if len(poll_ids) == 0 {
+		return options, nil
+	}
+	err := p.database.
+		From(TabNamePollOptions).
+		Where(goqu.C(ColNamePollOptionsPollID).In(poll_ids)).
+		Order(goqu.C(ColNamePollOptionsPollID).Asc(), goqu.C(ColNamePollOptionsPosition).Asc()).
+		ScanStructsContext(ctx, &options)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get options of polls")
+		return nil, status.Error(codes.Internal, "failed to get options of polls")
+	}
+	return options, nil
+}
+
+func (p pollDataAccessor) GetVoteCountsOfPolls(ctx context.Context, poll_ids []uint64) ([]PollOptionVoteCount, error) {
+	logger := utils.LoggerWithContext(ctx, p.logger)
+
+	var voteCounts []PollOptionVoteCount
+	if len(poll_ids) == 0 {
+		return voteCounts, nil
+	}
+	err := p.database.
+		From(TabNamePollVotes).
+		Select(goqu.C(ColNamePollVotesOptionID), goqu.COUNT("*").As("count")).
+		Where(goqu.C(ColNamePollVotesPollID).In(poll_ids)).
+		GroupBy(goqu.C(ColNamePollVotesOptionID)).
+		ScanStructsContext(ctx, &voteCounts)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get vote counts of polls")
+		return nil, status.Error(codes.Internal, "failed to get vote counts of polls")
+	}
+	return voteCounts, nil
+}
+
+func (p pollDataAccessor) GetVoterCountsOfPolls(ctx context.Context, poll_ids []uint64) ([]PollVoterCount, error) {
+	logger := utils.LoggerWithContext(ctx, p.logger)
+
+	var voterCounts []PollVoterCount
+	if len(poll_ids) == 0 {
+		return voterCounts, nil
+	}
+	err := p.database.
+		From(TabNamePollVoters).
+		Select(goqu.C(ColNamePollVotersPollID), goqu.COUNT("*").As("count")).
+		Where(goqu.C(ColNamePollVotersPollID).In(poll_ids)).
+		GroupBy(goqu.C(ColNamePollVotersPollID)).
+		ScanStructsContext(ctx, &voterCounts)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get voter counts of polls")
+		return nil, status.Error(codes.Internal, "failed to get voter counts of polls")
+	}
+	return voterCounts, nil
+}
+
+func (p pollDataAccessor) GetVotesOfAccount(ctx context.Context, poll_ids []uint64, account_id uint64) ([]PollVote, error) {
+	logger := utils.LoggerWithContext(ctx, p.logger)
+
+	var votes []PollVote
+	if len(poll_ids) == 0 {
+		return votes, nil
+	}
+	err := p.database.
+		From(TabNamePollVotes).
+		Where(
+			goqu.C(ColNamePollVotesPollID).In(poll_ids),
+			goqu.C(ColNamePollVotesAccountID).Eq(account_id),
+		).
+		ScanStructsContext(ctx, &votes)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get votes of account")
+		return nil, status.Error(codes.Internal, "failed to get votes of account")
+	}
+	return votes, nil
+}
+
+// CreateVote records the ballot of an account. The primary key of poll_voters rejects a second ballot from the
+// same account.
+func (p pollDataAccessor) CreateVote(ctx context.Context, poll_id uint64, account_id uint64, option_ids []uint64, voted_at time.Time) error {
+	logger := utils.LoggerWithContext(ctx, p.logger)
+
+	_, err := p.database.
+		Insert(TabNamePollVoters).
+		Rows(goqu.Record{
+			ColNamePollVotersPollID:    poll_id,
+			ColNamePollVotersAccountID: account_id,
+			ColNamePollVotersVotedAt:   voted_at,
+		}).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to create poll voter")
+		return status.Error(codes.Internal, "failed to create poll voter")
+	}
+
+	_, err = p.database.
+		Insert(TabNamePollVotes).
+		Rows(lo.Map(option_ids, func(item uint64, _ int) goqu.Record {
+			return goqu.Record{
+				ColNamePollVotesPollID:    poll_id,
+				ColNamePollVotesOptionID:  item,
+				ColNamePollVotesAccountID: account_id,
+			}
+		})).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to create poll votes")
+		return status.Error(codes.Internal, "failed to create poll votes")
+	}
+	return nil
+}
+
+func (p pollDataAccessor) GetDuePollIDs(ctx context.Context, now time.Time, limit uint64) ([]uint64, error) {
+	logger := utils.LoggerWithContext(ctx, p.logger)
+
+	var pollIDs []uint64
+	err := p.database.
+		From(TabNamePolls).
+		Select(ColNamePollsID).
+		Where(
+			goqu.C(ColNamePollsClosed).IsFalse(),
+			goqu.C(ColNamePollsClosesAt).Lte(now),
+		).
+		Order(goqu.C(ColNamePollsClosesAt).Asc()).
+		Limit(uint(limit)).
+		ScanValsContext(ctx, &pollIDs)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get due polls")
+		return nil, status.Error(codes.Internal, "failed to get due polls")
+	}
+	return pollIDs, nil
+}
+
+func (p pollDataAccessor) ClosePoll(ctx context.Context, poll_id uint64, voter_count uint64, vote_counts []PollOptionVoteCount) error {
+	logger := utils.LoggerWithContext(ctx, p.logger)
+
+	for _, voteCount := range vote_counts {
+		_, err := p.database.
+			Update(TabNamePollOptions).
+			Set(goqu.Record{ColNamePollOptionsVoteCount: voteCount.Count}).
+			Where(
+				goqu.C(ColNamePollOptionsID).Eq(voteCount.OptionID),
+				goqu.C(ColNamePollOptionsPollID).Eq(poll_id),
+			).
+			Executor().
+			ExecContext(ctx)
+		if err != nil {
+			logger.With(zap.Error(err)).Error("failed to freeze poll option vote count")
+			return status.Error(codes.Internal, "failed to freeze poll option vote count")
+		}
+	}
+
+	_, err := p.database.
+		Update(TabNamePolls).
+		Set(goqu.Record{
+			ColNamePollsClosed:     true,
+			ColNamePollsVoterCount: voter_count,
+		}).
+		Where(goqu.C(ColNamePollsID).Eq(poll_id)).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to close poll")
+		return status.Error(codes.Internal, "failed to close poll")
+	}
+	return nil
+}
+
+func (p pollDataAccessor) DeletePollOfPost(ctx context.Context, post_id uint64) error {
+	logger := utils.LoggerWithContext(ctx, p.logger)
+
+	pollIDs := p.database.
+		From(TabNamePolls).
+		Select(ColNamePollsID).
+		Where(goqu.C(ColNamePollsPostID).Eq(post_id))
+	for _, table := range []struct {
+		name   interface{}
+		column string
+	}{
+		{TabNamePollVotes, ColNamePollVotesPollID},
+		{TabNamePollVoters, ColNamePollVotersPollID},
+		{TabNamePollOptions, ColNamePollOptionsPollID},
+	} {
+		_, err := p.database.
+			Delete(table.name).
+			Where(goqu.C(table.column).In(pollIDs)).
+			Executor().
+			ExecContext(ctx)
+		if err != nil {
+			logger.With(zap.Error(err)).Error("failed to delete poll of post")
+			return status.Error(codes.Internal, "failed to delete poll of post")
+		}
+	}
+
+	_, err := p.database.
+		Delete(TabNamePolls).
+		Where(goqu.C(ColNamePollsPostID).Eq(post_id)).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to delete poll of post")
+		return status.Error(codes.Internal, "failed to delete poll of post")
+	}
+	return nil
+}
+
+func (p pollDataAccessor) WithDatabase(database Database) PollDataAccessor {
+	return &pollDataAccessor{
+		database: database,
+		logger:   p.logger,
+	}
+}
@@ -3,6 +3,8 @@ package database
 import (
 	"GoFeed/internal/utils"
 	"context"
+	"database/sql"
+	"time"
 
 	"github.com/doug-martin/goqu/v9"
 	"go.uber.org/zap"
@@ -18,23 +20,75 @@ var (
 )
 
 const (
-	ColNamePostsID        = "id"
-	ColNamePostsAccountID = "account_id"
-	ColNamePostContent    = "content"
+	ColNamePostsID            = "id"
+	ColNamePostsAccountID     = "account_id"
+	ColNamePostContent        = "content"
+	ColNamePostContentHTML    = "content_html"
+	ColNamePostsStatus        = "status"
+	ColNamePostsPublishAt     = "publish_at"
+	ColNamePostsRemovedAt     = "removed_at"
+	ColNamePostsRemovedReason = "removed_reason"
+	ColNamePostsDeletedAt     = "deleted_at"
+	ColNamePostsViewCount     = "view_count"
+	ColNamePostsCommentPolicy = "comment_policy"
+	ColNamePostsCommentCount  = "comment_count"
 )
 
+type PostStatus string
+
+const (
+	PostStatusDraft     PostStatus = "draft"
+	PostStatusPublished PostStatus = "published"
+)
+
+// CommentPolicy decides who can comment on a post, the author of the post always can unless comments are closed.
+type CommentPolicy string
+
+const (
+	CommentPolicyEveryone  CommentPolicy = "everyone"
+	CommentPolicyFollowers CommentPolicy = "followers"
+	CommentPolicyMentioned CommentPolicy = "mentioned"
+	CommentPolicyClosed    CommentPolicy = "closed"
+)
+
+// Post.PublishAt is the time a draft is scheduled to go out, or the time a published post went out.
 type Post struct {
-	ID        uint64
-	AccountID uint64
-	Content   string
+	ID        uint64 `db:"id"`
+	AccountID uint64 `db:"account_id"`
+	Content   string `db:"content"`
+	// ContentHTML is the sanitized markdown rendering of Content, empty when markdown is disabled.
+	ContentHTML string       `db:"content_html"`
+	Status      PostStatus   `db:"status"`
+	PublishAt   sql.NullTime `db:"publish_at"`
+	// RemovedAt is set when moderation takes the post down, the row is kept for audit but
+	// never returned by reads.
+	RemovedAt     sql.NullTime `db:"removed_at"`
+	RemovedReason string       `db:"removed_reason"`
+	// DeletedAt is set when the author deletes the post. It stays in the trash bin, where it can be restored, until
+	// the purge job hard deletes it.
+	DeletedAt sql.NullTime `db:"deleted_at"`
+	// ViewCount is the approximate number of unique viewers, it is only written by UpdateViewCount.
+	ViewCount     uint64        `db:"view_count" goqu:"skipupdate"`
+	CommentPolicy CommentPolicy `db:"comment_policy"`
+	// CommentCount is flushed from the counters kept in the cache, it is only written by UpdateCommentCount.
+	CommentCount uint64 `db:"comment_count" goqu:"skipupdate"`
 }
 
 type PostDataAccessor interface {
 	CreatePost(ctx context.Context, post Post) (uint64, error)
 	GetPostByID(ctx context.Context, id uint64) (Post, error)
 	GetPostByIDWithXLock(ctx context.Context, id uint64) (Post, error)
-	GetPostsOfAccount(ctx context.Context, account_id uint64) ([]Post, error)
+	GetPostByIDIncludingDeletedWithXLock(ctx context.Context, id uint64) (Post, error)
+	GetPostByIDs(ctx context.Context, ids []uint64) ([]Post, error)
+	GetPostsOfAccount(ctx context.Context, account_id uint64, include_drafts bool, before_id uint64, limit uint64) ([]Post, error)
+	GetDraftsOfAccount(ctx context.Context, account_id uint64) ([]Post, error)
+	GetDuePostIDs(ctx context.Context, now time.Time, limit uint64) ([]uint64, error)
+	GetDeletedPostByIDWithXLock(ctx context.Context, id uint64) (Post, error)
+	GetDeletedPostsOfAccount(ctx context.Context, account_id uint64) ([]Post, error)
+	GetPurgeablePostIDs(ctx context.Context, deleted_before time.Time, limit uint64) ([]uint64, error)
 	UpdatePost(ctx context.Context, post Post) error
+	UpdateViewCount(ctx context.Context, id uint64, view_count uint64) error
+	UpdateCommentCount(ctx context.Context, id uint64, comment_count uint64) error
 	DeletePost(ctx context.Context, id uint64) error
 	WithDatabase(database Database) PostDataAccessor
 }
@@ -54,12 +108,21 @@ func NewPostDataAccessor(database *goqu.Database, logger *zap.Logger) PostDataAc
 func (p postDataAccessor) CreatePost(ctx context.Context, post Post) (uint64, error) {
 	logger := utils.LoggerWithContext(ctx, p.logger)
 
+	commentPolicy := post.CommentPolicy
+	if commentPolicy == "" {
+		commentPolicy = CommentPolicyEveryone
+	}
+
 	_, err := p.database.
 		Insert(TabNamePosts).
 		Rows(goqu.Record{
-			ColNamePostsID:        post.ID,
-			ColNamePostsAccountID: post.AccountID,
-			ColNamePostContent:    post.Content,
+			ColNamePostsID:            post.ID,
+			ColNamePostsAccountID:     post.AccountID,
+			ColNamePostContent:        post.Content,
+			ColNamePostContentHTML:    post.ContentHTML,
+			ColNamePostsStatus:        post.Status,
+			ColNamePostsPublishAt:     post.PublishAt,
+			ColNamePostsCommentPolicy: commentPolicy,
 		}).
 		Executor().
 		ExecContext(ctx)
@@ -68,7 +131,7 @@ func (p postDataAccessor) CreatePost(ctx context.Context, post Post) (uint64, er
 		logger.With(zap.Error(err)).Error("failed to create post")
 		return 0, status.Error(codes.Internal, "failed to create post")
 	}
-	return 0, nil
+	return post.ID, nil
 }
 
 func (p postDataAccessor) GetPostByID(ctx context.Context, id uint64) (Post, error) {
@@ -77,7 +140,11 @@ func (p postDataAccessor) GetPostByID(ctx context.Context, id uint64) (Post, err
 	post := Post{}
 	found, err := p.database.
 		From(TabNamePosts).
-		Where(goqu.C(ColNamePostsID).Eq(id)).
+		Where(
+			goqu.C(ColNamePostsID).Eq(id),
+			goqu.C(ColNamePostsRemovedAt).IsNull(),
+			goqu.C(ColNamePostsDeletedAt).IsNull(),
+		).
 		ScanStructContext(ctx, &post)
 
 	if err != nil {
@@ -97,7 +164,37 @@ func (p postDataAccessor) GetPostByIDWithXLock(ctx context.Context, id uint64) (
 	post := Post{}
 	found, err := p.database.
 		From(TabNamePosts).
-		Where(goqu.C(ColNamePostsID).Eq(id)).
+		Where(
+			goqu.C(ColNamePostsID).Eq(id),
+			goqu.C(ColNamePostsRemovedAt).IsNull(),
+			goqu.C(ColNamePostsDeletedAt).IsNull(),
+		).
+		ForUpdate(goqu.Wait).
+		ScanStructContext(ctx, &post)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get post by id")
+		return Post{}, status.Error(codes.Internal, "failed to get post by id")
+	}
+	if !found {
+		logger.Warn("cannot find post by id")
+		return Post{}, ErrPostNotFound
+	}
+	return post, nil
+}
+
+// GetPostByIDIncludingDeletedWithXLock also finds posts in the trash bin, so that moderation can take down a post
+// the author deleted to dodge it.
+func (p postDataAccessor) GetPostByIDIncludingDeletedWithXLock(ctx context.Context, id uint64) (Post, error) {
+	logger := utils.LoggerWithContext(ctx, p.logger)
+
+	post := Post{}
+	found, err := p.database.
+		From(TabNamePosts).
+		Where(
+			goqu.C(ColNamePostsID).Eq(id),
+			goqu.C(ColNamePostsRemovedAt).IsNull(),
+		).
 		ForUpdate(goqu.Wait).
 		ScanStructContext(ctx, &post)
 
@@ -112,15 +209,53 @@ func (p postDataAccessor) GetPostByIDWithXLock(ctx context.Context, id uint64) (
 	return post, nil
 }
 
-func (p postDataAccessor) GetPostsOfAccount(ctx context.Context, account_id uint64) ([]Post, error) {
+func (p postDataAccessor) GetPostByIDs(ctx context.Context, ids []uint64) ([]Post, error) {
 	logger := utils.LoggerWithContext(ctx, p.logger)
 
 	var posts []Post
+	if len(ids) == 0 {
+		return posts, nil
+	}
 	err := p.database.
-		// Select(ColNamePostsID).
 		From(TabNamePosts).
-		Where(goqu.C(ColNamePostsAccountID).Eq(account_id)).
-		ScanValsContext(ctx, &posts)
+		Where(
+			goqu.C(ColNamePostsID).In(ids),
+			goqu.C(ColNamePostsRemovedAt).IsNull(),
+			goqu.C(ColNamePostsDeletedAt).IsNull(),
+		).
+		ScanStructsContext(ctx, &posts)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get posts by ids")
+		return nil, status.Error(codes.Internal, "failed to get posts by ids")
+	}
+	return posts, nil
+}
+
+// GetPostsOfAccount returns a page of the posts of an account, the most recent first, starting before the post
+// before_id. The first page starts from the most recent post when before_id is 0.
+func (p postDataAccessor) GetPostsOfAccount(ctx context.Context, account_id uint64, include_drafts bool, before_id uint64, limit uint64) ([]Post, error) {
+	logger := utils.LoggerWithContext(ctx, p.logger)
+
+	query := p.database.
+		From(TabNamePosts).
+		Where(
+			goqu.C(ColNamePostsAccountID).Eq(account_id),
+			goqu.C(ColNamePostsRemovedAt).IsNull(),
+			goqu.C(ColNamePostsDeletedAt).IsNull(),
+		)
+	if !include_drafts {
+		query = query.Where(goqu.C(ColNamePostsStatus).Eq(PostStatusPublished))
+	}
+	if before_id != 0 {
+		query = query.Where(goqu.C(ColNamePostsID).Lt(before_id))
+	}
+
+	posts := make([]Post, 0)
+	err := query.
+		Order(goqu.C(ColNamePostsID).Desc()).
+		Limit(uint(limit)).
+		ScanStructsContext(ctx, &posts)
 
 	if err != nil {
 		logger.With(zap.Error(err)).Error("failed to get posts of account")
@@ -130,6 +265,118 @@ func (p postDataAccessor) GetPostsOfAccount(ctx context.Context, account_id uint
 	return posts, nil
 }
 
+func (p postDataAccessor) GetDraftsOfAccount(ctx context.Context, account_id uint64) ([]Post, error) {
+	logger := utils.LoggerWithContext(ctx, p.logger)
+
+	var posts []Post
+	err := p.database.
+		From(TabNamePosts).
+		Where(
+			goqu.C(ColNamePostsAccountID).Eq(account_id),
+			goqu.C(ColNamePostsStatus).Eq(PostStatusDraft),
+			goqu.C(ColNamePostsRemovedAt).IsNull(),
+			goqu.C(ColNamePostsDeletedAt).IsNull(),
+		).
+		Order(goqu.C(ColNamePostsID).Desc()).
+		ScanStructsContext(ctx, &posts)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get drafts of account")
+		return nil, status.Error(codes.Internal, "failed to get drafts of account")
+	}
+	return posts, nil
+}
+
+func (p postDataAccessor) GetDuePostIDs(ctx context.Context, now time.Time, limit uint64) ([]uint64, error) {
+	logger := utils.LoggerWithContext(ctx, p.logger)
+
+	var postIDs []uint64
+	err := p.database.
+		From(TabNamePosts).
+		Select(ColNamePostsID).
+		Where(
+			goqu.C(ColNamePostsStatus).Eq(PostStatusDraft),
+			goqu.C(ColNamePostsPublishAt).Lte(now),
+			goqu.C(ColNamePostsRemovedAt).IsNull(),
+			goqu.C(ColNamePostsDeletedAt).IsNull(),
+		).
+		Order(goqu.C(ColNamePostsPublishAt).Asc()).
+		Limit(uint(limit)).
+		ScanValsContext(ctx, &postIDs)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get due posts")
+		return nil, status.Error(codes.Internal, "failed to get due posts")
+	}
+	return postIDs, nil
+}
+
+func (p postDataAccessor) GetDeletedPostByIDWithXLock(ctx context.Context, id uint64) (Post, error) {
+	logger := utils.LoggerWithContext(ctx, p.logger)
+
+	post := Post{}
+	found, err := p.database.
+		From(TabNamePosts).
+		Where(
+			goqu.C(ColNamePostsID).Eq(id),
+			goqu.C(ColNamePostsRemovedAt).IsNull(),
+			goqu.C(ColNamePostsDeletedAt).IsNotNull(),
+		).
+		ForUpdate(goqu.Wait).
+		ScanStructContext(ctx, &post)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get deleted post by id")
+		return Post{}, status.Error(codes.Internal, "failed to get deleted post by id")
+	}
+	if !found {
+		logger.Warn("cannot find deleted post by id")
+		return Post{}, ErrPostNotFound
+	}
+	return post, nil
+}
+
+// GetDeletedPostsOfAccount lists the trash bin of an account, the most recently deleted first.
+func (p postDataAccessor) GetDeletedPostsOfAccount(ctx context.Context, account_id uint64) ([]Post, error) {
+	logger := utils.LoggerWithContext(ctx, p.logger)
+
+	var posts []Post
+	err := p.database.
+		From(TabNamePosts).
+		Where(
+			goqu.C(ColNamePostsAccountID).Eq(account_id),
+			goqu.C(ColNamePostsRemovedAt).IsNull(),
+			goqu.C(ColNamePostsDeletedAt).IsNotNull(),
+		).
+		Order(goqu.C(ColNamePostsDeletedAt).Desc()).
+		ScanStructsContext(ctx, &posts)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get deleted posts of account")
+		return nil, status.Error(codes.Internal, "failed to get deleted posts of account")
+	}
+	return posts, nil
+}
+
+func (p postDataAccessor) GetPurgeablePostIDs(ctx context.Context, deleted_before time.Time, limit uint64) ([]uint64, error) {
+	logger := utils.LoggerWithContext(ctx, p.logger)
+
+	var postIDs []uint64
+	err := p.database.
+		From(TabNamePosts).
+		Select(ColNamePostsID).
+		Where(goqu.C(ColNamePostsDeletedAt).Lt(deleted_before)).
+		Order(goqu.C(ColNamePostsDeletedAt).Asc()).
+		Limit(uint(limit)).
+		ScanValsContext(ctx, &postIDs)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get purgeable posts")
+		return nil, status.Error(codes.Internal, "failed to get purgeable posts")
+	}
+	return postIDs, nil
+}
+
 func (p postDataAccessor) UpdatePost(ctx context.Context, post Post) error {
 	logger := utils.LoggerWithContext(ctx, p.logger)
 
@@ -147,6 +394,42 @@ func (p postDataAccessor) UpdatePost(ctx context.Context, post Post) error {
 	return nil
 }
 
+// UpdateViewCount never lowers the stored count, so a count taken after the cache lost the viewers of the post does
+// not undo the views persisted before.
+func (p postDataAccessor) UpdateViewCount(ctx context.Context, id uint64, view_count uint64) error {
+	logger := utils.LoggerWithContext(ctx, p.logger)
+
+	_, err := p.database.
+		Update(TabNamePosts).
+		Set(goqu.Record{ColNamePostsViewCount: goqu.Func("GREATEST", goqu.C(ColNamePostsViewCount), view_count)}).
+		Where(goqu.C(ColNamePostsID).Eq(id)).
+		Executor().
+		ExecContext(ctx)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to update view count of post")
+		return status.Error(codes.Internal, "failed to update view count of post")
+	}
+	return nil
+}
+
+func (p postDataAccessor) UpdateCommentCount(ctx context.Context, id uint64, comment_count uint64) error {
+	logger := utils.LoggerWithContext(ctx, p.logger)
+
+	_, err := p.database.
+		Update(TabNamePosts).
+		Set(goqu.Record{ColNamePostsCommentCount: comment_count}).
+		Where(goqu.C(ColNamePostsID).Eq(id)).
+		Executor().
+		ExecContext(ctx)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to update comment count of post")
+		return status.Error(codes.Internal, "failed to update comment count of post")
+	}
+	return nil
+}
+
 func (p postDataAccessor) DeletePost(ctx context.Context, id uint64) error {
 	logger := utils.LoggerWithContext(ctx, p.logger)
 
@@ -166,5 +449,6 @@ func (p postDataAccessor) DeletePost(ctx context.Context, id uint64) error {
 func (p postDataAccessor) WithDatabase(database Database) PostDataAccessor {
 	return &postDataAccessor{
 		database: database,
+		logger:   p.logger,
 	}
 }
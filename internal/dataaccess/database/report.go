@@ -0,0 +1,245 @@
+package database
+
+import (
+	"GoFeed/internal/utils"
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	TabNameContentReports = goqu.T("content_reports")
+
+	ErrReportNotFound = status.Error(codes.NotFound, "report not found")
+)
+
+const (
+	ColNameContentReportsID                  = "id"
+	ColNameContentReportsReporterAccountID   = "reporter_account_id"
+	ColNameContentReportsContentType         = "content_type"
+	ColNameContentReportsContentID           = "content_id"
+	ColNameContentReportsReason              = "reason"
+	ColNameContentReportsStatus              = "status"
+	ColNameContentReportsCreatedAt           = "created_at"
+	ColNameContentReportsResolvedByAccountID = "resolved_by_account_id"
+	ColNameContentReportsResolvedAt          = "resolved_at"
+	ColNameContentReportsResolutionNote      = "resolution_note"
+)
+
+type ReportContentType string
+
+const (
+	ReportContentTypePost    ReportContentType = "post"
+	ReportContentTypeComment ReportContentType = "comment"
+)
+
+type ReportStatus string
+
+const (
+	ReportStatusOpen      ReportStatus = "open"
+	ReportStatusResolved  ReportStatus = "resolved"
+	ReportStatusDismissed ReportStatus = "dismissed"
+)
+
+type Report struct {
+	ID                  uint64            `db:"id"`
+	ReporterAccountID   uint64            `db:"reporter_account_id"`
+	ContentType         ReportContentType `db:"content_type"`
+	ContentID           uint64            `db:"content_id"`
+	Reason              string            `db:"reason"`
+	Status              ReportStatus      `db:"status"`
+	CreatedAt           time.Time         `db:"created_at"`
+	ResolvedByAccountID sql.NullInt64     `db:"resolved_by_account_id"`
+	ResolvedAt          sql.NullTime      `db:"resolved_at"`
+	ResolutionNote      string            `db:"resolution_note"`
+}
+
+type ReportDataAccessor interface {
+	CreateReport(ctx context.Context, report Report) error
+	GetReportByIDWithXLock(ctx context.Context, id uint64) (Report, error)
+	GetReportOfReporter(ctx context.Context, reporter_account_id uint64, content_type ReportContentType, content_id uint64) (Report, bool, error)
+	GetReports(ctx context.Context, report_status ReportStatus, offset uint64, limit uint64) ([]Report, error)
+	ResolveReport(ctx context.Context, id uint64, report_status ReportStatus, resolved_by_account_id uint64, resolution_note string, resolved_at time.Time) error
+	ResolveOpenReportsOfContent(ctx context.Context, content_type ReportContentType, content_id uint64, resolved_by_account_id uint64, resolution_note string, resolved_at time.Time) error
+	WithDatabase(database Database) ReportDataAccessor
+}
+
+type reportDataAccessor struct {
+	database Database
+	logger   *zap.Logger
+}
+
+func NewReportDataAccessor(database *goqu.Database, logger *zap.Logger) ReportDataAccessor {
+	return &reportDataAccessor{
+		database: database,
+		logger:   logger,
+	}
+}
+
+func (r reportDataAccessor) CreateReport(ctx context.Context, report Report) error {
+	logger := utils.LoggerWithContext(ctx, r.logger)
+
+	_, err := r.database.
+		Insert(TabNameContentReports).
+		Rows(goqu.Record{
+			ColNameContentReportsID:                report.ID,
+			ColNameContentReportsReporterAccountID: report.ReporterAccountID,
+			ColNameContentReportsContentType:       report.ContentType,
+			ColNameContentReportsContentID:         report.ContentID,
+			ColNameContentReportsReason:            report.Reason,
+			ColNameContentReportsStatus:            ReportStatusOpen,
+			ColNameContentReportsCreatedAt:         report.CreatedAt,
+		}).
+		Executor().
+		ExecContext(ctx)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to create report")
+		return status.Error(codes.Internal, "failed to create report")
+	}
+	return nil
+}
+
+func (r reportDataAccessor) GetReportByIDWithXLock(ctx context.Context, id uint64) (Report, error) {
+	logger := utils.LoggerWithContext(ctx, r.logger)
+
+	report := Report{}
+	found, err := r.database.
+		From(TabNameContentReports).
+		Where(goqu.C(ColNameContentReportsID).Eq(id)).
+		ForUpdate(goqu.Wait).
+		ScanStructContext(ctx, &report)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get report by id")
+		return Report{}, status.Error(codes.Internal, "failed to get report by id")
+	}
+	if !found {
+		logger.Warn("cannot find report by id")
+		return Report{}, ErrReportNotFound
+	}
+	return report, nil
+}
+
+func (r reportDataAccessor) GetReportOfReporter(
+	ctx context.Context,
+	reporter_account_id uint64,
+	content_type ReportContentType,
+	content_id uint64,
+) (Report, bool, error) {
+	logger := utils.LoggerWithContext(ctx, r.logger)
+
+	report := Report{}
+	found, err := r.database.
+		From(TabNameContentReports).
+		Where(
+			goqu.C(ColNameContentReportsReporterAccountID).Eq(reporter_account_id),
+			goqu.C(ColNameContentReportsContentType).Eq(content_type),
+			goqu.C(ColNameContentReportsContentID).Eq(content_id),
+		).
+		ScanStructContext(ctx, &report)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get report of reporter")
+		return Report{}, false, status.Error(codes.Internal, "failed to get report of reporter")
+	}
+	return report, found, nil
+}
+
+// GetReports lists reports with the given status, the newest first. An empty status lists every report.
+func (r reportDataAccessor) GetReports(ctx context.Context, report_status ReportStatus, offset uint64, limit uint64) ([]Report, error) {
+	logger := utils.LoggerWithContext(ctx, r.logger)
+
+	query := r.database.
+		From(TabNameContentReports)
+	if report_status != "" {
+		query = query.Where(goqu.C(ColNameContentReportsStatus).Eq(report_status))
+	}
+
+	var reports []Report
+	err := query.
+		Order(goqu.C(ColNameContentReportsID).Desc()).
+		Offset(uint(offset)).
+		Limit(uint(limit)).
+		ScanStructsContext(ctx, &reports)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get reports")
+		return nil, status.Error(codes.Internal, "failed to get reports")
+	}
+	return reports, nil
+}
+
+func (r reportDataAccessor) ResolveReport(
+	ctx context.Context,
+	id uint64,
+	report_status ReportStatus,
+	resolved_by_account_id uint64,
+	resolution_note string,
+	resolved_at time.Time,
+) error {
+	logger := utils.LoggerWithContext(ctx, r.logger)
+
+	_, err := r.database.
+		Update(TabNameContentReports).
+		Set(goqu.Record{
+			ColNameContentReportsStatus:              report_status,
+			ColNameContentReportsResolvedByAccountID: resolved_by_account_id,
+			ColNameContentReportsResolutionNote:      resolution_note,
+			ColNameContentReportsResolvedAt:          resolved_at,
+		}).
+		Where(goqu.C(ColNameContentReportsID).Eq(id)).
+		Executor().
+		ExecContext(ctx)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to resolve report")
+		return status.Error(codes.Internal, "failed to resolve report")
+	}
+	return nil
+}
+
+func (r reportDataAccessor) ResolveOpenReportsOfContent(
+	ctx context.Context,
+	content_type ReportContentType,
+	content_id uint64,
+	resolved_by_account_id uint64,
+	resolution_note string,
+	resolved_at time.Time,
+) error {
+	logger := utils.LoggerWithContext(ctx, r.logger)
+
+	_, err := r.database.
+		Update(TabNameContentReports).
+		Set(goqu.Record{
+			ColNameContentReportsStatus:              ReportStatusResolved,
+			ColNameContentReportsResolvedByAccountID: resolved_by_account_id,
+			ColNameContentReportsResolutionNote:      resolution_note,
+			ColNameContentReportsResolvedAt:          resolved_at,
+		}).
+		Where(
+			goqu.C(ColNameContentReportsContentType).Eq(content_type),
+			goqu.C(ColNameContentReportsContentID).Eq(content_id),
+			goqu.C(ColNameContentReportsStatus).Eq(ReportStatusOpen),
+		).
+		Executor().
+		ExecContext(ctx)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to resolve reports of content")
+		return status.Error(codes.Internal, "failed to resolve reports of content")
+	}
+	return nil
+}
+
+func (r reportDataAccessor) WithDatabase(database Database) ReportDataAccessor {
+	return &reportDataAccessor{
+		database: database,
+		logger:   r.logger,
+	}
+}
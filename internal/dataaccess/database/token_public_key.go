@@ -79,5 +79,6 @@ func (t tokenPublicKeyDataAccessor) GetPublicKey(ctx context.Context, id uint64)
 func (t tokenPublicKeyDataAccessor) WithDatabase(database Database) TokenPublicKeyDataAccessor {
 	return &tokenPublicKeyDataAccessor{
 		database: database,
+		logger:   t.logger,
 	}
 }
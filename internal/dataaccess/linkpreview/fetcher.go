@@ -0,0 +1,204 @@
+package linkpreview
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"net/url"
+	"syscall"
+	"time"
+
+	"go.uber.org/zap"
+
+	"GoFeed/internal/configs"
+	"GoFeed/internal/utils"
+)
+
+const (
+	defaultTimeout      = 5 * time.Second
+	defaultMaxBodySize  = 1 << 20
+	defaultMaxRedirects = 3
+	defaultUserAgent    = "GoFeedLinkPreview/1.0"
+)
+
+var (
+	ErrBlockedAddress     = errors.New("address is not allowed")
+	ErrUnsupportedURL     = errors.New("only http and https urls are supported")
+	ErrUnsupportedContent = errors.New("response is not an html page")
+	ErrTooManyRedirects   = errors.New("too many redirects")
+)
+
+type Preview struct {
+	URL         string
+	Title       string
+	Description string
+	ImageURL    string
+	SiteName    string
+}
+
+type Fetcher interface {
+	Fetch(ctx context.Context, rawURL string) (Preview, error)
+}
+
+type fetcher struct {
+	httpClient  *http.Client
+	maxBodySize int64
+	userAgent   string
+	logger      *zap.Logger
+}
+
+func NewFetcher(linkPreviewConfig configs.LinkPreview, logger *zap.Logger) (Fetcher, error) {
+	timeout := defaultTimeout
+	if linkPreviewConfig.Timeout != "" {
+		parsedTimeout, err := linkPreviewConfig.GetTimeoutDuration()
+		if err != nil {
+			logger.With(zap.Error(err)).Error("failed to parse link preview timeout")
+			return nil, err
+		}
+		timeout = parsedTimeout
+	}
+	maxBodySize := linkPreviewConfig.MaxBodySize
+	if maxBodySize <= 0 {
+		maxBodySize = defaultMaxBodySize
+	}
+	maxRedirects := linkPreviewConfig.MaxRedirects
+	if maxRedirects <= 0 {
+		maxRedirects = defaultMaxRedirects
+	}
+	userAgent := linkPreviewConfig.UserAgent
+	if userAgent == "" {
+		userAgent = defaultUserAgent
+	}
+
+	dialer := &net.Dialer{
+		Timeout: timeout,
+	}
+	if !linkPreviewConfig.AllowPrivateAddresses {
+		// Checking the address right before connecting, after DNS resolution, also covers redirects and DNS
+		// rebinding.
+		dialer.Control = func(_ string, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || isBlockedIP(ip) {
+				return ErrBlockedAddress
+			}
+			return nil
+		}
+	}
+
+	return &fetcher{
+		httpClient: &http.Client{
+			Timeout: timeout,
+			Transport: &http.Transport{
+				Proxy:                 nil,
+				DialContext:           dialer.DialContext,
+				TLSHandshakeTimeout:   timeout,
+				ResponseHeaderTimeout: timeout,
+				MaxIdleConns:          10,
+				IdleConnTimeout:       30 * time.Second,
+			},
+			CheckRedirect: func(request *http.Request, via []*http.Request) error {
+				if len(via) > maxRedirects {
+					return ErrTooManyRedirects
+				}
+				if !isSupportedScheme(request.URL) {
+					return ErrUnsupportedURL
+				}
+				return nil
+			},
+		},
+		maxBodySize: maxBodySize,
+		userAgent:   userAgent,
+		logger:      logger,
+	}, nil
+}
+
+func isSupportedScheme(u *url.URL) bool {
+	return u.Scheme == "http" || u.Scheme == "https"
+}
+
+var blockedNetworks = func() []*net.IPNet {
+	cidrs := []string{
+		"0.0.0.0/8",
+		"100.64.0.0/10",
+		"192.0.0.0/24",
+		"192.0.2.0/24",
+		"198.18.0.0/15",
+		"198.51.100.0/24",
+		"203.0.113.0/24",
+		"240.0.0.0/4",
+		"64:ff9b::/96",
+		"2001:db8::/32",
+	}
+	networks := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		networks = append(networks, network)
+	}
+	return networks
+}()
+
+// isBlockedIP reports whether ip is loopback, private, link local or otherwise not publicly routable.
+func isBlockedIP(ip net.IP) bool {
+	if ip4 := ip.To4(); ip4 != nil {
+		ip = ip4
+	}
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() || ip.IsMulticast() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() {
+		return true
+	}
+	for _, network := range blockedNetworks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+func (f fetcher) Fetch(ctx context.Context, rawURL string) (Preview, error) {
+	logger := utils.LoggerWithContext(ctx, f.logger).With(zap.String("url", rawURL))
+
+	pageURL, err := url.Parse(rawURL)
+	if err != nil || !isSupportedScheme(pageURL) || pageURL.Host == "" {
+		return Preview{}, ErrUnsupportedURL
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, pageURL.String(), nil)
+	if err != nil {
+		return Preview{}, err
+	}
+	request.Header.Set("User-Agent", f.userAgent)
+	request.Header.Set("Accept", "text/html,application/xhtml+xml")
+
+	response, err := f.httpClient.Do(request)
+	if err != nil {
+		logger.With(zap.Error(err)).Warn("failed to fetch link preview")
+		return Preview{}, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return Preview{}, fmt.Errorf("unexpected status code %d", response.StatusCode)
+	}
+	mediaType, _, err := mime.ParseMediaType(response.Header.Get("Content-Type"))
+	if err != nil || (mediaType != "text/html" && mediaType != "application/xhtml+xml") {
+		return Preview{}, ErrUnsupportedContent
+	}
+
+	preview, err := parseMetadata(io.LimitReader(response.Body, f.maxBodySize), response.Request.URL)
+	if err != nil {
+		logger.With(zap.Error(err)).Warn("failed to parse link preview")
+		return Preview{}, err
+	}
+	preview.URL = pageURL.String()
+	return preview, nil
+}
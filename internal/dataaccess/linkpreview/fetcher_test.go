@@ -0,0 +1,222 @@
+package linkpreview
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"go.uber.org/zap"
+
+	"GoFeed/internal/configs"
+)
+
+func newTestFetcher(t *testing.T, linkPreviewConfig configs.LinkPreview) *fetcher {
+	t.Helper()
+
+	f, err := NewFetcher(linkPreviewConfig, zap.NewNop())
+	if err != nil {
+		t.Fatalf("failed to create fetcher: %v", err)
+	}
+	return f.(*fetcher)
+}
+
+func newHTMLServer(t *testing.T, body string) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+		writer.Header().Set("Content-Type", "text/html; charset=utf-8")
+		fmt.Fprint(writer, body)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestIsBlockedIP(t *testing.T) {
+	testCases := []struct {
+		ip      string
+		blocked bool
+	}{
+		{ip: "127.0.0.1", blocked: true},
+		{ip: "10.1.2.3", blocked: true},
+		{ip: "172.16.0.1", blocked: true},
+		{ip: "192.168.1.1", blocked: true},
+		{ip: "169.254.169.254", blocked: true},
+		{ip: "100.64.0.1", blocked: true},
+		{ip: "0.0.0.0", blocked: true},
+		{ip: "224.0.0.1", blocked: true},
+		{ip: "198.18.0.1", blocked: true},
+		{ip: "::1", blocked: true},
+		{ip: "::ffff:127.0.0.1", blocked: true},
+		{ip: "fc00::1", blocked: true},
+		{ip: "fe80::1", blocked: true},
+		{ip: "64:ff9b::a00:1", blocked: true},
+		{ip: "8.8.8.8", blocked: false},
+		{ip: "93.184.216.34", blocked: false},
+		{ip: "2606:4700:4700::1111", blocked: false},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.ip, func(t *testing.T) {
+			if blocked := isBlockedIP(net.ParseIP(testCase.ip)); blocked != testCase.blocked {
+				t.Errorf("isBlockedIP(%s) = %t, expected %t", testCase.ip, blocked, testCase.blocked)
+			}
+		})
+	}
+}
+
+func TestFetchRefusesPrivateAddress(t *testing.T) {
+	server := newHTMLServer(t, "<html><head><title>Internal</title></head></html>")
+	f := newTestFetcher(t, configs.LinkPreview{})
+
+	_, err := f.Fetch(context.Background(), server.URL)
+	if !errors.Is(err, ErrBlockedAddress) {
+		t.Fatalf("expected %v, got %v", ErrBlockedAddress, err)
+	}
+}
+
+func TestFetchRefusesRedirectToPrivateAddress(t *testing.T) {
+	internalHit := false
+	internalServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+		internalHit = true
+		writer.Header().Set("Content-Type", "text/html")
+		fmt.Fprint(writer, "<html><head><title>Internal</title></head></html>")
+	}))
+	t.Cleanup(internalServer.Close)
+	publicServer := httptest.NewServer(http.RedirectHandler(internalServer.URL, http.StatusFound))
+	t.Cleanup(publicServer.Close)
+
+	// Every test server listens on loopback, so public.example is dialed around the address check to stand in for
+	// a public site. The redirect target goes through the fetcher's own dialer.
+	f := newTestFetcher(t, configs.LinkPreview{})
+	transport := f.httpClient.Transport.(*http.Transport)
+	checkedDialContext := transport.DialContext
+	transport.DialContext = func(ctx context.Context, network string, address string) (net.Conn, error) {
+		if address == "public.example:80" {
+			return (&net.Dialer{}).DialContext(ctx, network, publicServer.Listener.Addr().String())
+		}
+		return checkedDialContext(ctx, network, address)
+	}
+
+	_, err := f.Fetch(context.Background(), "http://public.example/")
+	if !errors.Is(err, ErrBlockedAddress) {
+		t.Fatalf("expected %v, got %v", ErrBlockedAddress, err)
+	}
+	if internalHit {
+		t.Fatal("the redirect target was requested")
+	}
+}
+
+func TestFetchLimitsBodySize(t *testing.T) {
+	body := "<html><head><title>Early title</title><!--" + strings.Repeat("x", 4096) + "-->" +
+		`<meta property="og:title" content="Late title"></head></html>`
+	server := newHTMLServer(t, body)
+
+	testCases := []struct {
+		name        string
+		maxBodySize int64
+		title       string
+	}{
+		{name: "metadata past the cap is ignored", maxBodySize: 1024, title: "Early title"},
+		{name: "whole page under the cap", maxBodySize: 8192, title: "Late title"},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			f := newTestFetcher(t, configs.LinkPreview{
+				MaxBodySize:           testCase.maxBodySize,
+				AllowPrivateAddresses: true,
+			})
+			preview, err := f.Fetch(context.Background(), server.URL)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if preview.Title != testCase.title {
+				t.Errorf("title = %q, expected %q", preview.Title, testCase.title)
+			}
+		})
+	}
+}
+
+func TestFetch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		switch request.URL.Path {
+		case "/page":
+			writer.Header().Set("Content-Type", "text/html; charset=utf-8")
+			fmt.Fprint(writer, `<html><head>
+				<meta property="og:title" content="OpenGraph title">
+				<meta name="twitter:description" content="Twitter description">
+				<meta property="og:image" content="/images/cover.png">
+				<meta property="og:site_name" content="Example">
+			</head><body></body></html>`)
+		case "/moved":
+			http.Redirect(writer, request, "/page", http.StatusMovedPermanently)
+		case "/loop":
+			http.Redirect(writer, request, "/loop", http.StatusFound)
+		case "/image":
+			writer.Header().Set("Content-Type", "image/png")
+			fmt.Fprint(writer, "not a page")
+		default:
+			http.NotFound(writer, request)
+		}
+	}))
+	t.Cleanup(server.Close)
+	f := newTestFetcher(t, configs.LinkPreview{AllowPrivateAddresses: true})
+
+	testCases := []struct {
+		name     string
+		url      string
+		expected Preview
+		err      error
+	}{
+		{
+			name: "page",
+			url:  server.URL + "/page",
+			expected: Preview{
+				URL:         server.URL + "/page",
+				Title:       "OpenGraph title",
+				Description: "Twitter description",
+				ImageURL:    server.URL + "/images/cover.png",
+				SiteName:    "Example",
+			},
+		},
+		{
+			name: "redirect keeps the requested url and resolves against the final one",
+			url:  server.URL + "/moved",
+			expected: Preview{
+				URL:         server.URL + "/moved",
+				Title:       "OpenGraph title",
+				Description: "Twitter description",
+				ImageURL:    server.URL + "/images/cover.png",
+				SiteName:    "Example",
+			},
+		},
+		{name: "too many redirects", url: server.URL + "/loop", err: ErrTooManyRedirects},
+		{name: "not html", url: server.URL + "/image", err: ErrUnsupportedContent},
+		{name: "unsupported scheme", url: "ftp://example.com/file", err: ErrUnsupportedURL},
+		{name: "missing host", url: "http:///page", err: ErrUnsupportedURL},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			preview, err := f.Fetch(context.Background(), testCase.url)
+			if testCase.err != nil {
+				if !errors.Is(err, testCase.err) {
+					t.Fatalf("expected %v, got %v", testCase.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if preview != testCase.expected {
+				t.Errorf("preview = %+v, expected %+v", preview, testCase.expected)
+			}
+		})
+	}
+
+	if _, err := f.Fetch(context.Background(), server.URL+"/missing"); err == nil {
+		t.Error("expected an error for a not found page")
+	}
+}
@@ -0,0 +1,121 @@
+package linkpreview
+
+import (
+	"errors"
+	"io"
+	"net/url"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+const (
+	maxTitleLength       = 300
+	maxDescriptionLength = 1000
+)
+
+// metaKeys lists the metadata used for each preview field, from the most to the least preferred.
+var metaKeys = struct {
+	title       []string
+	description []string
+	image       []string
+	siteName    []string
+}{
+	title:       []string{"og:title", "twitter:title"},
+	description: []string{"og:description", "twitter:description", "description"},
+	image:       []string{"og:image", "og:image:url", "og:image:secure_url", "twitter:image", "twitter:image:src"},
+	siteName:    []string{"og:site_name", "application-name"},
+}
+
+// parseMetadata reads OpenGraph and Twitter card tags from the head of a page. It stops at the end of the head so
+// that only the part of the page that carries metadata is parsed.
+func parseMetadata(body io.Reader, pageURL *url.URL) (Preview, error) {
+	metas := make(map[string]string)
+	title := ""
+	inTitle := false
+
+	tokenizer := html.NewTokenizer(body)
+	for done := false; !done; {
+		switch tokenizer.Next() {
+		case html.ErrorToken:
+			if err := tokenizer.Err(); !errors.Is(err, io.EOF) {
+				// A truncated page still has usable metadata.
+				if len(metas) == 0 && title == "" {
+					return Preview{}, err
+				}
+			}
+			done = true
+		case html.StartTagToken, html.SelfClosingTagToken:
+			token := tokenizer.Token()
+			switch token.DataAtom {
+			case atom.Meta:
+				key, content := "", ""
+				for _, attribute := range token.Attr {
+					switch strings.ToLower(attribute.Key) {
+					case "property", "name":
+						key = strings.ToLower(strings.TrimSpace(attribute.Val))
+					case "content":
+						content = strings.TrimSpace(attribute.Val)
+					}
+				}
+				if _, ok := metas[key]; key != "" && content != "" && !ok {
+					metas[key] = content
+				}
+			case atom.Title:
+				inTitle = title == ""
+			case atom.Body:
+				done = true
+			}
+		case html.TextToken:
+			if inTitle {
+				title += string(tokenizer.Text())
+			}
+		case html.EndTagToken:
+			switch tokenizer.Token().DataAtom {
+			case atom.Title:
+				inTitle = false
+			case atom.Head:
+				done = true
+			}
+		}
+	}
+
+	preview := Preview{
+		Title:       truncate(firstMeta(metas, metaKeys.title, strings.TrimSpace(title)), maxTitleLength),
+		Description: truncate(firstMeta(metas, metaKeys.description, ""), maxDescriptionLength),
+		SiteName:    truncate(firstMeta(metas, metaKeys.siteName, pageURL.Hostname()), maxTitleLength),
+		ImageURL:    resolveImageURL(firstMeta(metas, metaKeys.image, ""), pageURL),
+	}
+	return preview, nil
+}
+
+func firstMeta(metas map[string]string, keys []string, fallback string) string {
+	for _, key := range keys {
+		if value, ok := metas[key]; ok {
+			return value
+		}
+	}
+	return fallback
+}
+
+// resolveImageURL makes relative image urls absolute and drops anything that is not http or https.
+func resolveImageURL(rawURL string, pageURL *url.URL) string {
+	if rawURL == "" {
+		return ""
+	}
+	imageURL, err := pageURL.Parse(rawURL)
+	if err != nil || !isSupportedScheme(imageURL) {
+		return ""
+	}
+	return imageURL.String()
+}
+
+func truncate(s string, maxLength int) string {
+	s = strings.Join(strings.Fields(s), " ")
+	if utf8.RuneCountInString(s) <= maxLength {
+		return s
+	}
+	return string([]rune(s)[:maxLength])
+}
@@ -0,0 +1,103 @@
+package linkpreview
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestParseMetadata(t *testing.T) {
+	pageURL, err := url.Parse("https://news.example.com/articles/1")
+	if err != nil {
+		t.Fatalf("failed to parse page url: %v", err)
+	}
+
+	testCases := []struct {
+		name     string
+		page     string
+		expected Preview
+	}{
+		{
+			name: "opengraph",
+			page: `<html><head>
+				<meta property="og:title" content="OG title">
+				<meta property="og:description" content="OG description">
+				<meta property="og:image" content="https://cdn.example.com/og.png">
+				<meta property="og:site_name" content="Example News">
+			</head></html>`,
+			expected: Preview{
+				Title:       "OG title",
+				Description: "OG description",
+				ImageURL:    "https://cdn.example.com/og.png",
+				SiteName:    "Example News",
+			},
+		},
+		{
+			name: "twitter card",
+			page: `<html><head>
+				<meta name="twitter:title" content="Card title">
+				<meta name="twitter:description" content="Card description">
+				<meta name="twitter:image:src" content="https://cdn.example.com/card.png">
+			</head></html>`,
+			expected: Preview{
+				Title:       "Card title",
+				Description: "Card description",
+				ImageURL:    "https://cdn.example.com/card.png",
+				SiteName:    "news.example.com",
+			},
+		},
+		{
+			name: "opengraph is preferred over twitter card",
+			page: `<html><head>
+				<meta name="twitter:title" content="Card title">
+				<meta property="og:title" content="OG title">
+			</head></html>`,
+			expected: Preview{Title: "OG title", SiteName: "news.example.com"},
+		},
+		{
+			name: "plain title and description",
+			page: `<html><head><title>  Page
+				title </title><meta name="description" content="Page description"></head></html>`,
+			expected: Preview{Title: "Page title", Description: "Page description", SiteName: "news.example.com"},
+		},
+		{
+			name: "first tag wins and keys are case insensitive",
+			page: `<html><head>
+				<meta property="OG:TITLE" content="First">
+				<meta property="og:title" content="Second">
+			</head></html>`,
+			expected: Preview{Title: "First", SiteName: "news.example.com"},
+		},
+		{
+			name:     "relative image is resolved against the page",
+			page:     `<html><head><meta property="og:image" content="../images/cover.png"></head></html>`,
+			expected: Preview{ImageURL: "https://news.example.com/images/cover.png", SiteName: "news.example.com"},
+		},
+		{
+			name:     "image with another scheme is dropped",
+			page:     `<html><head><meta property="og:image" content="javascript:alert(1)"></head></html>`,
+			expected: Preview{SiteName: "news.example.com"},
+		},
+		{
+			name:     "tags after the head are ignored",
+			page:     `<html><head></head><body><meta property="og:title" content="Body title"></body></html>`,
+			expected: Preview{SiteName: "news.example.com"},
+		},
+		{
+			name:     "long title is truncated",
+			page:     `<html><head><meta property="og:title" content="` + strings.Repeat("a", maxTitleLength+50) + `"></head></html>`,
+			expected: Preview{Title: strings.Repeat("a", maxTitleLength), SiteName: "news.example.com"},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			preview, err := parseMetadata(strings.NewReader(testCase.page), pageURL)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if preview != testCase.expected {
+				t.Errorf("preview = %+v, expected %+v", preview, testCase.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package producer
+
+import (
+	"context"
+	"encoding/json"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"GoFeed/internal/utils"
+)
+
+const (
+	MessageQueueLinkPreviewJob = "link_preview_job"
+)
+
+type LinkPreviewJob struct {
+	PostID uint64 `json:"post_id"`
+}
+
+type LinkPreviewJobProducer interface {
+	Produce(ctx context.Context, event LinkPreviewJob) error
+}
+
+type linkPreviewJobProducer struct {
+	client Client
+	logger *zap.Logger
+}
+
+func NewLinkPreviewJobProducer(
+	client Client,
+	logger *zap.Logger,
+) LinkPreviewJobProducer {
+	return &linkPreviewJobProducer{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (l linkPreviewJobProducer) Produce(ctx context.Context, event LinkPreviewJob) error {
+	logger := utils.LoggerWithContext(ctx, l.logger)
+
+	eventBytes, err := json.Marshal(event)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to marshal link preview job event")
+		return status.Error(codes.Internal, "failed to marshal link preview job event")
+	}
+
+	err = l.client.Produce(ctx, MessageQueueLinkPreviewJob, eventBytes)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to produce link preview job event")
+		return status.Error(codes.Internal, "failed to produce link preview job event")
+	}
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package producer
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"GoFeed/internal/utils"
+)
+
+const (
+	MessageQueueTrendingActivity = "trending_activity"
+)
+
+type TrendingActivityType string
+
+const (
+	TrendingActivityTypePost TrendingActivityType = "post"
+	TrendingActivityTypeLike TrendingActivityType = "like"
+)
+
+// TrendingActivity is produced when a post is published or liked. AccountID is the account that did it.
+type TrendingActivity struct {
+	Type      TrendingActivityType `json:"type"`
+	PostID    uint64               `json:"post_id"`
+	AccountID uint64               `json:"account_id"`
+	CreatedAt time.Time            `json:"created_at"`
+}
+
+type TrendingActivityProducer interface {
+	Produce(ctx context.Context, event TrendingActivity) error
+}
+
+type trendingActivityProducer struct {
+	client Client
+	logger *zap.Logger
+}
+
+func NewTrendingActivityProducer(
+	client Client,
+	logger *zap.Logger,
+) TrendingActivityProducer {
+	return &trendingActivityProducer{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (t trendingActivityProducer) Produce(ctx context.Context, event TrendingActivity) error {
+	logger := utils.LoggerWithContext(ctx, t.logger)
+
+	eventBytes, err := json.Marshal(event)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to marshal trending activity event")
+		return status.Error(codes.Internal, "failed to marshal trending activity event")
+	}
+
+	err = t.client.Produce(ctx, MessageQueueTrendingActivity, eventBytes)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to produce trending activity event")
+		return status.Error(codes.Internal, "failed to produce trending activity event")
+	}
+
+	return nil
+}
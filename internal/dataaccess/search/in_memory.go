@@ -0,0 +1,227 @@
+package search
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+
+	"go.uber.org/zap"
+)
+
+func tokenize(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+type parsedQuery struct {
+	terms   []string
+	phrases [][]string
+}
+
+// parseQuery splits a query into loose terms and double quoted phrases. An unterminated quote
+// extends to the end of the query.
+func parseQuery(query string) parsedQuery {
+	result := parsedQuery{}
+	for i, part := range strings.Split(query, `"`) {
+		tokens := tokenize(part)
+		if len(tokens) == 0 {
+			continue
+		}
+		if i%2 == 1 && len(tokens) > 1 {
+			result.phrases = append(result.phrases, tokens)
+		}
+		result.terms = append(result.terms, tokens...)
+	}
+	return result
+}
+
+type invertedIndex struct {
+	documentLengths map[uint64]int
+	documentTerms   map[uint64][]string
+	postings        map[string]map[uint64][]int
+}
+
+func newInvertedIndex() *invertedIndex {
+	return &invertedIndex{
+		documentLengths: make(map[uint64]int),
+		documentTerms:   make(map[uint64][]string),
+		postings:        make(map[string]map[uint64][]int),
+	}
+}
+
+func (i *invertedIndex) add(id uint64, text string) {
+	i.remove(id)
+
+	tokens := tokenize(text)
+	terms := make([]string, 0)
+	for position, token := range tokens {
+		documentPositions, ok := i.postings[token]
+		if !ok {
+			documentPositions = make(map[uint64][]int)
+			i.postings[token] = documentPositions
+		}
+		if _, ok := documentPositions[id]; !ok {
+			terms = append(terms, token)
+		}
+		documentPositions[id] = append(documentPositions[id], position)
+	}
+	i.documentLengths[id] = len(tokens)
+	i.documentTerms[id] = terms
+}
+
+func (i *invertedIndex) remove(id uint64) {
+	for _, term := range i.documentTerms[id] {
+		delete(i.postings[term], id)
+		if len(i.postings[term]) == 0 {
+			delete(i.postings, term)
+		}
+	}
+	delete(i.documentLengths, id)
+	delete(i.documentTerms, id)
+}
+
+func (i *invertedIndex) hasPhrase(id uint64, phrase []string) bool {
+	for _, start := range i.postings[phrase[0]][id] {
+		matched := true
+		for offset := 1; offset < len(phrase) && matched; offset++ {
+			matched = false
+			for _, position := range i.postings[phrase[offset]][id] {
+				if position == start+offset {
+					matched = true
+					break
+				}
+			}
+		}
+		if matched {
+			return true
+		}
+	}
+	return false
+}
+
+// search returns the documents containing every query term and phrase, scored by tf-idf normalized
+// by document length so that short documents that are mostly about the query rank first.
+func (i *invertedIndex) search(query parsedQuery, offset uint64, limit uint64) []uint64 {
+	if len(query.terms) == 0 {
+		return []uint64{}
+	}
+
+	var candidates map[uint64]struct{}
+	for _, term := range query.terms {
+		documentPositions := i.postings[term]
+		next := make(map[uint64]struct{})
+		for id := range documentPositions {
+			if _, ok := candidates[id]; candidates == nil || ok {
+				next[id] = struct{}{}
+			}
+		}
+		candidates = next
+		if len(candidates) == 0 {
+			return []uint64{}
+		}
+	}
+
+	type scoredDocument struct {
+		id    uint64
+		score float64
+	}
+	documentCount := float64(len(i.documentLengths))
+	scoredDocuments := make([]scoredDocument, 0, len(candidates))
+	for id := range candidates {
+		matchesPhrases := true
+		for _, phrase := range query.phrases {
+			if !i.hasPhrase(id, phrase) {
+				matchesPhrases = false
+				break
+			}
+		}
+		if !matchesPhrases {
+			continue
+		}
+
+		score := 0.0
+		for _, term := range query.terms {
+			termFrequency := float64(len(i.postings[term][id]))
+			inverseDocumentFrequency := math.Log(1 + documentCount/float64(len(i.postings[term])))
+			score += termFrequency * inverseDocumentFrequency
+		}
+		score /= math.Sqrt(float64(i.documentLengths[id]))
+		scoredDocuments = append(scoredDocuments, scoredDocument{id: id, score: score})
+	}
+
+	sort.Slice(scoredDocuments, func(a, b int) bool {
+		if scoredDocuments[a].score != scoredDocuments[b].score {
+			return scoredDocuments[a].score > scoredDocuments[b].score
+		}
+		return scoredDocuments[a].id > scoredDocuments[b].id
+	})
+
+	if offset >= uint64(len(scoredDocuments)) {
+		return []uint64{}
+	}
+	end := min(offset+limit, uint64(len(scoredDocuments)))
+	ids := make([]uint64, 0, end-offset)
+	for _, document := range scoredDocuments[offset:end] {
+		ids = append(ids, document.id)
+	}
+	return ids
+}
+
+// inMemorySearch keeps an inverted index inside the process. It is meant for tests and single node
+// deployments, the index is empty after a restart until documents are indexed again.
+type inMemorySearch struct {
+	mutex        sync.RWMutex
+	postIndex    *invertedIndex
+	accountIndex *invertedIndex
+	logger       *zap.Logger
+}
+
+func NewInMemorySearch(logger *zap.Logger) Search {
+	return &inMemorySearch{
+		postIndex:    newInvertedIndex(),
+		accountIndex: newInvertedIndex(),
+		logger:       logger,
+	}
+}
+
+func (s *inMemorySearch) IndexPost(_ context.Context, post PostDocument) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.postIndex.add(post.ID, post.Content)
+	return nil
+}
+
+func (s *inMemorySearch) DeletePost(_ context.Context, postID uint64) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.postIndex.remove(postID)
+	return nil
+}
+
+func (s *inMemorySearch) IndexAccount(_ context.Context, account AccountDocument) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.accountIndex.add(account.ID, account.AccountName)
+	return nil
+}
+
+func (s *inMemorySearch) SearchPosts(_ context.Context, query string, offset uint64, limit uint64) ([]uint64, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return s.postIndex.search(parseQuery(query), offset, limit), nil
+}
+
+func (s *inMemorySearch) SearchAccounts(_ context.Context, query string, offset uint64, limit uint64) ([]uint64, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return s.accountIndex.search(parseQuery(query), offset, limit), nil
+}
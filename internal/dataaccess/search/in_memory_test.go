@@ -0,0 +1,158 @@
+package search
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"go.uber.org/zap"
+)
+
+func newTestPostSearch(t *testing.T) Search {
+	t.Helper()
+
+	searcher := NewInMemorySearch(zap.NewNop())
+	for _, post := range []PostDocument{
+		{ID: 1, Content: "Go channels and goroutines"},
+		{ID: 2, Content: "Cooking pasta with fresh basil and garlic"},
+		{ID: 3, Content: "go, go, go!"},
+		{ID: 4, Content: "Learning Go makes concurrency easy with channels"},
+		{ID: 5, Content: "channels go"},
+		{ID: 6, Content: "fresh garlic bread"},
+	} {
+		if err := searcher.IndexPost(context.Background(), post); err != nil {
+			t.Fatalf("failed to index post %d: %v", post.ID, err)
+		}
+	}
+	return searcher
+}
+
+func TestInMemorySearchPosts(t *testing.T) {
+	searcher := newTestPostSearch(t)
+
+	testCases := []struct {
+		name     string
+		query    string
+		expected []uint64
+	}{
+		{name: "empty query", query: "", expected: []uint64{}},
+		{name: "unknown term", query: "rust", expected: []uint64{}},
+		{name: "every term must match", query: "go rust", expected: []uint64{}},
+		{name: "higher term frequency ranks first", query: "go", expected: []uint64{3, 5, 1, 4}},
+		{name: "shorter documents rank first", query: "go channels", expected: []uint64{5, 1, 4}},
+		{name: "case and punctuation are ignored", query: "GARLIC!", expected: []uint64{6, 2}},
+		{name: "phrase keeps word order", query: `"go channels"`, expected: []uint64{1}},
+		{name: "reversed phrase", query: `"channels go"`, expected: []uint64{5}},
+		{name: "phrase words must be adjacent", query: `"fresh garlic"`, expected: []uint64{6}},
+		{name: "phrase without match", query: `"garlic fresh"`, expected: []uint64{}},
+		{name: "unterminated phrase", query: `"fresh garlic`, expected: []uint64{6}},
+		{name: "single quoted word is a term", query: `"go" channels`, expected: []uint64{5, 1, 4}},
+		{name: "phrase and term", query: `"fresh basil" pasta`, expected: []uint64{2}},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			postIDs, err := searcher.SearchPosts(context.Background(), testCase.query, 0, 10)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !slices.Equal(postIDs, testCase.expected) {
+				t.Errorf("SearchPosts(%q) = %v, expected %v", testCase.query, postIDs, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestInMemorySearchPostsPagination(t *testing.T) {
+	searcher := newTestPostSearch(t)
+
+	testCases := []struct {
+		name     string
+		offset   uint64
+		limit    uint64
+		expected []uint64
+	}{
+		{name: "first page", offset: 0, limit: 2, expected: []uint64{3, 5}},
+		{name: "second page", offset: 2, limit: 2, expected: []uint64{1, 4}},
+		{name: "partial last page", offset: 3, limit: 2, expected: []uint64{4}},
+		{name: "offset at the end", offset: 4, limit: 2, expected: []uint64{}},
+		{name: "offset past the end", offset: 10, limit: 2, expected: []uint64{}},
+		{name: "zero limit", offset: 0, limit: 0, expected: []uint64{}},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			postIDs, err := searcher.SearchPosts(context.Background(), "go", testCase.offset, testCase.limit)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !slices.Equal(postIDs, testCase.expected) {
+				t.Errorf("SearchPosts(offset=%d, limit=%d) = %v, expected %v", testCase.offset, testCase.limit, postIDs, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestInMemorySearchPostsReindexAndDelete(t *testing.T) {
+	ctx := context.Background()
+	searcher := newTestPostSearch(t)
+
+	if err := searcher.IndexPost(ctx, PostDocument{ID: 3, Content: "garlic"}); err != nil {
+		t.Fatalf("failed to reindex post: %v", err)
+	}
+	if err := searcher.DeletePost(ctx, 6); err != nil {
+		t.Fatalf("failed to delete post: %v", err)
+	}
+
+	testCases := []struct {
+		query    string
+		expected []uint64
+	}{
+		{query: "go", expected: []uint64{5, 1, 4}},
+		{query: "garlic", expected: []uint64{3, 2}},
+		{query: "bread", expected: []uint64{}},
+	}
+	for _, testCase := range testCases {
+		postIDs, err := searcher.SearchPosts(ctx, testCase.query, 0, 10)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !slices.Equal(postIDs, testCase.expected) {
+			t.Errorf("SearchPosts(%q) = %v, expected %v", testCase.query, postIDs, testCase.expected)
+		}
+	}
+}
+
+func TestInMemorySearchAccounts(t *testing.T) {
+	ctx := context.Background()
+	searcher := NewInMemorySearch(zap.NewNop())
+	for _, account := range []AccountDocument{
+		{ID: 10, AccountName: "alice"},
+		{ID: 11, AccountName: "alice"},
+		{ID: 12, AccountName: "alice_smith"},
+		{ID: 13, AccountName: "bob"},
+	} {
+		if err := searcher.IndexAccount(ctx, account); err != nil {
+			t.Fatalf("failed to index account %d: %v", account.ID, err)
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		query    string
+		expected []uint64
+	}{
+		{name: "ties are ordered by id descending", query: "alice", expected: []uint64{11, 10, 12}},
+		{name: "name parts are terms", query: "smith", expected: []uint64{12}},
+		{name: "no match", query: "carol", expected: []uint64{}},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			accountIDs, err := searcher.SearchAccounts(ctx, testCase.query, 0, 10)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !slices.Equal(accountIDs, testCase.expected) {
+				t.Errorf("SearchAccounts(%q) = %v, expected %v", testCase.query, accountIDs, testCase.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package search
+
+import (
+	"context"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"GoFeed/internal/dataaccess/database"
+	"GoFeed/internal/utils"
+)
+
+const (
+	colNamePostsContentTSV         = "content_tsv"
+	colNameAccountsAccountNameTSV  = "account_name_tsv"
+	postgresTextSearchConfigName   = "simple"
+	postgresTextSearchQueryLiteral = "websearch_to_tsquery('" + postgresTextSearchConfigName + "', ?)"
+)
+
+// postgresSearch relies on the tsvector columns generated by the database from posts.content and
+// accounts.account_name, so rows are indexed as soon as they are written and there is nothing to
+// keep in sync from the application side.
+type postgresSearch struct {
+	database database.Database
+	logger   *zap.Logger
+}
+
+func NewPostgresSearch(database *goqu.Database, logger *zap.Logger) Search {
+	return &postgresSearch{
+		database: database,
+		logger:   logger,
+	}
+}
+
+func (p postgresSearch) IndexPost(_ context.Context, _ PostDocument) error {
+	return nil
+}
+
+func (p postgresSearch) DeletePost(_ context.Context, _ uint64) error {
+	return nil
+}
+
+func (p postgresSearch) IndexAccount(_ context.Context, _ AccountDocument) error {
+	return nil
+}
+
+func (p postgresSearch) search(
+	ctx context.Context,
+	table interface{},
+	idColumn string,
+	tsvColumn string,
+	query string,
+	offset uint64,
+	limit uint64,
+	filters ...exp.Expression,
+) ([]uint64, error) {
+	var ids []uint64
+	err := p.database.
+		From(table).
+		Select(idColumn).
+		Where(goqu.L("? @@ "+postgresTextSearchQueryLiteral, goqu.C(tsvColumn), query)).
+		Where(filters...).
+		Order(
+			goqu.L("ts_rank_cd(?, "+postgresTextSearchQueryLiteral+")", goqu.C(tsvColumn), query).Desc(),
+			goqu.C(idColumn).Desc(),
+		).
+		Offset(uint(offset)).
+		Limit(uint(limit)).
+		ScanValsContext(ctx, &ids)
+	return ids, err
+}
+
+func (p postgresSearch) SearchPosts(ctx context.Context, query string, offset uint64, limit uint64) ([]uint64, error) {
+	logger := utils.LoggerWithContext(ctx, p.logger).With(zap.String("query", query))
+
+	postIDs, err := p.search(ctx, database.TabNamePosts, database.ColNamePostsID, colNamePostsContentTSV, query, offset, limit,
+		goqu.C(database.ColNamePostsStatus).Eq(database.PostStatusPublished),
+		goqu.C(database.ColNamePostsRemovedAt).IsNull(),
+		goqu.C(database.ColNamePostsDeletedAt).IsNull(),
+	)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to search posts")
+		return nil, status.Error(codes.Internal, "failed to search posts")
+	}
+	return postIDs, nil
+}
+
+func (p postgresSearch) SearchAccounts(ctx context.Context, query string, offset uint64, limit uint64) ([]uint64, error) {
+	logger := utils.LoggerWithContext(ctx, p.logger).With(zap.String("query", query))
+
+	accountIDs, err := p.search(ctx, database.TabNameAccounts, database.ColNameAccountsID, colNameAccountsAccountNameTSV, query, offset, limit)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to search accounts")
+		return nil, status.Error(codes.Internal, "failed to search accounts")
+	}
+	return accountIDs, nil
+}
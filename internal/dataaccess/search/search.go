@@ -0,0 +1,46 @@
+package search
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/doug-martin/goqu/v9"
+	"go.uber.org/zap"
+
+	"GoFeed/internal/configs"
+)
+
+type PostDocument struct {
+	ID      uint64
+	Content string
+}
+
+type AccountDocument struct {
+	ID          uint64
+	AccountName string
+}
+
+// Search finds posts and accounts matching a free text query. Plain words must all match, and words
+// wrapped in double quotes must appear next to each other. Results are ordered by relevance.
+type Search interface {
+	IndexPost(ctx context.Context, post PostDocument) error
+	DeletePost(ctx context.Context, postID uint64) error
+	IndexAccount(ctx context.Context, account AccountDocument) error
+	SearchPosts(ctx context.Context, query string, offset uint64, limit uint64) ([]uint64, error)
+	SearchAccounts(ctx context.Context, query string, offset uint64, limit uint64) ([]uint64, error)
+}
+
+func NewSearch(
+	searchConfig configs.Search,
+	goquDatabase *goqu.Database,
+	logger *zap.Logger,
+) (Search, error) {
+	switch searchConfig.Type {
+	case configs.SearchTypePostgres:
+		return NewPostgresSearch(goquDatabase, logger), nil
+	case configs.SearchTypeInMemory:
+		return NewInMemorySearch(logger), nil
+	default:
+		return nil, fmt.Errorf("unsupported search type: %s", searchConfig.Type)
+	}
+}
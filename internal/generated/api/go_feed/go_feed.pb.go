@@ -26,7 +26,7 @@ var file_api_go_feed_go_feed_proto_rawDesc = []byte{
 	0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x67, 0x6f, 0x5f,
 	0x66, 0x65, 0x65, 0x64, 0x1a, 0x26, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65,
 	0x64, 0x2f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x72, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0xb7, 0x0f, 0x0a,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0xec, 0x2f, 0x0a,
 	0x0d, 0x47, 0x6f, 0x46, 0x65, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x50,
 	0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
 	0x1d, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
@@ -38,219 +38,670 @@ var file_api_go_feed_go_feed_proto_rawDesc = []byte{
 	0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x1a, 0x1e, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
 	0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x47, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74,
-	0x12, 0x1a, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x67,
-	0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0b, 0x47,
-	0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x49, 0x44, 0x12, 0x1b, 0x2e, 0x67, 0x6f, 0x5f,
-	0x66, 0x65, 0x65, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x49, 0x44,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65,
-	0x64, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x6f,
-	0x73, 0x74, 0x4f, 0x66, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x2e, 0x67, 0x6f,
-	0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x4f, 0x66, 0x41,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e,
-	0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x4f,
-	0x66, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x47, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74,
-	0x12, 0x1a, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x67,
-	0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0a, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6b, 0x65, 0x12, 0x1a, 0x2e, 0x67, 0x6f, 0x5f, 0x66,
-	0x65, 0x65, 0x64, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x66, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x22, 0x2e, 0x67, 0x6f, 0x5f,
-	0x66, 0x65, 0x65, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x4f, 0x66, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23,
-	0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6b, 0x65,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x66, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x68, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6b, 0x65,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x4f, 0x66, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x25,
+	0x22, 0x00, 0x12, 0x53, 0x0a, 0x0e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x12, 0x1e, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x4c, 0x69,
+	0x6b, 0x65, 0x73, 0x48, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x1e, 0x2e, 0x67, 0x6f, 0x5f, 0x66,
+	0x65, 0x65, 0x64, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x48, 0x69, 0x64, 0x64,
+	0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x67, 0x6f, 0x5f, 0x66,
+	0x65, 0x65, 0x64, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x48, 0x69, 0x64, 0x64,
+	0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x11,
+	0x53, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74,
+	0x65, 0x12, 0x21, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x53, 0x65, 0x74, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x53,
+	0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0a, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x1a, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65,
+	0x65, 0x64, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x42, 0x79,
+	0x49, 0x44, 0x12, 0x1b, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x47, 0x65, 0x74,
+	0x50, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1c, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73,
+	0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x59, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x4f, 0x66, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x20, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x47, 0x65,
+	0x74, 0x50, 0x6f, 0x73, 0x74, 0x4f, 0x66, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e,
+	0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x4f, 0x66, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0a, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x1a, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65,
+	0x65, 0x64, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73,
+	0x74, 0x12, 0x1a, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e,
+	0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0b,
+	0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x1b, 0x2e, 0x67, 0x6f,
+	0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x6f, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65,
+	0x65, 0x64, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x20, 0x2e, 0x67,
+	0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21,
+	0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x42,
+	0x79, 0x48, 0x61, 0x73, 0x68, 0x74, 0x61, 0x67, 0x12, 0x21, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65,
+	0x65, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x42, 0x79, 0x48, 0x61, 0x73,
+	0x68, 0x74, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x67, 0x6f,
+	0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x42, 0x79,
+	0x48, 0x61, 0x73, 0x68, 0x74, 0x61, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x74, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x4d, 0x65, 0x6e,
+	0x74, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x29,
+	0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74,
+	0x73, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x67, 0x6f, 0x5f, 0x66,
+	0x65, 0x65, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x4d, 0x65, 0x6e, 0x74,
+	0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0b, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x1b, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64,
+	0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x72, 0x61,
+	0x66, 0x74, 0x12, 0x1b, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1c, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x47, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x72, 0x61, 0x66, 0x74, 0x73, 0x12, 0x1a, 0x2e,
+	0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x72, 0x61, 0x66,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x67, 0x6f, 0x5f, 0x66,
+	0x65, 0x65, 0x64, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x72, 0x61, 0x66, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x44, 0x72, 0x61, 0x66, 0x74, 0x12, 0x1b, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65,
+	0x64, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0c, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x44,
+	0x72, 0x61, 0x66, 0x74, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x50, 0x75, 0x62,
+	0x6c, 0x69, 0x73, 0x68, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x07, 0x50, 0x69, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x17,
+	0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x50, 0x69, 0x6e, 0x50, 0x6f, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65,
+	0x64, 0x2e, 0x50, 0x69, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x09, 0x55, 0x6e, 0x70, 0x69, 0x6e, 0x50, 0x6f, 0x73, 0x74,
+	0x12, 0x19, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x55, 0x6e, 0x70, 0x69, 0x6e,
+	0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x67, 0x6f,
+	0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x55, 0x6e, 0x70, 0x69, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x1e, 0x2e, 0x67, 0x6f,
+	0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b,
+	0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x67, 0x6f,
+	0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b,
+	0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53,
+	0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b,
+	0x12, 0x1e, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1f, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x6d,
+	0x61, 0x72, 0x6b, 0x73, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x54, 0x72, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x48, 0x61, 0x73, 0x68, 0x74, 0x61, 0x67, 0x73, 0x12, 0x23, 0x2e, 0x67,
+	0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x48, 0x61, 0x73, 0x68, 0x74, 0x61, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x24, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x54,
+	0x72, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x48, 0x61, 0x73, 0x68, 0x74, 0x61, 0x67, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x10, 0x47, 0x65, 0x74,
+	0x54, 0x72, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x20, 0x2e,
+	0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x21, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x08, 0x56, 0x6f, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x6c,
+	0x12, 0x18, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x50,
+	0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x67, 0x6f, 0x5f,
+	0x66, 0x65, 0x65, 0x64, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x50, 0x6f,
+	0x6c, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x1e, 0x2e, 0x67, 0x6f, 0x5f, 0x66,
+	0x65, 0x65, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x67, 0x6f, 0x5f, 0x66,
+	0x65, 0x65, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0a,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6b, 0x65, 0x12, 0x1a, 0x2e, 0x67, 0x6f, 0x5f,
+	0x66, 0x65, 0x65, 0x64, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6b, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x52, 0x65, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x53,
+	0x65, 0x74, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1c, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x53, 0x65, 0x74, 0x52,
+	0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x5f, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x4f, 0x66, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x22, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65,
+	0x64, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x66,
+	0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x67, 0x6f,
+	0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x4f, 0x66, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x68, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x4f, 0x66, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x25, 0x2e, 0x67, 0x6f,
+	0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x4f, 0x66, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x26, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x47, 0x65, 0x74,
+	0x4c, 0x69, 0x6b, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x4f, 0x66, 0x50, 0x6f,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0a,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x69, 0x6b, 0x65, 0x12, 0x1a, 0x2e, 0x67, 0x6f, 0x5f,
+	0x66, 0x65, 0x65, 0x64, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x69, 0x6b, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
+	0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65,
+	0x64, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65,
+	0x64, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0d, 0x48, 0x61,
+	0x73, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x1d, 0x2e, 0x67, 0x6f,
+	0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x48, 0x61, 0x73, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x50, 0x6f,
+	0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x67, 0x6f, 0x5f,
+	0x66, 0x65, 0x65, 0x64, 0x2e, 0x48, 0x61, 0x73, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x50, 0x6f, 0x73,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6b, 0x0a, 0x16,
+	0x47, 0x65, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x4f, 0x66, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64,
+	0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x4f, 0x66,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27,
 	0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6b, 0x65,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x4f, 0x66, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e,
-	0x47, 0x65, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x4f,
-	0x66, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x47, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x69, 0x6b, 0x65, 0x12, 0x1a, 0x2e,
-	0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x69,
-	0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x67, 0x6f, 0x5f, 0x66,
-	0x65, 0x65, 0x64, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x5f, 0x66,
-	0x65, 0x65, 0x64, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65,
-	0x65, 0x64, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x68, 0x0a, 0x15, 0x47, 0x65,
-	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x66, 0x50,
-	0x6f, 0x73, 0x74, 0x12, 0x25, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x47, 0x65,
-	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x66, 0x50,
-	0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x67, 0x6f, 0x5f,
+	0x64, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x4f, 0x66, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x11, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x12, 0x21,
+	0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x22, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x68, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4c, 0x69,
+	0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x66, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x25, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69,
+	0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x66, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65,
+	0x64, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x66,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x5c, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x12, 0x21, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69,
+	0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x67, 0x6f, 0x5f, 0x66,
+	0x65, 0x65, 0x64, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x50, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1e, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x68, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x66, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x25, 0x2e, 0x67, 0x6f, 0x5f,
 	0x66, 0x65, 0x65, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x66, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x74, 0x73, 0x4f, 0x66, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x21, 0x2e, 0x67, 0x6f, 0x5f, 0x66,
-	0x65, 0x65, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x4f,
-	0x66, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x67,
-	0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x74, 0x73, 0x4f, 0x66, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x50, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64,
-	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x74, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c,
-	0x6f, 0x77, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x66, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x29, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x47, 0x65, 0x74,
-	0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x66, 0x41,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e,
-	0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f,
-	0x77, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x66, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x68, 0x0a, 0x15, 0x47,
-	0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x4f, 0x66, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x47,
-	0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x4f, 0x66, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x67, 0x6f,
+	0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x66, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x26, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x66, 0x50, 0x6f, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x11, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x4f, 0x66, 0x50, 0x6f, 0x73, 0x74,
+	0x12, 0x21, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x4f, 0x66, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x4f, 0x66, 0x50, 0x6f, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x11, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x12, 0x21,
+	0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x22, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65,
+	0x65, 0x64, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65,
+	0x64, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0d, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x5f,
+	0x66, 0x65, 0x65, 0x64, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x67, 0x6f, 0x5f, 0x66,
+	0x65, 0x65, 0x64, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0b, 0x48,
+	0x69, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x2e, 0x67, 0x6f, 0x5f,
+	0x66, 0x65, 0x65, 0x64, 0x2e, 0x48, 0x69, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65,
+	0x64, 0x2e, 0x48, 0x69, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0d, 0x55, 0x6e, 0x68, 0x69, 0x64,
+	0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65,
+	0x65, 0x64, 0x2e, 0x55, 0x6e, 0x68, 0x69, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65,
+	0x64, 0x2e, 0x55, 0x6e, 0x68, 0x69, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x10, 0x53, 0x65, 0x74,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x20, 0x2e,
+	0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x21, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x74, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
+	0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x66, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x29, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x66, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x67, 0x6f,
 	0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65,
-	0x72, 0x73, 0x4f, 0x66, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x77, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c,
-	0x6f, 0x77, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x66, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x12, 0x2a, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x47, 0x65,
-	0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4f,
-	0x66, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x2b, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c,
-	0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x66, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6b,
-	0x0a, 0x16, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x73, 0x4f,
-	0x66, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65,
-	0x65, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x73,
-	0x4f, 0x66, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x27, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x6f,
-	0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x73, 0x4f, 0x66, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0c, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x12, 0x1c, 0x2e, 0x67, 0x6f,
-	0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x6f, 0x6c, 0x6c,
-	0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x67, 0x6f, 0x5f, 0x66,
+	0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x66, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x68, 0x0a, 0x15, 0x47, 0x65, 0x74,
+	0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x4f, 0x66, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x25, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x47, 0x65, 0x74,
+	0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x4f, 0x66, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x67, 0x6f, 0x5f, 0x66,
+	0x65, 0x65, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73,
+	0x4f, 0x66, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x77, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
+	0x69, 0x6e, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x66, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x2a, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x46,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x66, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e,
+	0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f,
+	0x77, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x66, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6b, 0x0a, 0x16,
+	0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x73, 0x4f, 0x66, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64,
+	0x2e, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x73, 0x4f, 0x66,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27,
+	0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x77, 0x69, 0x6e, 0x67, 0x73, 0x4f, 0x66, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0c, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x5f, 0x66,
 	0x65, 0x65, 0x64, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0b, 0x47, 0x65,
-	0x74, 0x4e, 0x65, 0x77, 0x46, 0x65, 0x65, 0x64, 0x73, 0x12, 0x1b, 0x2e, 0x67, 0x6f, 0x5f, 0x66,
-	0x65, 0x65, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x77, 0x46, 0x65, 0x65, 0x64, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64,
-	0x2e, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x77, 0x46, 0x65, 0x65, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x15, 0x5a, 0x13, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x6f,
-	0x5f, 0x66, 0x65, 0x65, 0x64, 0x3b, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65,
+	0x64, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74,
+	0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x22,
+	0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c,
+	0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x23, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x13, 0x41, 0x63, 0x63,
+	0x65, 0x70, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x23, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70,
+	0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e,
+	0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x62, 0x0a,
+	0x13, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x52,
+	0x65, 0x6a, 0x65, 0x63, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x67, 0x6f, 0x5f, 0x66,
+	0x65, 0x65, 0x64, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x65, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x75,
+	0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x24, 0x2e, 0x67, 0x6f, 0x5f, 0x66,
+	0x65, 0x65, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x75, 0x67,
+	0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x25, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c,
+	0x6c, 0x6f, 0x77, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x73, 0x12, 0x20, 0x2e, 0x67,
+	0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21,
+	0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1d, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x53, 0x0a, 0x0e, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x55,
+	0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x55,
+	0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x23,
+	0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x65, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0b, 0x4d,
+	0x75, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x2e, 0x67, 0x6f, 0x5f,
+	0x66, 0x65, 0x65, 0x64, 0x2e, 0x4d, 0x75, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65,
+	0x64, 0x2e, 0x4d, 0x75, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0d, 0x55, 0x6e, 0x6d, 0x75, 0x74,
+	0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65,
+	0x65, 0x64, 0x2e, 0x55, 0x6e, 0x6d, 0x75, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65,
+	0x64, 0x2e, 0x55, 0x6e, 0x6d, 0x75, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x11, 0x4c, 0x69, 0x73,
+	0x74, 0x4d, 0x75, 0x74, 0x65, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x21,
+	0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x75, 0x74,
+	0x65, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x22, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x4d, 0x75, 0x74, 0x65, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4e, 0x65,
+	0x77, 0x46, 0x65, 0x65, 0x64, 0x73, 0x12, 0x1b, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64,
+	0x2e, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x77, 0x46, 0x65, 0x65, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x47, 0x65,
+	0x74, 0x4e, 0x65, 0x77, 0x46, 0x65, 0x65, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0d, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x73, 0x12, 0x1b, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1c, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x50, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x52, 0x65, 0x73,
+	0x6f, 0x6c, 0x76, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1e, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x52, 0x65, 0x73, 0x6f,
+	0x6c, 0x76, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x0f, 0x54, 0x61, 0x6b, 0x65, 0x64, 0x6f, 0x77, 0x6e, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64,
+	0x2e, 0x54, 0x61, 0x6b, 0x65, 0x64, 0x6f, 0x77, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65,
+	0x64, 0x2e, 0x54, 0x61, 0x6b, 0x65, 0x64, 0x6f, 0x77, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x15, 0x5a, 0x13, 0x61,
+	0x70, 0x69, 0x2f, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x3b, 0x67, 0x6f, 0x5f, 0x66, 0x65,
+	0x65, 0x64, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var file_api_go_feed_go_feed_proto_goTypes = []any{
 	(*CreateAccountRequest)(nil),               // 0: go_feed.CreateAccountRequest
 	(*CreateSessionRequest)(nil),               // 1: go_feed.CreateSessionRequest
-	(*CreatePostRequest)(nil),                  // 2: go_feed.CreatePostRequest
-	(*GetPostByIDRequest)(nil),                 // 3: go_feed.GetPostByIDRequest
-	(*GetPostOfAccountRequest)(nil),            // 4: go_feed.GetPostOfAccountRequest
-	(*UpdatePostRequest)(nil),                  // 5: go_feed.UpdatePostRequest
-	(*CreateLikeRequest)(nil),                  // 6: go_feed.CreateLikeRequest
-	(*GetLikeCountOfPostRequest)(nil),          // 7: go_feed.GetLikeCountOfPostRequest
-	(*GetLikeAccountsOfPostRequest)(nil),       // 8: go_feed.GetLikeAccountsOfPostRequest
-	(*DeleteLikeRequest)(nil),                  // 9: go_feed.DeleteLikeRequest
-	(*CreateCommentRequest)(nil),               // 10: go_feed.CreateCommentRequest
-	(*GetCommentCountOfPostRequest)(nil),       // 11: go_feed.GetCommentCountOfPostRequest
-	(*GetCommentsOfPostRequest)(nil),           // 12: go_feed.GetCommentsOfPostRequest
-	(*UpdateCommentRequest)(nil),               // 13: go_feed.UpdateCommentRequest
-	(*DeleteCommentRequest)(nil),               // 14: go_feed.DeleteCommentRequest
-	(*CreateFollowRequest)(nil),                // 15: go_feed.CreateFollowRequest
-	(*GetFollowerCountOfAccountRequest)(nil),   // 16: go_feed.GetFollowerCountOfAccountRequest
-	(*GetFollowersOfAccountRequest)(nil),       // 17: go_feed.GetFollowersOfAccountRequest
-	(*GetFollowingCountOfAccountRequest)(nil),  // 18: go_feed.GetFollowingCountOfAccountRequest
-	(*GetFollowingsOfAccountRequest)(nil),      // 19: go_feed.GetFollowingsOfAccountRequest
-	(*DeleteFollowRequest)(nil),                // 20: go_feed.DeleteFollowRequest
-	(*GetNewFeedsRequest)(nil),                 // 21: go_feed.GetNewFeedsRequest
-	(*CreateAccountResponse)(nil),              // 22: go_feed.CreateAccountResponse
-	(*CreateSessionResponse)(nil),              // 23: go_feed.CreateSessionResponse
-	(*CreatePostResponse)(nil),                 // 24: go_feed.CreatePostResponse
-	(*GetPostByIDResponse)(nil),                // 25: go_feed.GetPostByIDResponse
-	(*GetPostOfAccountResponse)(nil),           // 26: go_feed.GetPostOfAccountResponse
-	(*UpdatePostResponse)(nil),                 // 27: go_feed.UpdatePostResponse
-	(*CreateLikeResponse)(nil),                 // 28: go_feed.CreateLikeResponse
-	(*GetLikeCountOfPostResponse)(nil),         // 29: go_feed.GetLikeCountOfPostResponse
-	(*GetLikeAccountsOfPostResponse)(nil),      // 30: go_feed.GetLikeAccountsOfPostResponse
-	(*DeleteLikeResponse)(nil),                 // 31: go_feed.DeleteLikeResponse
-	(*CreateCommentResponse)(nil),              // 32: go_feed.CreateCommentResponse
-	(*GetCommentCountOfPostResponse)(nil),      // 33: go_feed.GetCommentCountOfPostResponse
-	(*GetCommentsOfPostResponse)(nil),          // 34: go_feed.GetCommentsOfPostResponse
-	(*UpdateCommentResponse)(nil),              // 35: go_feed.UpdateCommentResponse
-	(*DeleteCommentResponse)(nil),              // 36: go_feed.DeleteCommentResponse
-	(*CreateFollowResponse)(nil),               // 37: go_feed.CreateFollowResponse
-	(*GetFollowerCountOfAccountResponse)(nil),  // 38: go_feed.GetFollowerCountOfAccountResponse
-	(*GetFollowersOfAccountResponse)(nil),      // 39: go_feed.GetFollowersOfAccountResponse
-	(*GetFollowingCountOfAccountResponse)(nil), // 40: go_feed.GetFollowingCountOfAccountResponse
-	(*GetFollowingsOfAccountResponse)(nil),     // 41: go_feed.GetFollowingsOfAccountResponse
-	(*DeleteFollowResponse)(nil),               // 42: go_feed.DeleteFollowResponse
-	(*GetNewFeedsResponse)(nil),                // 43: go_feed.GetNewFeedsResponse
+	(*SearchAccountsRequest)(nil),              // 2: go_feed.SearchAccountsRequest
+	(*SetLikesHiddenRequest)(nil),              // 3: go_feed.SetLikesHiddenRequest
+	(*SetAccountPrivateRequest)(nil),           // 4: go_feed.SetAccountPrivateRequest
+	(*CreatePostRequest)(nil),                  // 5: go_feed.CreatePostRequest
+	(*GetPostByIDRequest)(nil),                 // 6: go_feed.GetPostByIDRequest
+	(*GetPostOfAccountRequest)(nil),            // 7: go_feed.GetPostOfAccountRequest
+	(*UpdatePostRequest)(nil),                  // 8: go_feed.UpdatePostRequest
+	(*DeletePostRequest)(nil),                  // 9: go_feed.DeletePostRequest
+	(*RestorePostRequest)(nil),                 // 10: go_feed.RestorePostRequest
+	(*ListDeletedPostsRequest)(nil),            // 11: go_feed.ListDeletedPostsRequest
+	(*GetPostsByHashtagRequest)(nil),           // 12: go_feed.GetPostsByHashtagRequest
+	(*GetPostsMentioningAccountRequest)(nil),   // 13: go_feed.GetPostsMentioningAccountRequest
+	(*SearchPostsRequest)(nil),                 // 14: go_feed.SearchPostsRequest
+	(*CreateDraftRequest)(nil),                 // 15: go_feed.CreateDraftRequest
+	(*ListDraftsRequest)(nil),                  // 16: go_feed.ListDraftsRequest
+	(*UpdateDraftRequest)(nil),                 // 17: go_feed.UpdateDraftRequest
+	(*PublishDraftRequest)(nil),                // 18: go_feed.PublishDraftRequest
+	(*PinPostRequest)(nil),                     // 19: go_feed.PinPostRequest
+	(*UnpinPostRequest)(nil),                   // 20: go_feed.UnpinPostRequest
+	(*CreateBookmarkRequest)(nil),              // 21: go_feed.CreateBookmarkRequest
+	(*DeleteBookmarkRequest)(nil),              // 22: go_feed.DeleteBookmarkRequest
+	(*ListBookmarksRequest)(nil),               // 23: go_feed.ListBookmarksRequest
+	(*GetTrendingHashtagsRequest)(nil),         // 24: go_feed.GetTrendingHashtagsRequest
+	(*GetTrendingPostsRequest)(nil),            // 25: go_feed.GetTrendingPostsRequest
+	(*VotePollRequest)(nil),                    // 26: go_feed.VotePollRequest
+	(*GetPollResultsRequest)(nil),              // 27: go_feed.GetPollResultsRequest
+	(*CreateLikeRequest)(nil),                  // 28: go_feed.CreateLikeRequest
+	(*SetReactionRequest)(nil),                 // 29: go_feed.SetReactionRequest
+	(*GetLikeCountOfPostRequest)(nil),          // 30: go_feed.GetLikeCountOfPostRequest
+	(*GetLikeAccountsOfPostRequest)(nil),       // 31: go_feed.GetLikeAccountsOfPostRequest
+	(*DeleteLikeRequest)(nil),                  // 32: go_feed.DeleteLikeRequest
+	(*DeleteReactionRequest)(nil),              // 33: go_feed.DeleteReactionRequest
+	(*HasLikedPostsRequest)(nil),               // 34: go_feed.HasLikedPostsRequest
+	(*GetLikedPostsOfAccountRequest)(nil),      // 35: go_feed.GetLikedPostsOfAccountRequest
+	(*CreateCommentLikeRequest)(nil),           // 36: go_feed.CreateCommentLikeRequest
+	(*GetLikeCountOfCommentRequest)(nil),       // 37: go_feed.GetLikeCountOfCommentRequest
+	(*DeleteCommentLikeRequest)(nil),           // 38: go_feed.DeleteCommentLikeRequest
+	(*CreateCommentRequest)(nil),               // 39: go_feed.CreateCommentRequest
+	(*GetCommentCountOfPostRequest)(nil),       // 40: go_feed.GetCommentCountOfPostRequest
+	(*GetCommentsOfPostRequest)(nil),           // 41: go_feed.GetCommentsOfPostRequest
+	(*GetCommentRepliesRequest)(nil),           // 42: go_feed.GetCommentRepliesRequest
+	(*UpdateCommentRequest)(nil),               // 43: go_feed.UpdateCommentRequest
+	(*DeleteCommentRequest)(nil),               // 44: go_feed.DeleteCommentRequest
+	(*HideCommentRequest)(nil),                 // 45: go_feed.HideCommentRequest
+	(*UnhideCommentRequest)(nil),               // 46: go_feed.UnhideCommentRequest
+	(*SetCommentPolicyRequest)(nil),            // 47: go_feed.SetCommentPolicyRequest
+	(*CreateFollowRequest)(nil),                // 48: go_feed.CreateFollowRequest
+	(*GetFollowerCountOfAccountRequest)(nil),   // 49: go_feed.GetFollowerCountOfAccountRequest
+	(*GetFollowersOfAccountRequest)(nil),       // 50: go_feed.GetFollowersOfAccountRequest
+	(*GetFollowingCountOfAccountRequest)(nil),  // 51: go_feed.GetFollowingCountOfAccountRequest
+	(*GetFollowingsOfAccountRequest)(nil),      // 52: go_feed.GetFollowingsOfAccountRequest
+	(*DeleteFollowRequest)(nil),                // 53: go_feed.DeleteFollowRequest
+	(*ListFollowRequestsRequest)(nil),          // 54: go_feed.ListFollowRequestsRequest
+	(*AcceptFollowRequestRequest)(nil),         // 55: go_feed.AcceptFollowRequestRequest
+	(*RejectFollowRequestRequest)(nil),         // 56: go_feed.RejectFollowRequestRequest
+	(*GetFollowSuggestionsRequest)(nil),        // 57: go_feed.GetFollowSuggestionsRequest
+	(*GetRelationshipsRequest)(nil),            // 58: go_feed.GetRelationshipsRequest
+	(*BlockAccountRequest)(nil),                // 59: go_feed.BlockAccountRequest
+	(*UnblockAccountRequest)(nil),              // 60: go_feed.UnblockAccountRequest
+	(*ListBlockedAccountsRequest)(nil),         // 61: go_feed.ListBlockedAccountsRequest
+	(*MuteAccountRequest)(nil),                 // 62: go_feed.MuteAccountRequest
+	(*UnmuteAccountRequest)(nil),               // 63: go_feed.UnmuteAccountRequest
+	(*ListMutedAccountsRequest)(nil),           // 64: go_feed.ListMutedAccountsRequest
+	(*GetNewFeedsRequest)(nil),                 // 65: go_feed.GetNewFeedsRequest
+	(*ReportContentRequest)(nil),               // 66: go_feed.ReportContentRequest
+	(*ListReportsRequest)(nil),                 // 67: go_feed.ListReportsRequest
+	(*ResolveReportRequest)(nil),               // 68: go_feed.ResolveReportRequest
+	(*TakedownContentRequest)(nil),             // 69: go_feed.TakedownContentRequest
+	(*CreateAccountResponse)(nil),              // 70: go_feed.CreateAccountResponse
+	(*CreateSessionResponse)(nil),              // 71: go_feed.CreateSessionResponse
+	(*SearchAccountsResponse)(nil),             // 72: go_feed.SearchAccountsResponse
+	(*SetLikesHiddenResponse)(nil),             // 73: go_feed.SetLikesHiddenResponse
+	(*SetAccountPrivateResponse)(nil),          // 74: go_feed.SetAccountPrivateResponse
+	(*CreatePostResponse)(nil),                 // 75: go_feed.CreatePostResponse
+	(*GetPostByIDResponse)(nil),                // 76: go_feed.GetPostByIDResponse
+	(*GetPostOfAccountResponse)(nil),           // 77: go_feed.GetPostOfAccountResponse
+	(*UpdatePostResponse)(nil),                 // 78: go_feed.UpdatePostResponse
+	(*DeletePostResponse)(nil),                 // 79: go_feed.DeletePostResponse
+	(*RestorePostResponse)(nil),                // 80: go_feed.RestorePostResponse
+	(*ListDeletedPostsResponse)(nil),           // 81: go_feed.ListDeletedPostsResponse
+	(*GetPostsByHashtagResponse)(nil),          // 82: go_feed.GetPostsByHashtagResponse
+	(*GetPostsMentioningAccountResponse)(nil),  // 83: go_feed.GetPostsMentioningAccountResponse
+	(*SearchPostsResponse)(nil),                // 84: go_feed.SearchPostsResponse
+	(*CreateDraftResponse)(nil),                // 85: go_feed.CreateDraftResponse
+	(*ListDraftsResponse)(nil),                 // 86: go_feed.ListDraftsResponse
+	(*UpdateDraftResponse)(nil),                // 87: go_feed.UpdateDraftResponse
+	(*PublishDraftResponse)(nil),               // 88: go_feed.PublishDraftResponse
+	(*PinPostResponse)(nil),                    // 89: go_feed.PinPostResponse
+	(*UnpinPostResponse)(nil),                  // 90: go_feed.UnpinPostResponse
+	(*CreateBookmarkResponse)(nil),             // 91: go_feed.CreateBookmarkResponse
+	(*DeleteBookmarkResponse)(nil),             // 92: go_feed.DeleteBookmarkResponse
+	(*ListBookmarksResponse)(nil),              // 93: go_feed.ListBookmarksResponse
+	(*GetTrendingHashtagsResponse)(nil),        // 94: go_feed.GetTrendingHashtagsResponse
+	(*GetTrendingPostsResponse)(nil),           // 95: go_feed.GetTrendingPostsResponse
+	(*VotePollResponse)(nil),                   // 96: go_feed.VotePollResponse
+	(*GetPollResultsResponse)(nil),             // 97: go_feed.GetPollResultsResponse
+	(*CreateLikeResponse)(nil),                 // 98: go_feed.CreateLikeResponse
+	(*SetReactionResponse)(nil),                // 99: go_feed.SetReactionResponse
+	(*GetLikeCountOfPostResponse)(nil),         // 100: go_feed.GetLikeCountOfPostResponse
+	(*GetLikeAccountsOfPostResponse)(nil),      // 101: go_feed.GetLikeAccountsOfPostResponse
+	(*DeleteLikeResponse)(nil),                 // 102: go_feed.DeleteLikeResponse
+	(*DeleteReactionResponse)(nil),             // 103: go_feed.DeleteReactionResponse
+	(*HasLikedPostsResponse)(nil),              // 104: go_feed.HasLikedPostsResponse
+	(*GetLikedPostsOfAccountResponse)(nil),     // 105: go_feed.GetLikedPostsOfAccountResponse
+	(*CreateCommentLikeResponse)(nil),          // 106: go_feed.CreateCommentLikeResponse
+	(*GetLikeCountOfCommentResponse)(nil),      // 107: go_feed.GetLikeCountOfCommentResponse
+	(*DeleteCommentLikeResponse)(nil),          // 108: go_feed.DeleteCommentLikeResponse
+	(*CreateCommentResponse)(nil),              // 109: go_feed.CreateCommentResponse
+	(*GetCommentCountOfPostResponse)(nil),      // 110: go_feed.GetCommentCountOfPostResponse
+	(*GetCommentsOfPostResponse)(nil),          // 111: go_feed.GetCommentsOfPostResponse
+	(*GetCommentRepliesResponse)(nil),          // 112: go_feed.GetCommentRepliesResponse
+	(*UpdateCommentResponse)(nil),              // 113: go_feed.UpdateCommentResponse
+	(*DeleteCommentResponse)(nil),              // 114: go_feed.DeleteCommentResponse
+	(*HideCommentResponse)(nil),                // 115: go_feed.HideCommentResponse
+	(*UnhideCommentResponse)(nil),              // 116: go_feed.UnhideCommentResponse
+	(*SetCommentPolicyResponse)(nil),           // 117: go_feed.SetCommentPolicyResponse
+	(*CreateFollowResponse)(nil),               // 118: go_feed.CreateFollowResponse
+	(*GetFollowerCountOfAccountResponse)(nil),  // 119: go_feed.GetFollowerCountOfAccountResponse
+	(*GetFollowersOfAccountResponse)(nil),      // 120: go_feed.GetFollowersOfAccountResponse
+	(*GetFollowingCountOfAccountResponse)(nil), // 121: go_feed.GetFollowingCountOfAccountResponse
+	(*GetFollowingsOfAccountResponse)(nil),     // 122: go_feed.GetFollowingsOfAccountResponse
+	(*DeleteFollowResponse)(nil),               // 123: go_feed.DeleteFollowResponse
+	(*ListFollowRequestsResponse)(nil),         // 124: go_feed.ListFollowRequestsResponse
+	(*AcceptFollowRequestResponse)(nil),        // 125: go_feed.AcceptFollowRequestResponse
+	(*RejectFollowRequestResponse)(nil),        // 126: go_feed.RejectFollowRequestResponse
+	(*GetFollowSuggestionsResponse)(nil),       // 127: go_feed.GetFollowSuggestionsResponse
+	(*GetRelationshipsResponse)(nil),           // 128: go_feed.GetRelationshipsResponse
+	(*BlockAccountResponse)(nil),               // 129: go_feed.BlockAccountResponse
+	(*UnblockAccountResponse)(nil),             // 130: go_feed.UnblockAccountResponse
+	(*ListBlockedAccountsResponse)(nil),        // 131: go_feed.ListBlockedAccountsResponse
+	(*MuteAccountResponse)(nil),                // 132: go_feed.MuteAccountResponse
+	(*UnmuteAccountResponse)(nil),              // 133: go_feed.UnmuteAccountResponse
+	(*ListMutedAccountsResponse)(nil),          // 134: go_feed.ListMutedAccountsResponse
+	(*GetNewFeedsResponse)(nil),                // 135: go_feed.GetNewFeedsResponse
+	(*ReportContentResponse)(nil),              // 136: go_feed.ReportContentResponse
+	(*ListReportsResponse)(nil),                // 137: go_feed.ListReportsResponse
+	(*ResolveReportResponse)(nil),              // 138: go_feed.ResolveReportResponse
+	(*TakedownContentResponse)(nil),            // 139: go_feed.TakedownContentResponse
 }
 var file_api_go_feed_go_feed_proto_depIdxs = []int32{
-	0,  // 0: go_feed.GoFeedService.CreateAccount:input_type -> go_feed.CreateAccountRequest
-	1,  // 1: go_feed.GoFeedService.CreateSession:input_type -> go_feed.CreateSessionRequest
-	2,  // 2: go_feed.GoFeedService.CreatePost:input_type -> go_feed.CreatePostRequest
-	3,  // 3: go_feed.GoFeedService.GetPostByID:input_type -> go_feed.GetPostByIDRequest
-	4,  // 4: go_feed.GoFeedService.GetPostOfAccount:input_type -> go_feed.GetPostOfAccountRequest
-	5,  // 5: go_feed.GoFeedService.UpdatePost:input_type -> go_feed.UpdatePostRequest
-	6,  // 6: go_feed.GoFeedService.CreateLike:input_type -> go_feed.CreateLikeRequest
-	7,  // 7: go_feed.GoFeedService.GetLikeCountOfPost:input_type -> go_feed.GetLikeCountOfPostRequest
-	8,  // 8: go_feed.GoFeedService.GetLikeAccountsOfPost:input_type -> go_feed.GetLikeAccountsOfPostRequest
-	9,  // 9: go_feed.GoFeedService.DeleteLike:input_type -> go_feed.DeleteLikeRequest
-	10, // 10: go_feed.GoFeedService.CreateComment:input_type -> go_feed.CreateCommentRequest
-	11, // 11: go_feed.GoFeedService.GetCommentCountOfPost:input_type -> go_feed.GetCommentCountOfPostRequest
-	12, // 12: go_feed.GoFeedService.GetCommentsOfPost:input_type -> go_feed.GetCommentsOfPostRequest
-	13, // 13: go_feed.GoFeedService.UpdateComment:input_type -> go_feed.UpdateCommentRequest
-	14, // 14: go_feed.GoFeedService.DeleteComment:input_type -> go_feed.DeleteCommentRequest
-	15, // 15: go_feed.GoFeedService.CreateFollow:input_type -> go_feed.CreateFollowRequest
-	16, // 16: go_feed.GoFeedService.GetFollowerCountOfAccount:input_type -> go_feed.GetFollowerCountOfAccountRequest
-	17, // 17: go_feed.GoFeedService.GetFollowersOfAccount:input_type -> go_feed.GetFollowersOfAccountRequest
-	18, // 18: go_feed.GoFeedService.GetFollowingCountOfAccount:input_type -> go_feed.GetFollowingCountOfAccountRequest
-	19, // 19: go_feed.GoFeedService.GetFollowingsOfAccount:input_type -> go_feed.GetFollowingsOfAccountRequest
-	20, // 20: go_feed.GoFeedService.DeleteFollow:input_type -> go_feed.DeleteFollowRequest
-	21, // 21: go_feed.GoFeedService.GetNewFeeds:input_type -> go_feed.GetNewFeedsRequest
-	22, // 22: go_feed.GoFeedService.CreateAccount:output_type -> go_feed.CreateAccountResponse
-	23, // 23: go_feed.GoFeedService.CreateSession:output_type -> go_feed.CreateSessionResponse
-	24, // 24: go_feed.GoFeedService.CreatePost:output_type -> go_feed.CreatePostResponse
-	25, // 25: go_feed.GoFeedService.GetPostByID:output_type -> go_feed.GetPostByIDResponse
-	26, // 26: go_feed.GoFeedService.GetPostOfAccount:output_type -> go_feed.GetPostOfAccountResponse
-	27, // 27: go_feed.GoFeedService.UpdatePost:output_type -> go_feed.UpdatePostResponse
-	28, // 28: go_feed.GoFeedService.CreateLike:output_type -> go_feed.CreateLikeResponse
-	29, // 29: go_feed.GoFeedService.GetLikeCountOfPost:output_type -> go_feed.GetLikeCountOfPostResponse
-	30, // 30: go_feed.GoFeedService.GetLikeAccountsOfPost:output_type -> go_feed.GetLikeAccountsOfPostResponse
-	31, // 31: go_feed.GoFeedService.DeleteLike:output_type -> go_feed.DeleteLikeResponse
-	32, // 32: go_feed.GoFeedService.CreateComment:output_type -> go_feed.CreateCommentResponse
-	33, // 33: go_feed.GoFeedService.GetCommentCountOfPost:output_type -> go_feed.GetCommentCountOfPostResponse
-	34, // 34: go_feed.GoFeedService.GetCommentsOfPost:output_type -> go_feed.GetCommentsOfPostResponse
-	35, // 35: go_feed.GoFeedService.UpdateComment:output_type -> go_feed.UpdateCommentResponse
-	36, // 36: go_feed.GoFeedService.DeleteComment:output_type -> go_feed.DeleteCommentResponse
-	37, // 37: go_feed.GoFeedService.CreateFollow:output_type -> go_feed.CreateFollowResponse
-	38, // 38: go_feed.GoFeedService.GetFollowerCountOfAccount:output_type -> go_feed.GetFollowerCountOfAccountResponse
-	39, // 39: go_feed.GoFeedService.GetFollowersOfAccount:output_type -> go_feed.GetFollowersOfAccountResponse
-	40, // 40: go_feed.GoFeedService.GetFollowingCountOfAccount:output_type -> go_feed.GetFollowingCountOfAccountResponse
-	41, // 41: go_feed.GoFeedService.GetFollowingsOfAccount:output_type -> go_feed.GetFollowingsOfAccountResponse
-	42, // 42: go_feed.GoFeedService.DeleteFollow:output_type -> go_feed.DeleteFollowResponse
-	43, // 43: go_feed.GoFeedService.GetNewFeeds:output_type -> go_feed.GetNewFeedsResponse
-	22, // [22:44] is the sub-list for method output_type
-	0,  // [0:22] is the sub-list for method input_type
-	0,  // [0:0] is the sub-list for extension type_name
-	0,  // [0:0] is the sub-list for extension extendee
-	0,  // [0:0] is the sub-list for field type_name
+	0,   // 0: go_feed.GoFeedService.CreateAccount:input_type -> go_feed.CreateAccountRequest
+	1,   // 1: go_feed.GoFeedService.CreateSession:input_type -> go_feed.CreateSessionRequest
+	2,   // 2: go_feed.GoFeedService.SearchAccounts:input_type -> go_feed.SearchAccountsRequest
+	3,   // 3: go_feed.GoFeedService.SetLikesHidden:input_type -> go_feed.SetLikesHiddenRequest
+	4,   // 4: go_feed.GoFeedService.SetAccountPrivate:input_type -> go_feed.SetAccountPrivateRequest
+	5,   // 5: go_feed.GoFeedService.CreatePost:input_type -> go_feed.CreatePostRequest
+	6,   // 6: go_feed.GoFeedService.GetPostByID:input_type -> go_feed.GetPostByIDRequest
+	7,   // 7: go_feed.GoFeedService.GetPostOfAccount:input_type -> go_feed.GetPostOfAccountRequest
+	8,   // 8: go_feed.GoFeedService.UpdatePost:input_type -> go_feed.UpdatePostRequest
+	9,   // 9: go_feed.GoFeedService.DeletePost:input_type -> go_feed.DeletePostRequest
+	10,  // 10: go_feed.GoFeedService.RestorePost:input_type -> go_feed.RestorePostRequest
+	11,  // 11: go_feed.GoFeedService.ListDeletedPosts:input_type -> go_feed.ListDeletedPostsRequest
+	12,  // 12: go_feed.GoFeedService.GetPostsByHashtag:input_type -> go_feed.GetPostsByHashtagRequest
+	13,  // 13: go_feed.GoFeedService.GetPostsMentioningAccount:input_type -> go_feed.GetPostsMentioningAccountRequest
+	14,  // 14: go_feed.GoFeedService.SearchPosts:input_type -> go_feed.SearchPostsRequest
+	15,  // 15: go_feed.GoFeedService.CreateDraft:input_type -> go_feed.CreateDraftRequest
+	16,  // 16: go_feed.GoFeedService.ListDrafts:input_type -> go_feed.ListDraftsRequest
+	17,  // 17: go_feed.GoFeedService.UpdateDraft:input_type -> go_feed.UpdateDraftRequest
+	18,  // 18: go_feed.GoFeedService.PublishDraft:input_type -> go_feed.PublishDraftRequest
+	19,  // 19: go_feed.GoFeedService.PinPost:input_type -> go_feed.PinPostRequest
+	20,  // 20: go_feed.GoFeedService.UnpinPost:input_type -> go_feed.UnpinPostRequest
+	21,  // 21: go_feed.GoFeedService.CreateBookmark:input_type -> go_feed.CreateBookmarkRequest
+	22,  // 22: go_feed.GoFeedService.DeleteBookmark:input_type -> go_feed.DeleteBookmarkRequest
+	23,  // 23: go_feed.GoFeedService.ListBookmarks:input_type -> go_feed.ListBookmarksRequest
+	24,  // 24: go_feed.GoFeedService.GetTrendingHashtags:input_type -> go_feed.GetTrendingHashtagsRequest
+	25,  // 25: go_feed.GoFeedService.GetTrendingPosts:input_type -> go_feed.GetTrendingPostsRequest
+	26,  // 26: go_feed.GoFeedService.VotePoll:input_type -> go_feed.VotePollRequest
+	27,  // 27: go_feed.GoFeedService.GetPollResults:input_type -> go_feed.GetPollResultsRequest
+	28,  // 28: go_feed.GoFeedService.CreateLike:input_type -> go_feed.CreateLikeRequest
+	29,  // 29: go_feed.GoFeedService.SetReaction:input_type -> go_feed.SetReactionRequest
+	30,  // 30: go_feed.GoFeedService.GetLikeCountOfPost:input_type -> go_feed.GetLikeCountOfPostRequest
+	31,  // 31: go_feed.GoFeedService.GetLikeAccountsOfPost:input_type -> go_feed.GetLikeAccountsOfPostRequest
+	32,  // 32: go_feed.GoFeedService.DeleteLike:input_type -> go_feed.DeleteLikeRequest
+	33,  // 33: go_feed.GoFeedService.DeleteReaction:input_type -> go_feed.DeleteReactionRequest
+	34,  // 34: go_feed.GoFeedService.HasLikedPosts:input_type -> go_feed.HasLikedPostsRequest
+	35,  // 35: go_feed.GoFeedService.GetLikedPostsOfAccount:input_type -> go_feed.GetLikedPostsOfAccountRequest
+	36,  // 36: go_feed.GoFeedService.CreateCommentLike:input_type -> go_feed.CreateCommentLikeRequest
+	37,  // 37: go_feed.GoFeedService.GetLikeCountOfComment:input_type -> go_feed.GetLikeCountOfCommentRequest
+	38,  // 38: go_feed.GoFeedService.DeleteCommentLike:input_type -> go_feed.DeleteCommentLikeRequest
+	39,  // 39: go_feed.GoFeedService.CreateComment:input_type -> go_feed.CreateCommentRequest
+	40,  // 40: go_feed.GoFeedService.GetCommentCountOfPost:input_type -> go_feed.GetCommentCountOfPostRequest
+	41,  // 41: go_feed.GoFeedService.GetCommentsOfPost:input_type -> go_feed.GetCommentsOfPostRequest
+	42,  // 42: go_feed.GoFeedService.GetCommentReplies:input_type -> go_feed.GetCommentRepliesRequest
+	43,  // 43: go_feed.GoFeedService.UpdateComment:input_type -> go_feed.UpdateCommentRequest
+	44,  // 44: go_feed.GoFeedService.DeleteComment:input_type -> go_feed.DeleteCommentRequest
+	45,  // 45: go_feed.GoFeedService.HideComment:input_type -> go_feed.HideCommentRequest
+	46,  // 46: go_feed.GoFeedService.UnhideComment:input_type -> go_feed.UnhideCommentRequest
+	47,  // 47: go_feed.GoFeedService.SetCommentPolicy:input_type -> go_feed.SetCommentPolicyRequest
+	48,  // 48: go_feed.GoFeedService.CreateFollow:input_type -> go_feed.CreateFollowRequest
+	49,  // 49: go_feed.GoFeedService.GetFollowerCountOfAccount:input_type -> go_feed.GetFollowerCountOfAccountRequest
+	50,  // 50: go_feed.GoFeedService.GetFollowersOfAccount:input_type -> go_feed.GetFollowersOfAccountRequest
+	51,  // 51: go_feed.GoFeedService.GetFollowingCountOfAccount:input_type -> go_feed.GetFollowingCountOfAccountRequest
+	52,  // 52: go_feed.GoFeedService.GetFollowingsOfAccount:input_type -> go_feed.GetFollowingsOfAccountRequest
+	53,  // 53: go_feed.GoFeedService.DeleteFollow:input_type -> go_feed.DeleteFollowRequest
+	54,  // 54: go_feed.GoFeedService.ListFollowRequests:input_type -> go_feed.ListFollowRequestsRequest
+	55,  // 55: go_feed.GoFeedService.AcceptFollowRequest:input_type -> go_feed.AcceptFollowRequestRequest
+	56,  // 56: go_feed.GoFeedService.RejectFollowRequest:input_type -> go_feed.RejectFollowRequestRequest
+	57,  // 57: go_feed.GoFeedService.GetFollowSuggestions:input_type -> go_feed.GetFollowSuggestionsRequest
+	58,  // 58: go_feed.GoFeedService.GetRelationships:input_type -> go_feed.GetRelationshipsRequest
+	59,  // 59: go_feed.GoFeedService.BlockAccount:input_type -> go_feed.BlockAccountRequest
+	60,  // 60: go_feed.GoFeedService.UnblockAccount:input_type -> go_feed.UnblockAccountRequest
+	61,  // 61: go_feed.GoFeedService.ListBlockedAccounts:input_type -> go_feed.ListBlockedAccountsRequest
+	62,  // 62: go_feed.GoFeedService.MuteAccount:input_type -> go_feed.MuteAccountRequest
+	63,  // 63: go_feed.GoFeedService.UnmuteAccount:input_type -> go_feed.UnmuteAccountRequest
+	64,  // 64: go_feed.GoFeedService.ListMutedAccounts:input_type -> go_feed.ListMutedAccountsRequest
+	65,  // 65: go_feed.GoFeedService.GetNewFeeds:input_type -> go_feed.GetNewFeedsRequest
+	66,  // 66: go_feed.GoFeedService.ReportContent:input_type -> go_feed.ReportContentRequest
+	67,  // 67: go_feed.GoFeedService.ListReports:input_type -> go_feed.ListReportsRequest
+	68,  // 68: go_feed.GoFeedService.ResolveReport:input_type -> go_feed.ResolveReportRequest
+	69,  // 69: go_feed.GoFeedService.TakedownContent:input_type -> go_feed.TakedownContentRequest
+	70,  // 70: go_feed.GoFeedService.CreateAccount:output_type -> go_feed.CreateAccountResponse
+	71,  // 71: go_feed.GoFeedService.CreateSession:output_type -> go_feed.CreateSessionResponse
+	72,  // 72: go_feed.GoFeedService.SearchAccounts:output_type -> go_feed.SearchAccountsResponse
+	73,  // 73: go_feed.GoFeedService.SetLikesHidden:output_type -> go_feed.SetLikesHiddenResponse
+	74,  // 74: go_feed.GoFeedService.SetAccountPrivate:output_type -> go_feed.SetAccountPrivateResponse
+	75,  // 75: go_feed.GoFeedService.CreatePost:output_type -> go_feed.CreatePostResponse
+	76,  // 76: go_feed.GoFeedService.GetPostByID:output_type -> go_feed.GetPostByIDResponse
+	77,  // 77: go_feed.GoFeedService.GetPostOfAccount:output_type -> go_feed.GetPostOfAccountResponse
+	78,  // 78: go_feed.GoFeedService.UpdatePost:output_type -> go_feed.UpdatePostResponse
+	79,  // 79: go_feed.GoFeedService.DeletePost:output_type -> go_feed.DeletePostResponse
+	80,  // 80: go_feed.GoFeedService.RestorePost:output_type -> go_feed.RestorePostResponse
+	81,  // 81: go_feed.GoFeedService.ListDeletedPosts:output_type -> go_feed.ListDeletedPostsResponse
+	82,  // 82: go_feed.GoFeedService.GetPostsByHashtag:output_type -> go_feed.GetPostsByHashtagResponse
+	83,  // 83: go_feed.GoFeedService.GetPostsMentioningAccount:output_type -> go_feed.GetPostsMentioningAccountResponse
+	84,  // 84: go_feed.GoFeedService.SearchPosts:output_type -> go_feed.SearchPostsResponse
+	85,  // 85: go_feed.GoFeedService.CreateDraft:output_type -> go_feed.CreateDraftResponse
+	86,  // 86: go_feed.GoFeedService.ListDrafts:output_type -> go_feed.ListDraftsResponse
+	87,  // 87: go_feed.GoFeedService.UpdateDraft:output_type -> go_feed.UpdateDraftResponse
+	88,  // 88: go_feed.GoFeedService.PublishDraft:output_type -> go_feed.PublishDraftResponse
+	89,  // 89: go_feed.GoFeedService.PinPost:output_type -> go_feed.PinPostResponse
+	90,  // 90: go_feed.GoFeedService.UnpinPost:output_type -> go_feed.UnpinPostResponse
+	91,  // 91: go_feed.GoFeedService.CreateBookmark:output_type -> go_feed.CreateBookmarkResponse
+	92,  // 92: go_feed.GoFeedService.DeleteBookmark:output_type -> go_feed.DeleteBookmarkResponse
+	93,  // 93: go_feed.GoFeedService.ListBookmarks:output_type -> go_feed.ListBookmarksResponse
+	94,  // 94: go_feed.GoFeedService.GetTrendingHashtags:output_type -> go_feed.GetTrendingHashtagsResponse
+	95,  // 95: go_feed.GoFeedService.GetTrendingPosts:output_type -> go_feed.GetTrendingPostsResponse
+	96,  // 96: go_feed.GoFeedService.VotePoll:output_type -> go_feed.VotePollResponse
+	97,  // 97: go_feed.GoFeedService.GetPollResults:output_type -> go_feed.GetPollResultsResponse
+	98,  // 98: go_feed.GoFeedService.CreateLike:output_type -> go_feed.CreateLikeResponse
+	99,  // 99: go_feed.GoFeedService.SetReaction:output_type -> go_feed.SetReactionResponse
+	100, // 100: go_feed.GoFeedService.GetLikeCountOfPost:output_type -> go_feed.GetLikeCountOfPostResponse
+	101, // 101: go_feed.GoFeedService.GetLikeAccountsOfPost:output_type -> go_feed.GetLikeAccountsOfPostResponse
+	102, // 102: go_feed.GoFeedService.DeleteLike:output_type -> go_feed.DeleteLikeResponse
+	103, // 103: go_feed.GoFeedService.DeleteReaction:output_type -> go_feed.DeleteReactionResponse
+	104, // 104: go_feed.GoFeedService.HasLikedPosts:output_type -> go_feed.HasLikedPostsResponse
+	105, // 105: go_feed.GoFeedService.GetLikedPostsOfAccount:output_type -> go_feed.GetLikedPostsOfAccountResponse
+	106, // 106: go_feed.GoFeedService.CreateCommentLike:output_type -> go_feed.CreateCommentLikeResponse
+	107, // 107: go_feed.GoFeedService.GetLikeCountOfComment:output_type -> go_feed.GetLikeCountOfCommentResponse
+	108, // 108: go_feed.GoFeedService.DeleteCommentLike:output_type -> go_feed.DeleteCommentLikeResponse
+	109, // 109: go_feed.GoFeedService.CreateComment:output_type -> go_feed.CreateCommentResponse
+	110, // 110: go_feed.GoFeedService.GetCommentCountOfPost:output_type -> go_feed.GetCommentCountOfPostResponse
+	111, // 111: go_feed.GoFeedService.GetCommentsOfPost:output_type -> go_feed.GetCommentsOfPostResponse
+	112, // 112: go_feed.GoFeedService.GetCommentReplies:output_type -> go_feed.GetCommentRepliesResponse
+	113, // 113: go_feed.GoFeedService.UpdateComment:output_type -> go_feed.UpdateCommentResponse
+	114, // 114: go_feed.GoFeedService.DeleteComment:output_type -> go_feed.DeleteCommentResponse
+	115, // 115: go_feed.GoFeedService.HideComment:output_type -> go_feed.HideCommentResponse
+	116, // 116: go_feed.GoFeedService.UnhideComment:output_type -> go_feed.UnhideCommentResponse
+	117, // 117: go_feed.GoFeedService.SetCommentPolicy:output_type -> go_feed.SetCommentPolicyResponse
+	118, // 118: go_feed.GoFeedService.CreateFollow:output_type -> go_feed.CreateFollowResponse
+	119, // 119: go_feed.GoFeedService.GetFollowerCountOfAccount:output_type -> go_feed.GetFollowerCountOfAccountResponse
+	120, // 120: go_feed.GoFeedService.GetFollowersOfAccount:output_type -> go_feed.GetFollowersOfAccountResponse
+	121, // 121: go_feed.GoFeedService.GetFollowingCountOfAccount:output_type -> go_feed.GetFollowingCountOfAccountResponse
+	122, // 122: go_feed.GoFeedService.GetFollowingsOfAccount:output_type -> go_feed.GetFollowingsOfAccountResponse
+	123, // 123: go_feed.GoFeedService.DeleteFollow:output_type -> go_feed.DeleteFollowResponse
+	124, // 124: go_feed.GoFeedService.ListFollowRequests:output_type -> go_feed.ListFollowRequestsResponse
+	125, // 125: go_feed.GoFeedService.AcceptFollowRequest:output_type -> go_feed.AcceptFollowRequestResponse
+	126, // 126: go_feed.GoFeedService.RejectFollowRequest:output_type -> go_feed.RejectFollowRequestResponse
+	127, // 127: go_feed.GoFeedService.GetFollowSuggestions:output_type -> go_feed.GetFollowSuggestionsResponse
+	128, // 128: go_feed.GoFeedService.GetRelationships:output_type -> go_feed.GetRelationshipsResponse
+	129, // 129: go_feed.GoFeedService.BlockAccount:output_type -> go_feed.BlockAccountResponse
+	130, // 130: go_feed.GoFeedService.UnblockAccount:output_type -> go_feed.UnblockAccountResponse
+	131, // 131: go_feed.GoFeedService.ListBlockedAccounts:output_type -> go_feed.ListBlockedAccountsResponse
+	132, // 132: go_feed.GoFeedService.MuteAccount:output_type -> go_feed.MuteAccountResponse
+	133, // 133: go_feed.GoFeedService.UnmuteAccount:output_type -> go_feed.UnmuteAccountResponse
+	134, // 134: go_feed.GoFeedService.ListMutedAccounts:output_type -> go_feed.ListMutedAccountsResponse
+	135, // 135: go_feed.GoFeedService.GetNewFeeds:output_type -> go_feed.GetNewFeedsResponse
+	136, // 136: go_feed.GoFeedService.ReportContent:output_type -> go_feed.ReportContentResponse
+	137, // 137: go_feed.GoFeedService.ListReports:output_type -> go_feed.ListReportsResponse
+	138, // 138: go_feed.GoFeedService.ResolveReport:output_type -> go_feed.ResolveReportResponse
+	139, // 139: go_feed.GoFeedService.TakedownContent:output_type -> go_feed.TakedownContentResponse
+	70,  // [70:140] is the sub-list for method output_type
+	0,   // [0:70] is the sub-list for method input_type
+	0,   // [0:0] is the sub-list for extension type_name
+	0,   // [0:0] is the sub-list for extension extendee
+	0,   // [0:0] is the sub-list for field type_name
 }
 
 func init() { file_api_go_feed_go_feed_proto_init() }
@@ -21,26 +21,74 @@ const _ = grpc.SupportPackageIsVersion9
 const (
 	GoFeedService_CreateAccount_FullMethodName              = "/go_feed.GoFeedService/CreateAccount"
 	GoFeedService_CreateSession_FullMethodName              = "/go_feed.GoFeedService/CreateSession"
+	GoFeedService_SearchAccounts_FullMethodName             = "/go_feed.GoFeedService/SearchAccounts"
+	GoFeedService_SetLikesHidden_FullMethodName             = "/go_feed.GoFeedService/SetLikesHidden"
+	GoFeedService_SetAccountPrivate_FullMethodName          = "/go_feed.GoFeedService/SetAccountPrivate"
 	GoFeedService_CreatePost_FullMethodName                 = "/go_feed.GoFeedService/CreatePost"
 	GoFeedService_GetPostByID_FullMethodName                = "/go_feed.GoFeedService/GetPostByID"
 	GoFeedService_GetPostOfAccount_FullMethodName           = "/go_feed.GoFeedService/GetPostOfAccount"
 	GoFeedService_UpdatePost_FullMethodName                 = "/go_feed.GoFeedService/UpdatePost"
+	GoFeedService_DeletePost_FullMethodName                 = "/go_feed.GoFeedService/DeletePost"
+	GoFeedService_RestorePost_FullMethodName                = "/go_feed.GoFeedService/RestorePost"
+	GoFeedService_ListDeletedPosts_FullMethodName           = "/go_feed.GoFeedService/ListDeletedPosts"
+	GoFeedService_GetPostsByHashtag_FullMethodName          = "/go_feed.GoFeedService/GetPostsByHashtag"
+	GoFeedService_GetPostsMentioningAccount_FullMethodName  = "/go_feed.GoFeedService/GetPostsMentioningAccount"
+	GoFeedService_SearchPosts_FullMethodName                = "/go_feed.GoFeedService/SearchPosts"
+	GoFeedService_CreateDraft_FullMethodName                = "/go_feed.GoFeedService/CreateDraft"
+	GoFeedService_ListDrafts_FullMethodName                 = "/go_feed.GoFeedService/ListDrafts"
+	GoFeedService_UpdateDraft_FullMethodName                = "/go_feed.GoFeedService/UpdateDraft"
+	GoFeedService_PublishDraft_FullMethodName               = "/go_feed.GoFeedService/PublishDraft"
+	GoFeedService_PinPost_FullMethodName                    = "/go_feed.GoFeedService/PinPost"
+	GoFeedService_UnpinPost_FullMethodName                  = "/go_feed.GoFeedService/UnpinPost"
+	GoFeedService_CreateBookmark_FullMethodName             = "/go_feed.GoFeedService/CreateBookmark"
+	GoFeedService_DeleteBookmark_FullMethodName             = "/go_feed.GoFeedService/DeleteBookmark"
+	GoFeedService_ListBookmarks_FullMethodName              = "/go_feed.GoFeedService/ListBookmarks"
+	GoFeedService_GetTrendingHashtags_FullMethodName        = "/go_feed.GoFeedService/GetTrendingHashtags"
+	GoFeedService_GetTrendingPosts_FullMethodName           = "/go_feed.GoFeedService/GetTrendingPosts"
+	GoFeedService_VotePoll_FullMethodName                   = "/go_feed.GoFeedService/VotePoll"
+	GoFeedService_GetPollResults_FullMethodName             = "/go_feed.GoFeedService/GetPollResults"
 	GoFeedService_CreateLike_FullMethodName                 = "/go_feed.GoFeedService/CreateLike"
+	GoFeedService_SetReaction_FullMethodName                = "/go_feed.GoFeedService/SetReaction"
 	GoFeedService_GetLikeCountOfPost_FullMethodName         = "/go_feed.GoFeedService/GetLikeCountOfPost"
 	GoFeedService_GetLikeAccountsOfPost_FullMethodName      = "/go_feed.GoFeedService/GetLikeAccountsOfPost"
 	GoFeedService_DeleteLike_FullMethodName                 = "/go_feed.GoFeedService/DeleteLike"
+	GoFeedService_DeleteReaction_FullMethodName             = "/go_feed.GoFeedService/DeleteReaction"
+	GoFeedService_HasLikedPosts_FullMethodName              = "/go_feed.GoFeedService/HasLikedPosts"
+	GoFeedService_GetLikedPostsOfAccount_FullMethodName     = "/go_feed.GoFeedService/GetLikedPostsOfAccount"
+	GoFeedService_CreateCommentLike_FullMethodName          = "/go_feed.GoFeedService/CreateCommentLike"
+	GoFeedService_GetLikeCountOfComment_FullMethodName      = "/go_feed.GoFeedService/GetLikeCountOfComment"
+	GoFeedService_DeleteCommentLike_FullMethodName          = "/go_feed.GoFeedService/DeleteCommentLike"
 	GoFeedService_CreateComment_FullMethodName              = "/go_feed.GoFeedService/CreateComment"
 	GoFeedService_GetCommentCountOfPost_FullMethodName      = "/go_feed.GoFeedService/GetCommentCountOfPost"
 	GoFeedService_GetCommentsOfPost_FullMethodName          = "/go_feed.GoFeedService/GetCommentsOfPost"
+	GoFeedService_GetCommentReplies_FullMethodName          = "/go_feed.GoFeedService/GetCommentReplies"
 	GoFeedService_UpdateComment_FullMethodName              = "/go_feed.GoFeedService/UpdateComment"
 	GoFeedService_DeleteComment_FullMethodName              = "/go_feed.GoFeedService/DeleteComment"
+	GoFeedService_HideComment_FullMethodName                = "/go_feed.GoFeedService/HideComment"
+	GoFeedService_UnhideComment_FullMethodName              = "/go_feed.GoFeedService/UnhideComment"
+	GoFeedService_SetCommentPolicy_FullMethodName           = "/go_feed.GoFeedService/SetCommentPolicy"
 	GoFeedService_CreateFollow_FullMethodName               = "/go_feed.GoFeedService/CreateFollow"
 	GoFeedService_GetFollowerCountOfAccount_FullMethodName  = "/go_feed.GoFeedService/GetFollowerCountOfAccount"
 	GoFeedService_GetFollowersOfAccount_FullMethodName      = "/go_feed.GoFeedService/GetFollowersOfAccount"
 	GoFeedService_GetFollowingCountOfAccount_FullMethodName = "/go_feed.GoFeedService/GetFollowingCountOfAccount"
 	GoFeedService_GetFollowingsOfAccount_FullMethodName     = "/go_feed.GoFeedService/GetFollowingsOfAccount"
 	GoFeedService_DeleteFollow_FullMethodName               = "/go_feed.GoFeedService/DeleteFollow"
+	GoFeedService_ListFollowRequests_FullMethodName         = "/go_feed.GoFeedService/ListFollowRequests"
+	GoFeedService_AcceptFollowRequest_FullMethodName        = "/go_feed.GoFeedService/AcceptFollowRequest"
+	GoFeedService_RejectFollowRequest_FullMethodName        = "/go_feed.GoFeedService/RejectFollowRequest"
+	GoFeedService_GetFollowSuggestions_FullMethodName       = "/go_feed.GoFeedService/GetFollowSuggestions"
+	GoFeedService_GetRelationships_FullMethodName           = "/go_feed.GoFeedService/GetRelationships"
+	GoFeedService_BlockAccount_FullMethodName               = "/go_feed.GoFeedService/BlockAccount"
+	GoFeedService_UnblockAccount_FullMethodName             = "/go_feed.GoFeedService/UnblockAccount"
+	GoFeedService_ListBlockedAccounts_FullMethodName        = "/go_feed.GoFeedService/ListBlockedAccounts"
+	GoFeedService_MuteAccount_FullMethodName                = "/go_feed.GoFeedService/MuteAccount"
+	GoFeedService_UnmuteAccount_FullMethodName              = "/go_feed.GoFeedService/UnmuteAccount"
+	GoFeedService_ListMutedAccounts_FullMethodName          = "/go_feed.GoFeedService/ListMutedAccounts"
 	GoFeedService_GetNewFeeds_FullMethodName                = "/go_feed.GoFeedService/GetNewFeeds"
+	GoFeedService_ReportContent_FullMethodName              = "/go_feed.GoFeedService/ReportContent"
+	GoFeedService_ListReports_FullMethodName                = "/go_feed.GoFeedService/ListReports"
+	GoFeedService_ResolveReport_FullMethodName              = "/go_feed.GoFeedService/ResolveReport"
+	GoFeedService_TakedownContent_FullMethodName            = "/go_feed.GoFeedService/TakedownContent"
 )
 
 // GoFeedServiceClient is the client API for GoFeedService service.
@@ -49,26 +97,74 @@ const (
 type GoFeedServiceClient interface {
 	CreateAccount(ctx context.Context, in *CreateAccountRequest, opts ...grpc.CallOption) (*CreateAccountResponse, error)
 	CreateSession(ctx context.Context, in *CreateSessionRequest, opts ...grpc.CallOption) (*CreateSessionResponse, error)
+	SearchAccounts(ctx context.Context, in *SearchAccountsRequest, opts ...grpc.CallOption) (*SearchAccountsResponse, error)
+	SetLikesHidden(ctx context.Context, in *SetLikesHiddenRequest, opts ...grpc.CallOption) (*SetLikesHiddenResponse, error)
+	SetAccountPrivate(ctx context.Context, in *SetAccountPrivateRequest, opts ...grpc.CallOption) (*SetAccountPrivateResponse, error)
 	CreatePost(ctx context.Context, in *CreatePostRequest, opts ...grpc.CallOption) (*CreatePostResponse, error)
 	GetPostByID(ctx context.Context, in *GetPostByIDRequest, opts ...grpc.CallOption) (*GetPostByIDResponse, error)
 	GetPostOfAccount(ctx context.Context, in *GetPostOfAccountRequest, opts ...grpc.CallOption) (*GetPostOfAccountResponse, error)
 	UpdatePost(ctx context.Context, in *UpdatePostRequest, opts ...grpc.CallOption) (*UpdatePostResponse, error)
+	DeletePost(ctx context.Context, in *DeletePostRequest, opts ...grpc.CallOption) (*DeletePostResponse, error)
+	RestorePost(ctx context.Context, in *RestorePostRequest, opts ...grpc.CallOption) (*RestorePostResponse, error)
+	ListDeletedPosts(ctx context.Context, in *ListDeletedPostsRequest, opts ...grpc.CallOption) (*ListDeletedPostsResponse, error)
+	GetPostsByHashtag(ctx context.Context, in *GetPostsByHashtagRequest, opts ...grpc.CallOption) (*GetPostsByHashtagResponse, error)
+	GetPostsMentioningAccount(ctx context.Context, in *GetPostsMentioningAccountRequest, opts ...grpc.CallOption) (*GetPostsMentioningAccountResponse, error)
+	SearchPosts(ctx context.Context, in *SearchPostsRequest, opts ...grpc.CallOption) (*SearchPostsResponse, error)
+	CreateDraft(ctx context.Context, in *CreateDraftRequest, opts ...grpc.CallOption) (*CreateDraftResponse, error)
+	ListDrafts(ctx context.Context, in *ListDraftsRequest, opts ...grpc.CallOption) (*ListDraftsResponse, error)
+	UpdateDraft(ctx context.Context, in *UpdateDraftRequest, opts ...grpc.CallOption) (*UpdateDraftResponse, error)
+	PublishDraft(ctx context.Context, in *PublishDraftRequest, opts ...grpc.CallOption) (*PublishDraftResponse, error)
+	PinPost(ctx context.Context, in *PinPostRequest, opts ...grpc.CallOption) (*PinPostResponse, error)
+	UnpinPost(ctx context.Context, in *UnpinPostRequest, opts ...grpc.CallOption) (*UnpinPostResponse, error)
+	CreateBookmark(ctx context.Context, in *CreateBookmarkRequest, opts ...grpc.CallOption) (*CreateBookmarkResponse, error)
+	DeleteBookmark(ctx context.Context, in *DeleteBookmarkRequest, opts ...grpc.CallOption) (*DeleteBookmarkResponse, error)
+	ListBookmarks(ctx context.Context, in *ListBookmarksRequest, opts ...grpc.CallOption) (*ListBookmarksResponse, error)
+	GetTrendingHashtags(ctx context.Context, in *GetTrendingHashtagsRequest, opts ...grpc.CallOption) (*GetTrendingHashtagsResponse, error)
+	GetTrendingPosts(ctx context.Context, in *GetTrendingPostsRequest, opts ...grpc.CallOption) (*GetTrendingPostsResponse, error)
+	VotePoll(ctx context.Context, in *VotePollRequest, opts ...grpc.CallOption) (*VotePollResponse, error)
+	GetPollResults(ctx context.Context, in *GetPollResultsRequest, opts ...grpc.CallOption) (*GetPollResultsResponse, error)
 	CreateLike(ctx context.Context, in *CreateLikeRequest, opts ...grpc.CallOption) (*CreateLikeResponse, error)
+	SetReaction(ctx context.Context, in *SetReactionRequest, opts ...grpc.CallOption) (*SetReactionResponse, error)
 	GetLikeCountOfPost(ctx context.Context, in *GetLikeCountOfPostRequest, opts ...grpc.CallOption) (*GetLikeCountOfPostResponse, error)
 	GetLikeAccountsOfPost(ctx context.Context, in *GetLikeAccountsOfPostRequest, opts ...grpc.CallOption) (*GetLikeAccountsOfPostResponse, error)
 	DeleteLike(ctx context.Context, in *DeleteLikeRequest, opts ...grpc.CallOption) (*DeleteLikeResponse, error)
+	DeleteReaction(ctx context.Context, in *DeleteReactionRequest, opts ...grpc.CallOption) (*DeleteReactionResponse, error)
+	HasLikedPosts(ctx context.Context, in *HasLikedPostsRequest, opts ...grpc.CallOption) (*HasLikedPostsResponse, error)
+	GetLikedPostsOfAccount(ctx context.Context, in *GetLikedPostsOfAccountRequest, opts ...grpc.CallOption) (*GetLikedPostsOfAccountResponse, error)
+	CreateCommentLike(ctx context.Context, in *CreateCommentLikeRequest, opts ...grpc.CallOption) (*CreateCommentLikeResponse, error)
+	GetLikeCountOfComment(ctx context.Context, in *GetLikeCountOfCommentRequest, opts ...grpc.CallOption) (*GetLikeCountOfCommentResponse, error)
+	DeleteCommentLike(ctx context.Context, in *DeleteCommentLikeRequest, opts ...grpc.CallOption) (*DeleteCommentLikeResponse, error)
 	CreateComment(ctx context.Context, in *CreateCommentRequest, opts ...grpc.CallOption) (*CreateCommentResponse, error)
 	GetCommentCountOfPost(ctx context.Context, in *GetCommentCountOfPostRequest, opts ...grpc.CallOption) (*GetCommentCountOfPostResponse, error)
 	GetCommentsOfPost(ctx context.Context, in *GetCommentsOfPostRequest, opts ...grpc.CallOption) (*GetCommentsOfPostResponse, error)
+	GetCommentReplies(ctx context.Context, in *GetCommentRepliesRequest, opts ...grpc.CallOption) (*GetCommentRepliesResponse, error)
 	UpdateComment(ctx context.Context, in *UpdateCommentRequest, opts ...grpc.CallOption) (*UpdateCommentResponse, error)
 	DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*DeleteCommentResponse, error)
+	HideComment(ctx context.Context, in *HideCommentRequest, opts ...grpc.CallOption) (*HideCommentResponse, error)
+	UnhideComment(ctx context.Context, in *UnhideCommentRequest, opts ...grpc.CallOption) (*UnhideCommentResponse, error)
+	SetCommentPolicy(ctx context.Context, in *SetCommentPolicyRequest, opts ...grpc.CallOption) (*SetCommentPolicyResponse, error)
 	CreateFollow(ctx context.Context, in *CreateFollowRequest, opts ...grpc.CallOption) (*CreateFollowResponse, error)
 	GetFollowerCountOfAccount(ctx context.Context, in *GetFollowerCountOfAccountRequest, opts ...grpc.CallOption) (*GetFollowerCountOfAccountResponse, error)
 	GetFollowersOfAccount(ctx context.Context, in *GetFollowersOfAccountRequest, opts ...grpc.CallOption) (*GetFollowersOfAccountResponse, error)
 	GetFollowingCountOfAccount(ctx context.Context, in *GetFollowingCountOfAccountRequest, opts ...grpc.CallOption) (*GetFollowingCountOfAccountResponse, error)
 	GetFollowingsOfAccount(ctx context.Context, in *GetFollowingsOfAccountRequest, opts ...grpc.CallOption) (*GetFollowingsOfAccountResponse, error)
 	DeleteFollow(ctx context.Context, in *DeleteFollowRequest, opts ...grpc.CallOption) (*DeleteFollowResponse, error)
+	ListFollowRequests(ctx context.Context, in *ListFollowRequestsRequest, opts ...grpc.CallOption) (*ListFollowRequestsResponse, error)
+	AcceptFollowRequest(ctx context.Context, in *AcceptFollowRequestRequest, opts ...grpc.CallOption) (*AcceptFollowRequestResponse, error)
+	RejectFollowRequest(ctx context.Context, in *RejectFollowRequestRequest, opts ...grpc.CallOption) (*RejectFollowRequestResponse, error)
+	GetFollowSuggestions(ctx context.Context, in *GetFollowSuggestionsRequest, opts ...grpc.CallOption) (*GetFollowSuggestionsResponse, error)
+	GetRelationships(ctx context.Context, in *GetRelationshipsRequest, opts ...grpc.CallOption) (*GetRelationshipsResponse, error)
+	BlockAccount(ctx context.Context, in *BlockAccountRequest, opts ...grpc.CallOption) (*BlockAccountResponse, error)
+	UnblockAccount(ctx context.Context, in *UnblockAccountRequest, opts ...grpc.CallOption) (*UnblockAccountResponse, error)
+	ListBlockedAccounts(ctx context.Context, in *ListBlockedAccountsRequest, opts ...grpc.CallOption) (*ListBlockedAccountsResponse, error)
+	MuteAccount(ctx context.Context, in *MuteAccountRequest, opts ...grpc.CallOption) (*MuteAccountResponse, error)
+	UnmuteAccount(ctx context.Context, in *UnmuteAccountRequest, opts ...grpc.CallOption) (*UnmuteAccountResponse, error)
+	ListMutedAccounts(ctx context.Context, in *ListMutedAccountsRequest, opts ...grpc.CallOption) (*ListMutedAccountsResponse, error)
 	GetNewFeeds(ctx context.Context, in *GetNewFeedsRequest, opts ...grpc.CallOption) (*GetNewFeedsResponse, error)
+	ReportContent(ctx context.Context, in *ReportContentRequest, opts ...grpc.CallOption) (*ReportContentResponse, error)
+	ListReports(ctx context.Context, in *ListReportsRequest, opts ...grpc.CallOption) (*ListReportsResponse, error)
+	ResolveReport(ctx context.Context, in *ResolveReportRequest, opts ...grpc.CallOption) (*ResolveReportResponse, error)
+	TakedownContent(ctx context.Context, in *TakedownContentRequest, opts ...grpc.CallOption) (*TakedownContentResponse, error)
 }
 
 type goFeedServiceClient struct {
@@ -99,6 +195,36 @@ func (c *goFeedServiceClient) CreateSession(ctx context.Context, in *CreateSessi
 	return out, nil
 }
 
+func (c *goFeedServiceClient) SearchAccounts(ctx context.Context, in *SearchAccountsRequest, opts ...grpc.CallOption) (*SearchAccountsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchAccountsResponse)
+	err := c.cc.Invoke(ctx, GoFeedService_SearchAccounts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goFeedServiceClient) SetLikesHidden(ctx context.Context, in *SetLikesHiddenRequest, opts ...grpc.CallOption) (*SetLikesHiddenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetLikesHiddenResponse)
+	err := c.cc.Invoke(ctx, GoFeedService_SetLikesHidden_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goFeedServiceClient) SetAccountPrivate(ctx context.Context, in *SetAccountPrivateRequest, opts ...grpc.CallOption) (*SetAccountPrivateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetAccountPrivateResponse)
+	err := c.cc.Invoke(ctx, GoFeedService_SetAccountPrivate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *goFeedServiceClient) CreatePost(ctx context.Context, in *CreatePostRequest, opts ...grpc.CallOption) (*CreatePostResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreatePostResponse)
@@ -139,6 +265,196 @@ func (c *goFeedServiceClient) UpdatePost(ctx context.Context, in *UpdatePostRequ
 	return out, nil
 }
 
+func (c *goFeedServiceClient) DeletePost(ctx context.Context, in *DeletePostRequest, opts ...grpc.CallOption) (*DeletePostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePostResponse)
+	err := c.cc.Invoke(ctx, GoFeedService_DeletePost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goFeedServiceClient) RestorePost(ctx context.Context, in *RestorePostRequest, opts ...grpc.CallOption) (*RestorePostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestorePostResponse)
+	err := c.cc.Invoke(ctx, GoFeedService_RestorePost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goFeedServiceClient) ListDeletedPosts(ctx context.Context, in *ListDeletedPostsRequest, opts ...grpc.CallOption) (*ListDeletedPostsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDeletedPostsResponse)
+	err := c.cc.Invoke(ctx, GoFeedService_ListDeletedPosts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goFeedServiceClient) GetPostsByHashtag(ctx context.Context, in *GetPostsByHashtagRequest, opts ...grpc.CallOption) (*GetPostsByHashtagResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPostsByHashtagResponse)
+	err := c.cc.Invoke(ctx, GoFeedService_GetPostsByHashtag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goFeedServiceClient) GetPostsMentioningAccount(ctx context.Context, in *GetPostsMentioningAccountRequest, opts ...grpc.CallOption) (*GetPostsMentioningAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPostsMentioningAccountResponse)
+	err := c.cc.Invoke(ctx, GoFeedService_GetPostsMentioningAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goFeedServiceClient) SearchPosts(ctx context.Context, in *SearchPostsRequest, opts ...grpc.CallOption) (*SearchPostsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchPostsResponse)
+	err := c.cc.Invoke(ctx, GoFeedService_SearchPosts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goFeedServiceClient) CreateDraft(ctx context.Context, in *CreateDraftRequest, opts ...grpc.CallOption) (*CreateDraftResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateDraftResponse)
+	err := c.cc.Invoke(ctx, GoFeedService_CreateDraft_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goFeedServiceClient) ListDrafts(ctx context.Context, in *ListDraftsRequest, opts ...grpc.CallOption) (*ListDraftsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDraftsResponse)
+	err := c.cc.Invoke(ctx, GoFeedService_ListDrafts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goFeedServiceClient) UpdateDraft(ctx context.Context, in *UpdateDraftRequest, opts ...grpc.CallOption) (*UpdateDraftResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateDraftResponse)
+	err := c.cc.Invoke(ctx, GoFeedService_UpdateDraft_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goFeedServiceClient) PublishDraft(ctx context.Context, in *PublishDraftRequest, opts ...grpc.CallOption) (*PublishDraftResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PublishDraftResponse)
+	err := c.cc.Invoke(ctx, GoFeedService_PublishDraft_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goFeedServiceClient) PinPost(ctx context.Context, in *PinPostRequest, opts ...grpc.CallOption) (*PinPostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PinPostResponse)
+	err := c.cc.Invoke(ctx, GoFeedService_PinPost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goFeedServiceClient) UnpinPost(ctx context.Context, in *UnpinPostRequest, opts ...grpc.CallOption) (*UnpinPostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnpinPostResponse)
+	err := c.cc.Invoke(ctx, GoFeedService_UnpinPost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goFeedServiceClient) CreateBookmark(ctx context.Context, in *CreateBookmarkRequest, opts ...grpc.CallOption) (*CreateBookmarkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateBookmarkResponse)
+	err := c.cc.Invoke(ctx, GoFeedService_CreateBookmark_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goFeedServiceClient) DeleteBookmark(ctx context.Context, in *DeleteBookmarkRequest, opts ...grpc.CallOption) (*DeleteBookmarkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteBookmarkResponse)
+	err := c.cc.Invoke(ctx, GoFeedService_DeleteBookmark_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goFeedServiceClient) ListBookmarks(ctx context.Context, in *ListBookmarksRequest, opts ...grpc.CallOption) (*ListBookmarksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBookmarksResponse)
+	err := c.cc.Invoke(ctx, GoFeedService_ListBookmarks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goFeedServiceClient) GetTrendingHashtags(ctx context.Context, in *GetTrendingHashtagsRequest, opts ...grpc.CallOption) (*GetTrendingHashtagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTrendingHashtagsResponse)
+	err := c.cc.Invoke(ctx, GoFeedService_GetTrendingHashtags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goFeedServiceClient) GetTrendingPosts(ctx context.Context, in *GetTrendingPostsRequest, opts ...grpc.CallOption) (*GetTrendingPostsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTrendingPostsResponse)
+	err := c.cc.Invoke(ctx, GoFeedService_GetTrendingPosts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goFeedServiceClient) VotePoll(ctx context.Context, in *VotePollRequest, opts ...grpc.CallOption) (*VotePollResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VotePollResponse)
+	err := c.cc.Invoke(ctx, GoFeedService_VotePoll_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goFeedServiceClient) GetPollResults(ctx context.Context, in *GetPollResultsRequest, opts ...grpc.CallOption) (*GetPollResultsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPollResultsResponse)
+	err := c.cc.Invoke(ctx, GoFeedService_GetPollResults_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *goFeedServiceClient) CreateLike(ctx context.Context, in *CreateLikeRequest, opts ...grpc.CallOption) (*CreateLikeResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateLikeResponse)
@@ -149,6 +465,16 @@ func (c *goFeedServiceClient) CreateLike(ctx context.Context, in *CreateLikeRequ
 	return out, nil
 }
 
+func (c *goFeedServiceClient) SetReaction(ctx context.Context, in *SetReactionRequest, opts ...grpc.CallOption) (*SetReactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetReactionResponse)
+	err := c.cc.Invoke(ctx, GoFeedService_SetReaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *goFeedServiceClient) GetLikeCountOfPost(ctx context.Context, in *GetLikeCountOfPostRequest, opts ...grpc.CallOption) (*GetLikeCountOfPostResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetLikeCountOfPostResponse)
@@ -179,6 +505,66 @@ func (c *goFeedServiceClient) DeleteLike(ctx context.Context, in *DeleteLikeRequ
 	return out, nil
 }
 
+func (c *goFeedServiceClient) DeleteReaction(ctx context.Context, in *DeleteReactionRequest, opts ...grpc.CallOption) (*DeleteReactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteReactionResponse)
+	err := c.cc.Invoke(ctx, GoFeedService_DeleteReaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goFeedServiceClient) HasLikedPosts(ctx context.Context, in *HasLikedPostsRequest, opts ...grpc.CallOption) (*HasLikedPostsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HasLikedPostsResponse)
+	err := c.cc.Invoke(ctx, GoFeedService_HasLikedPosts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goFeedServiceClient) GetLikedPostsOfAccount(ctx context.Context, in *GetLikedPostsOfAccountRequest, opts ...grpc.CallOption) (*GetLikedPostsOfAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLikedPostsOfAccountResponse)
+	err := c.cc.Invoke(ctx, GoFeedService_GetLikedPostsOfAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goFeedServiceClient) CreateCommentLike(ctx context.Context, in *CreateCommentLikeRequest, opts ...grpc.CallOption) (*CreateCommentLikeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCommentLikeResponse)
+	err := c.cc.Invoke(ctx, GoFeedService_CreateCommentLike_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goFeedServiceClient) GetLikeCountOfComment(ctx context.Context, in *GetLikeCountOfCommentRequest, opts ...grpc.CallOption) (*GetLikeCountOfCommentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLikeCountOfCommentResponse)
+	err := c.cc.Invoke(ctx, GoFeedService_GetLikeCountOfComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goFeedServiceClient) DeleteCommentLike(ctx context.Context, in *DeleteCommentLikeRequest, opts ...grpc.CallOption) (*DeleteCommentLikeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCommentLikeResponse)
+	err := c.cc.Invoke(ctx, GoFeedService_DeleteCommentLike_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *goFeedServiceClient) CreateComment(ctx context.Context, in *CreateCommentRequest, opts ...grpc.CallOption) (*CreateCommentResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateCommentResponse)
@@ -209,6 +595,16 @@ func (c *goFeedServiceClient) GetCommentsOfPost(ctx context.Context, in *GetComm
 	return out, nil
 }
 
+func (c *goFeedServiceClient) GetCommentReplies(ctx context.Context, in *GetCommentRepliesRequest, opts ...grpc.CallOption) (*GetCommentRepliesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCommentRepliesResponse)
+	err := c.cc.Invoke(ctx, GoFeedService_GetCommentReplies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *goFeedServiceClient) UpdateComment(ctx context.Context, in *UpdateCommentRequest, opts ...grpc.CallOption) (*UpdateCommentResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UpdateCommentResponse)
@@ -229,6 +625,36 @@ func (c *goFeedServiceClient) DeleteComment(ctx context.Context, in *DeleteComme
 	return out, nil
 }
 
+func (c *goFeedServiceClient) HideComment(ctx context.Context, in *HideCommentRequest, opts ...grpc.CallOption) (*HideCommentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HideCommentResponse)
+	err := c.cc.Invoke(ctx, GoFeedService_HideComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goFeedServiceClient) UnhideComment(ctx context.Context, in *UnhideCommentRequest, opts ...grpc.CallOption) (*UnhideCommentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnhideCommentResponse)
+	err := c.cc.Invoke(ctx, GoFeedService_UnhideComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goFeedServiceClient) SetCommentPolicy(ctx context.Context, in *SetCommentPolicyRequest, opts ...grpc.CallOption) (*SetCommentPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetCommentPolicyResponse)
+	err := c.cc.Invoke(ctx, GoFeedService_SetCommentPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *goFeedServiceClient) CreateFollow(ctx context.Context, in *CreateFollowRequest, opts ...grpc.CallOption) (*CreateFollowResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateFollowResponse)
@@ -289,42 +715,240 @@ func (c *goFeedServiceClient) DeleteFollow(ctx context.Context, in *DeleteFollow
 	return out, nil
 }
 
-func (c *goFeedServiceClient) GetNewFeeds(ctx context.Context, in *GetNewFeedsRequest, opts ...grpc.CallOption) (*GetNewFeedsResponse, error) {
+func (c *goFeedServiceClient) ListFollowRequests(ctx context.Context, in *ListFollowRequestsRequest, opts ...grpc.CallOption) (*ListFollowRequestsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetNewFeedsResponse)
-	err := c.cc.Invoke(ctx, GoFeedService_GetNewFeeds_FullMethodName, in, out, cOpts...)
+	out := new(ListFollowRequestsResponse)
+	err := c.cc.Invoke(ctx, GoFeedService_ListFollowRequests_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// GoFeedServiceServer is the server API for GoFeedService service.
-// All implementations must embed UnimplementedGoFeedServiceServer
-// for forward compatibility.
-type GoFeedServiceServer interface {
-	CreateAccount(context.Context, *CreateAccountRequest) (*CreateAccountResponse, error)
-	CreateSession(context.Context, *CreateSessionRequest) (*CreateSessionResponse, error)
-	CreatePost(context.Context, *CreatePostRequest) (*CreatePostResponse, error)
-	GetPostByID(context.Context, *GetPostByIDRequest) (*GetPostByIDResponse, error)
-	GetPostOfAccount(context.Context, *GetPostOfAccountRequest) (*GetPostOfAccountResponse, error)
-	UpdatePost(context.Context, *UpdatePostRequest) (*UpdatePostResponse, error)
-	CreateLike(context.Context, *CreateLikeRequest) (*CreateLikeResponse, error)
-	GetLikeCountOfPost(context.Context, *GetLikeCountOfPostRequest) (*GetLikeCountOfPostResponse, error)
-	GetLikeAccountsOfPost(context.Context, *GetLikeAccountsOfPostRequest) (*GetLikeAccountsOfPostResponse, error)
-	DeleteLike(context.Context, *DeleteLikeRequest) (*DeleteLikeResponse, error)
-	CreateComment(context.Context, *CreateCommentRequest) (*CreateCommentResponse, error)
-	GetCommentCountOfPost(context.Context, *GetCommentCountOfPostRequest) (*GetCommentCountOfPostResponse, error)
-	GetCommentsOfPost(context.Context, *GetCommentsOfPostRequest) (*GetCommentsOfPostResponse, error)
-	UpdateComment(context.Context, *UpdateCommentRequest) (*UpdateCommentResponse, error)
-	DeleteComment(context.Context, *DeleteCommentRequest) (*DeleteCommentResponse, error)
-	CreateFollow(context.Context, *CreateFollowRequest) (*CreateFollowResponse, error)
-	GetFollowerCountOfAccount(context.Context, *GetFollowerCountOfAccountRequest) (*GetFollowerCountOfAccountResponse, error)
-	GetFollowersOfAccount(context.Context, *GetFollowersOfAccountRequest) (*GetFollowersOfAccountResponse, error)
+func (c *goFeedServiceClient) AcceptFollowRequest(ctx context.Context, in *AcceptFollowRequestRequest, opts ...grpc.CallOption) (*AcceptFollowRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcceptFollowRequestResponse)
+	err := c.cc.Invoke(ctx, GoFeedService_AcceptFollowRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goFeedServiceClient) RejectFollowRequest(ctx context.Context, in *RejectFollowRequestRequest, opts ...grpc.CallOption) (*RejectFollowRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RejectFollowRequestResponse)
+	err := c.cc.Invoke(ctx, GoFeedService_RejectFollowRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goFeedServiceClient) GetFollowSuggestions(ctx context.Context, in *GetFollowSuggestionsRequest, opts ...grpc.CallOption) (*GetFollowSuggestionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFollowSuggestionsResponse)
+	err := c.cc.Invoke(ctx, GoFeedService_GetFollowSuggestions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goFeedServiceClient) GetRelationships(ctx context.Context, in *GetRelationshipsRequest, opts ...grpc.CallOption) (*GetRelationshipsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRelationshipsResponse)
+	err := c.cc.Invoke(ctx, GoFeedService_GetRelationships_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goFeedServiceClient) BlockAccount(ctx context.Context, in *BlockAccountRequest, opts ...grpc.CallOption) (*BlockAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BlockAccountResponse)
+	err := c.cc.Invoke(ctx, GoFeedService_BlockAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goFeedServiceClient) UnblockAccount(ctx context.Context, in *UnblockAccountRequest, opts ...grpc.CallOption) (*UnblockAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnblockAccountResponse)
+	err := c.cc.Invoke(ctx, GoFeedService_UnblockAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goFeedServiceClient) ListBlockedAccounts(ctx context.Context, in *ListBlockedAccountsRequest, opts ...grpc.CallOption) (*ListBlockedAccountsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBlockedAccountsResponse)
+	err := c.cc.Invoke(ctx, GoFeedService_ListBlockedAccounts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goFeedServiceClient) MuteAccount(ctx context.Context, in *MuteAccountRequest, opts ...grpc.CallOption) (*MuteAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MuteAccountResponse)
+	err := c.cc.Invoke(ctx, GoFeedService_MuteAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goFeedServiceClient) UnmuteAccount(ctx context.Context, in *UnmuteAccountRequest, opts ...grpc.CallOption) (*UnmuteAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnmuteAccountResponse)
+	err := c.cc.Invoke(ctx, GoFeedService_UnmuteAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goFeedServiceClient) ListMutedAccounts(ctx context.Context, in *ListMutedAccountsRequest, opts ...grpc.CallOption) (*ListMutedAccountsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMutedAccountsResponse)
+	err := c.cc.Invoke(ctx, GoFeedService_ListMutedAccounts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goFeedServiceClient) GetNewFeeds(ctx context.Context, in *GetNewFeedsRequest, opts ...grpc.CallOption) (*GetNewFeedsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetNewFeedsResponse)
+	err := c.cc.Invoke(ctx, GoFeedService_GetNewFeeds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goFeedServiceClient) ReportContent(ctx context.Context, in *ReportContentRequest, opts ...grpc.CallOption) (*ReportContentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReportContentResponse)
+	err := c.cc.Invoke(ctx, GoFeedService_ReportContent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goFeedServiceClient) ListReports(ctx context.Context, in *ListReportsRequest, opts ...grpc.CallOption) (*ListReportsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReportsResponse)
+	err := c.cc.Invoke(ctx, GoFeedService_ListReports_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goFeedServiceClient) ResolveReport(ctx context.Context, in *ResolveReportRequest, opts ...grpc.CallOption) (*ResolveReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveReportResponse)
+	err := c.cc.Invoke(ctx, GoFeedService_ResolveReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goFeedServiceClient) TakedownContent(ctx context.Context, in *TakedownContentRequest, opts ...grpc.CallOption) (*TakedownContentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TakedownContentResponse)
+	err := c.cc.Invoke(ctx, GoFeedService_TakedownContent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GoFeedServiceServer is the server API for GoFeedService service.
+// All implementations must embed UnimplementedGoFeedServiceServer
+// for forward compatibility.
+type GoFeedServiceServer interface {
+	CreateAccount(context.Context, *CreateAccountRequest) (*CreateAccountResponse, error)
+	CreateSession(context.Context, *CreateSessionRequest) (*CreateSessionResponse, error)
+	SearchAccounts(context.Context, *SearchAccountsRequest) (*SearchAccountsResponse, error)
+	SetLikesHidden(context.Context, *SetLikesHiddenRequest) (*SetLikesHiddenResponse, error)
+	SetAccountPrivate(context.Context, *SetAccountPrivateRequest) (*SetAccountPrivateResponse, error)
+	CreatePost(context.Context, *CreatePostRequest) (*CreatePostResponse, error)
+	GetPostByID(context.Context, *GetPostByIDRequest) (*GetPostByIDResponse, error)
+	GetPostOfAccount(context.Context, *GetPostOfAccountRequest) (*GetPostOfAccountResponse, error)
+	UpdatePost(context.Context, *UpdatePostRequest) (*UpdatePostResponse, error)
+	DeletePost(context.Context, *DeletePostRequest) (*DeletePostResponse, error)
+	RestorePost(context.Context, *RestorePostRequest) (*RestorePostResponse, error)
+	ListDeletedPosts(context.Context, *ListDeletedPostsRequest) (*ListDeletedPostsResponse, error)
+	GetPostsByHashtag(context.Context, *GetPostsByHashtagRequest) (*GetPostsByHashtagResponse, error)
+	GetPostsMentioningAccount(context.Context, *GetPostsMentioningAccountRequest) (*GetPostsMentioningAccountResponse, error)
+	SearchPosts(context.Context, *SearchPostsRequest) (*SearchPostsResponse, error)
+	CreateDraft(context.Context, *CreateDraftRequest) (*CreateDraftResponse, error)
+	ListDrafts(context.Context, *ListDraftsRequest) (*ListDraftsResponse, error)
+	UpdateDraft(context.Context, *UpdateDraftRequest) (*UpdateDraftResponse, error)
+	PublishDraft(context.Context, *PublishDraftRequest) (*PublishDraftResponse, error)
+	PinPost(context.Context, *PinPostRequest) (*PinPostResponse, error)
+	UnpinPost(context.Context, *UnpinPostRequest) (*UnpinPostResponse, error)
+	CreateBookmark(context.Context, *CreateBookmarkRequest) (*CreateBookmarkResponse, error)
+	DeleteBookmark(context.Context, *DeleteBookmarkRequest) (*DeleteBookmarkResponse, error)
+	ListBookmarks(context.Context, *ListBookmarksRequest) (*ListBookmarksResponse, error)
+	GetTrendingHashtags(context.Context, *GetTrendingHashtagsRequest) (*GetTrendingHashtagsResponse, error)
+	GetTrendingPosts(context.Context, *GetTrendingPostsRequest) (*GetTrendingPostsResponse, error)
+	VotePoll(context.Context, *VotePollRequest) (*VotePollResponse, error)
+	GetPollResults(context.Context, *GetPollResultsRequest) (*GetPollResultsResponse, error)
+	CreateLike(context.Context, *CreateLikeRequest) (*CreateLikeResponse, error)
+	SetReaction(context.Context, *SetReactionRequest) (*SetReactionResponse, error)
+	GetLikeCountOfPost(context.Context, *GetLikeCountOfPostRequest) (*GetLikeCountOfPostResponse, error)
+	GetLikeAccountsOfPost(context.Context, *GetLikeAccountsOfPostRequest) (*GetLikeAccountsOfPostResponse, error)
+	DeleteLike(context.Context, *DeleteLikeRequest) (*DeleteLikeResponse, error)
+	DeleteReaction(context.Context, *DeleteReactionRequest) (*DeleteReactionResponse, error)
+	HasLikedPosts(context.Context, *HasLikedPostsRequest) (*HasLikedPostsResponse, error)
+	GetLikedPostsOfAccount(context.Context, *GetLikedPostsOfAccountRequest) (*GetLikedPostsOfAccountResponse, error)
+	CreateCommentLike(context.Context, *CreateCommentLikeRequest) (*CreateCommentLikeResponse, error)
+	GetLikeCountOfComment(context.Context, *GetLikeCountOfCommentRequest) (*GetLikeCountOfCommentResponse, error)
+	DeleteCommentLike(context.Context, *DeleteCommentLikeRequest) (*DeleteCommentLikeResponse, error)
+	CreateComment(context.Context, *CreateCommentRequest) (*CreateCommentResponse, error)
+	GetCommentCountOfPost(context.Context, *GetCommentCountOfPostRequest) (*GetCommentCountOfPostResponse, error)
+	GetCommentsOfPost(context.Context, *GetCommentsOfPostRequest) (*GetCommentsOfPostResponse, error)
+	GetCommentReplies(context.Context, *GetCommentRepliesRequest) (*GetCommentRepliesResponse, error)
+	UpdateComment(context.Context, *UpdateCommentRequest) (*UpdateCommentResponse, error)
+	DeleteComment(context.Context, *DeleteCommentRequest) (*DeleteCommentResponse, error)
+	HideComment(context.Context, *HideCommentRequest) (*HideCommentResponse, error)
+	UnhideComment(context.Context, *UnhideCommentRequest) (*UnhideCommentResponse, error)
+	SetCommentPolicy(context.Context, *SetCommentPolicyRequest) (*SetCommentPolicyResponse, error)
+	CreateFollow(context.Context, *CreateFollowRequest) (*CreateFollowResponse, error)
+	GetFollowerCountOfAccount(context.Context, *GetFollowerCountOfAccountRequest) (*GetFollowerCountOfAccountResponse, error)
+	GetFollowersOfAccount(context.Context, *GetFollowersOfAccountRequest) (*GetFollowersOfAccountResponse, error)
 	GetFollowingCountOfAccount(context.Context, *GetFollowingCountOfAccountRequest) (*GetFollowingCountOfAccountResponse, error)
 	GetFollowingsOfAccount(context.Context, *GetFollowingsOfAccountRequest) (*GetFollowingsOfAccountResponse, error)
 	DeleteFollow(context.Context, *DeleteFollowRequest) (*DeleteFollowResponse, error)
+	ListFollowRequests(context.Context, *ListFollowRequestsRequest) (*ListFollowRequestsResponse, error)
+	AcceptFollowRequest(context.Context, *AcceptFollowRequestRequest) (*AcceptFollowRequestResponse, error)
+	RejectFollowRequest(context.Context, *RejectFollowRequestRequest) (*RejectFollowRequestResponse, error)
+	GetFollowSuggestions(context.Context, *GetFollowSuggestionsRequest) (*GetFollowSuggestionsResponse, error)
+	GetRelationships(context.Context, *GetRelationshipsRequest) (*GetRelationshipsResponse, error)
+	BlockAccount(context.Context, *BlockAccountRequest) (*BlockAccountResponse, error)
+	UnblockAccount(context.Context, *UnblockAccountRequest) (*UnblockAccountResponse, error)
+	ListBlockedAccounts(context.Context, *ListBlockedAccountsRequest) (*ListBlockedAccountsResponse, error)
+	MuteAccount(context.Context, *MuteAccountRequest) (*MuteAccountResponse, error)
+	UnmuteAccount(context.Context, *UnmuteAccountRequest) (*UnmuteAccountResponse, error)
+	ListMutedAccounts(context.Context, *ListMutedAccountsRequest) (*ListMutedAccountsResponse, error)
 	GetNewFeeds(context.Context, *GetNewFeedsRequest) (*GetNewFeedsResponse, error)
+	ReportContent(context.Context, *ReportContentRequest) (*ReportContentResponse, error)
+	ListReports(context.Context, *ListReportsRequest) (*ListReportsResponse, error)
+	ResolveReport(context.Context, *ResolveReportRequest) (*ResolveReportResponse, error)
+	TakedownContent(context.Context, *TakedownContentRequest) (*TakedownContentResponse, error)
 	mustEmbedUnimplementedGoFeedServiceServer()
 }
 
@@ -341,6 +965,15 @@ func (UnimplementedGoFeedServiceServer) CreateAccount(context.Context, *CreateAc
 func (UnimplementedGoFeedServiceServer) CreateSession(context.Context, *CreateSessionRequest) (*CreateSessionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateSession not implemented")
 }
+func (UnimplementedGoFeedServiceServer) SearchAccounts(context.Context, *SearchAccountsRequest) (*SearchAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchAccounts not implemented")
+}
+func (UnimplementedGoFeedServiceServer) SetLikesHidden(context.Context, *SetLikesHiddenRequest) (*SetLikesHiddenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLikesHidden not implemented")
+}
+func (UnimplementedGoFeedServiceServer) SetAccountPrivate(context.Context, *SetAccountPrivateRequest) (*SetAccountPrivateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAccountPrivate not implemented")
+}
 func (UnimplementedGoFeedServiceServer) CreatePost(context.Context, *CreatePostRequest) (*CreatePostResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreatePost not implemented")
 }
@@ -353,9 +986,69 @@ func (UnimplementedGoFeedServiceServer) GetPostOfAccount(context.Context, *GetPo
 func (UnimplementedGoFeedServiceServer) UpdatePost(context.Context, *UpdatePostRequest) (*UpdatePostResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdatePost not implemented")
 }
+func (UnimplementedGoFeedServiceServer) DeletePost(context.Context, *DeletePostRequest) (*DeletePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePost not implemented")
+}
+func (UnimplementedGoFeedServiceServer) RestorePost(context.Context, *RestorePostRequest) (*RestorePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestorePost not implemented")
+}
+func (UnimplementedGoFeedServiceServer) ListDeletedPosts(context.Context, *ListDeletedPostsRequest) (*ListDeletedPostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeletedPosts not implemented")
+}
+func (UnimplementedGoFeedServiceServer) GetPostsByHashtag(context.Context, *GetPostsByHashtagRequest) (*GetPostsByHashtagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPostsByHashtag not implemented")
+}
+func (UnimplementedGoFeedServiceServer) GetPostsMentioningAccount(context.Context, *GetPostsMentioningAccountRequest) (*GetPostsMentioningAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPostsMentioningAccount not implemented")
+}
+func (UnimplementedGoFeedServiceServer) SearchPosts(context.Context, *SearchPostsRequest) (*SearchPostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchPosts not implemented")
+}
+func (UnimplementedGoFeedServiceServer) CreateDraft(context.Context, *CreateDraftRequest) (*CreateDraftResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDraft not implemented")
+}
+func (UnimplementedGoFeedServiceServer) ListDrafts(context.Context, *ListDraftsRequest) (*ListDraftsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDrafts not implemented")
+}
+func (UnimplementedGoFeedServiceServer) UpdateDraft(context.Context, *UpdateDraftRequest) (*UpdateDraftResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDraft not implemented")
+}
+func (UnimplementedGoFeedServiceServer) PublishDraft(context.Context, *PublishDraftRequest) (*PublishDraftResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishDraft not implemented")
+}
+func (UnimplementedGoFeedServiceServer) PinPost(context.Context, *PinPostRequest) (*PinPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PinPost not implemented")
+}
+func (UnimplementedGoFeedServiceServer) UnpinPost(context.Context, *UnpinPostRequest) (*UnpinPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpinPost not implemented")
+}
+func (UnimplementedGoFeedServiceServer) CreateBookmark(context.Context, *CreateBookmarkRequest) (*CreateBookmarkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBookmark not implemented")
+}
+func (UnimplementedGoFeedServiceServer) DeleteBookmark(context.Context, *DeleteBookmarkRequest) (*DeleteBookmarkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBookmark not implemented")
+}
+func (UnimplementedGoFeedServiceServer) ListBookmarks(context.Context, *ListBookmarksRequest) (*ListBookmarksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBookmarks not implemented")
+}
+func (UnimplementedGoFeedServiceServer) GetTrendingHashtags(context.Context, *GetTrendingHashtagsRequest) (*GetTrendingHashtagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrendingHashtags not implemented")
+}
+func (UnimplementedGoFeedServiceServer) GetTrendingPosts(context.Context, *GetTrendingPostsRequest) (*GetTrendingPostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrendingPosts not implemented")
+}
+func (UnimplementedGoFeedServiceServer) VotePoll(context.Context, *VotePollRequest) (*VotePollResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VotePoll not implemented")
+}
+func (UnimplementedGoFeedServiceServer) GetPollResults(context.Context, *GetPollResultsRequest) (*GetPollResultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPollResults not implemented")
+}
 func (UnimplementedGoFeedServiceServer) CreateLike(context.Context, *CreateLikeRequest) (*CreateLikeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateLike not implemented")
 }
+func (UnimplementedGoFeedServiceServer) SetReaction(context.Context, *SetReactionRequest) (*SetReactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetReaction not implemented")
+}
 func (UnimplementedGoFeedServiceServer) GetLikeCountOfPost(context.Context, *GetLikeCountOfPostRequest) (*GetLikeCountOfPostResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetLikeCountOfPost not implemented")
 }
@@ -365,6 +1058,24 @@ func (UnimplementedGoFeedServiceServer) GetLikeAccountsOfPost(context.Context, *
 func (UnimplementedGoFeedServiceServer) DeleteLike(context.Context, *DeleteLikeRequest) (*DeleteLikeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteLike not implemented")
 }
+func (UnimplementedGoFeedServiceServer) DeleteReaction(context.Context, *DeleteReactionRequest) (*DeleteReactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteReaction not implemented")
+}
+func (UnimplementedGoFeedServiceServer) HasLikedPosts(context.Context, *HasLikedPostsRequest) (*HasLikedPostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HasLikedPosts not implemented")
+}
+func (UnimplementedGoFeedServiceServer) GetLikedPostsOfAccount(context.Context, *GetLikedPostsOfAccountRequest) (*GetLikedPostsOfAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLikedPostsOfAccount not implemented")
+}
+func (UnimplementedGoFeedServiceServer) CreateCommentLike(context.Context, *CreateCommentLikeRequest) (*CreateCommentLikeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCommentLike not implemented")
+}
+func (UnimplementedGoFeedServiceServer) GetLikeCountOfComment(context.Context, *GetLikeCountOfCommentRequest) (*GetLikeCountOfCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLikeCountOfComment not implemented")
+}
+func (UnimplementedGoFeedServiceServer) DeleteCommentLike(context.Context, *DeleteCommentLikeRequest) (*DeleteCommentLikeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCommentLike not implemented")
+}
 func (UnimplementedGoFeedServiceServer) CreateComment(context.Context, *CreateCommentRequest) (*CreateCommentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateComment not implemented")
 }
@@ -374,12 +1085,24 @@ func (UnimplementedGoFeedServiceServer) GetCommentCountOfPost(context.Context, *
 func (UnimplementedGoFeedServiceServer) GetCommentsOfPost(context.Context, *GetCommentsOfPostRequest) (*GetCommentsOfPostResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCommentsOfPost not implemented")
 }
+func (UnimplementedGoFeedServiceServer) GetCommentReplies(context.Context, *GetCommentRepliesRequest) (*GetCommentRepliesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommentReplies not implemented")
+}
 func (UnimplementedGoFeedServiceServer) UpdateComment(context.Context, *UpdateCommentRequest) (*UpdateCommentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateComment not implemented")
 }
 func (UnimplementedGoFeedServiceServer) DeleteComment(context.Context, *DeleteCommentRequest) (*DeleteCommentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteComment not implemented")
 }
+func (UnimplementedGoFeedServiceServer) HideComment(context.Context, *HideCommentRequest) (*HideCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HideComment not implemented")
+}
+func (UnimplementedGoFeedServiceServer) UnhideComment(context.Context, *UnhideCommentRequest) (*UnhideCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnhideComment not implemented")
+}
+func (UnimplementedGoFeedServiceServer) SetCommentPolicy(context.Context, *SetCommentPolicyRequest) (*SetCommentPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCommentPolicy not implemented")
+}
 func (UnimplementedGoFeedServiceServer) CreateFollow(context.Context, *CreateFollowRequest) (*CreateFollowResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateFollow not implemented")
 }
@@ -398,9 +1121,54 @@ func (UnimplementedGoFeedServiceServer) GetFollowingsOfAccount(context.Context,
 func (UnimplementedGoFeedServiceServer) DeleteFollow(context.Context, *DeleteFollowRequest) (*DeleteFollowResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteFollow not implemented")
 }
+func (UnimplementedGoFeedServiceServer) ListFollowRequests(context.Context, *ListFollowRequestsRequest) (*ListFollowRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFollowRequests not implemented")
+}
+func (UnimplementedGoFeedServiceServer) AcceptFollowRequest(context.Context, *AcceptFollowRequestRequest) (*AcceptFollowRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptFollowRequest not implemented")
+}
+func (UnimplementedGoFeedServiceServer) RejectFollowRequest(context.Context, *RejectFollowRequestRequest) (*RejectFollowRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectFollowRequest not implemented")
+}
+func (UnimplementedGoFeedServiceServer) GetFollowSuggestions(context.Context, *GetFollowSuggestionsRequest) (*GetFollowSuggestionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFollowSuggestions not implemented")
+}
+func (UnimplementedGoFeedServiceServer) GetRelationships(context.Context, *GetRelationshipsRequest) (*GetRelationshipsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRelationships not implemented")
+}
+func (UnimplementedGoFeedServiceServer) BlockAccount(context.Context, *BlockAccountRequest) (*BlockAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockAccount not implemented")
+}
+func (UnimplementedGoFeedServiceServer) UnblockAccount(context.Context, *UnblockAccountRequest) (*UnblockAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnblockAccount not implemented")
+}
+func (UnimplementedGoFeedServiceServer) ListBlockedAccounts(context.Context, *ListBlockedAccountsRequest) (*ListBlockedAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBlockedAccounts not implemented")
+}
+func (UnimplementedGoFeedServiceServer) MuteAccount(context.Context, *MuteAccountRequest) (*MuteAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MuteAccount not implemented")
+}
+func (UnimplementedGoFeedServiceServer) UnmuteAccount(context.Context, *UnmuteAccountRequest) (*UnmuteAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnmuteAccount not implemented")
+}
+func (UnimplementedGoFeedServiceServer) ListMutedAccounts(context.Context, *ListMutedAccountsRequest) (*ListMutedAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMutedAccounts not implemented")
+}
 func (UnimplementedGoFeedServiceServer) GetNewFeeds(context.Context, *GetNewFeedsRequest) (*GetNewFeedsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetNewFeeds not implemented")
 }
+func (UnimplementedGoFeedServiceServer) ReportContent(context.Context, *ReportContentRequest) (*ReportContentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportContent not implemented")
+}
+func (UnimplementedGoFeedServiceServer) ListReports(context.Context, *ListReportsRequest) (*ListReportsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReports not implemented")
+}
+func (UnimplementedGoFeedServiceServer) ResolveReport(context.Context, *ResolveReportRequest) (*ResolveReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveReport not implemented")
+}
+func (UnimplementedGoFeedServiceServer) TakedownContent(context.Context, *TakedownContentRequest) (*TakedownContentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TakedownContent not implemented")
+}
 func (UnimplementedGoFeedServiceServer) mustEmbedUnimplementedGoFeedServiceServer() {}
 func (UnimplementedGoFeedServiceServer) testEmbeddedByValue()                       {}
 
@@ -419,401 +1187,1265 @@ func RegisterGoFeedServiceServer(s grpc.ServiceRegistrar, srv GoFeedServiceServe
 	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
 		t.testEmbeddedByValue()
 	}
-	s.RegisterService(&GoFeedService_ServiceDesc, srv)
+	s.RegisterService(&GoFeedService_ServiceDesc, srv)
+}
+
+func _GoFeedService_CreateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoFeedServiceServer).CreateAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoFeedService_CreateAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoFeedServiceServer).CreateAccount(ctx, req.(*CreateAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoFeedService_CreateSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoFeedServiceServer).CreateSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoFeedService_CreateSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoFeedServiceServer).CreateSession(ctx, req.(*CreateSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoFeedService_SearchAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoFeedServiceServer).SearchAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoFeedService_SearchAccounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoFeedServiceServer).SearchAccounts(ctx, req.(*SearchAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoFeedService_SetLikesHidden_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLikesHiddenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoFeedServiceServer).SetLikesHidden(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoFeedService_SetLikesHidden_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoFeedServiceServer).SetLikesHidden(ctx, req.(*SetLikesHiddenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoFeedService_SetAccountPrivate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAccountPrivateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoFeedServiceServer).SetAccountPrivate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoFeedService_SetAccountPrivate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoFeedServiceServer).SetAccountPrivate(ctx, req.(*SetAccountPrivateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoFeedService_CreatePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoFeedServiceServer).CreatePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoFeedService_CreatePost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoFeedServiceServer).CreatePost(ctx, req.(*CreatePostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoFeedService_GetPostByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPostByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoFeedServiceServer).GetPostByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoFeedService_GetPostByID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoFeedServiceServer).GetPostByID(ctx, req.(*GetPostByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoFeedService_GetPostOfAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPostOfAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoFeedServiceServer).GetPostOfAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoFeedService_GetPostOfAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoFeedServiceServer).GetPostOfAccount(ctx, req.(*GetPostOfAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoFeedService_UpdatePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoFeedServiceServer).UpdatePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoFeedService_UpdatePost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoFeedServiceServer).UpdatePost(ctx, req.(*UpdatePostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoFeedService_DeletePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoFeedServiceServer).DeletePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoFeedService_DeletePost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoFeedServiceServer).DeletePost(ctx, req.(*DeletePostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoFeedService_RestorePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestorePostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoFeedServiceServer).RestorePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoFeedService_RestorePost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoFeedServiceServer).RestorePost(ctx, req.(*RestorePostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoFeedService_ListDeletedPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeletedPostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoFeedServiceServer).ListDeletedPosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoFeedService_ListDeletedPosts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoFeedServiceServer).ListDeletedPosts(ctx, req.(*ListDeletedPostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoFeedService_GetPostsByHashtag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPostsByHashtagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoFeedServiceServer).GetPostsByHashtag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoFeedService_GetPostsByHashtag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoFeedServiceServer).GetPostsByHashtag(ctx, req.(*GetPostsByHashtagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoFeedService_GetPostsMentioningAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPostsMentioningAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoFeedServiceServer).GetPostsMentioningAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoFeedService_GetPostsMentioningAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoFeedServiceServer).GetPostsMentioningAccount(ctx, req.(*GetPostsMentioningAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoFeedService_SearchPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchPostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoFeedServiceServer).SearchPosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoFeedService_SearchPosts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoFeedServiceServer).SearchPosts(ctx, req.(*SearchPostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoFeedService_CreateDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDraftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoFeedServiceServer).CreateDraft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoFeedService_CreateDraft_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoFeedServiceServer).CreateDraft(ctx, req.(*CreateDraftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoFeedService_ListDrafts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDraftsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoFeedServiceServer).ListDrafts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoFeedService_ListDrafts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoFeedServiceServer).ListDrafts(ctx, req.(*ListDraftsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoFeedService_UpdateDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDraftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoFeedServiceServer).UpdateDraft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoFeedService_UpdateDraft_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoFeedServiceServer).UpdateDraft(ctx, req.(*UpdateDraftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoFeedService_PublishDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishDraftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoFeedServiceServer).PublishDraft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoFeedService_PublishDraft_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoFeedServiceServer).PublishDraft(ctx, req.(*PublishDraftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoFeedService_PinPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PinPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoFeedServiceServer).PinPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoFeedService_PinPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoFeedServiceServer).PinPost(ctx, req.(*PinPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoFeedService_UnpinPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpinPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoFeedServiceServer).UnpinPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoFeedService_UnpinPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoFeedServiceServer).UnpinPost(ctx, req.(*UnpinPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoFeedService_CreateBookmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBookmarkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoFeedServiceServer).CreateBookmark(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoFeedService_CreateBookmark_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoFeedServiceServer).CreateBookmark(ctx, req.(*CreateBookmarkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoFeedService_DeleteBookmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBookmarkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoFeedServiceServer).DeleteBookmark(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoFeedService_DeleteBookmark_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoFeedServiceServer).DeleteBookmark(ctx, req.(*DeleteBookmarkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoFeedService_ListBookmarks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBookmarksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoFeedServiceServer).ListBookmarks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoFeedService_ListBookmarks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoFeedServiceServer).ListBookmarks(ctx, req.(*ListBookmarksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoFeedService_GetTrendingHashtags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTrendingHashtagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoFeedServiceServer).GetTrendingHashtags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoFeedService_GetTrendingHashtags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoFeedServiceServer).GetTrendingHashtags(ctx, req.(*GetTrendingHashtagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoFeedService_GetTrendingPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTrendingPostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoFeedServiceServer).GetTrendingPosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoFeedService_GetTrendingPosts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoFeedServiceServer).GetTrendingPosts(ctx, req.(*GetTrendingPostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoFeedService_VotePoll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VotePollRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoFeedServiceServer).VotePoll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoFeedService_VotePoll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoFeedServiceServer).VotePoll(ctx, req.(*VotePollRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoFeedService_GetPollResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPollResultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoFeedServiceServer).GetPollResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoFeedService_GetPollResults_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoFeedServiceServer).GetPollResults(ctx, req.(*GetPollResultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoFeedService_CreateLike_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateLikeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoFeedServiceServer).CreateLike(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoFeedService_CreateLike_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoFeedServiceServer).CreateLike(ctx, req.(*CreateLikeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoFeedService_SetReaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetReactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoFeedServiceServer).SetReaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoFeedService_SetReaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoFeedServiceServer).SetReaction(ctx, req.(*SetReactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoFeedService_GetLikeCountOfPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLikeCountOfPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoFeedServiceServer).GetLikeCountOfPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoFeedService_GetLikeCountOfPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoFeedServiceServer).GetLikeCountOfPost(ctx, req.(*GetLikeCountOfPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoFeedService_GetLikeAccountsOfPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLikeAccountsOfPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoFeedServiceServer).GetLikeAccountsOfPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoFeedService_GetLikeAccountsOfPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoFeedServiceServer).GetLikeAccountsOfPost(ctx, req.(*GetLikeAccountsOfPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoFeedService_DeleteLike_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteLikeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoFeedServiceServer).DeleteLike(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoFeedService_DeleteLike_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoFeedServiceServer).DeleteLike(ctx, req.(*DeleteLikeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoFeedService_DeleteReaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteReactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoFeedServiceServer).DeleteReaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoFeedService_DeleteReaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoFeedServiceServer).DeleteReaction(ctx, req.(*DeleteReactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoFeedService_HasLikedPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HasLikedPostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoFeedServiceServer).HasLikedPosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoFeedService_HasLikedPosts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoFeedServiceServer).HasLikedPosts(ctx, req.(*HasLikedPostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoFeedService_GetLikedPostsOfAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLikedPostsOfAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoFeedServiceServer).GetLikedPostsOfAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoFeedService_GetLikedPostsOfAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoFeedServiceServer).GetLikedPostsOfAccount(ctx, req.(*GetLikedPostsOfAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoFeedService_CreateCommentLike_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCommentLikeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoFeedServiceServer).CreateCommentLike(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoFeedService_CreateCommentLike_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoFeedServiceServer).CreateCommentLike(ctx, req.(*CreateCommentLikeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoFeedService_GetLikeCountOfComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLikeCountOfCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoFeedServiceServer).GetLikeCountOfComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoFeedService_GetLikeCountOfComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoFeedServiceServer).GetLikeCountOfComment(ctx, req.(*GetLikeCountOfCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoFeedService_DeleteCommentLike_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCommentLikeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoFeedServiceServer).DeleteCommentLike(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoFeedService_DeleteCommentLike_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoFeedServiceServer).DeleteCommentLike(ctx, req.(*DeleteCommentLikeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoFeedService_CreateComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoFeedServiceServer).CreateComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoFeedService_CreateComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoFeedServiceServer).CreateComment(ctx, req.(*CreateCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoFeedService_GetCommentCountOfPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommentCountOfPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoFeedServiceServer).GetCommentCountOfPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoFeedService_GetCommentCountOfPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoFeedServiceServer).GetCommentCountOfPost(ctx, req.(*GetCommentCountOfPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoFeedService_GetCommentsOfPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommentsOfPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoFeedServiceServer).GetCommentsOfPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoFeedService_GetCommentsOfPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoFeedServiceServer).GetCommentsOfPost(ctx, req.(*GetCommentsOfPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoFeedService_GetCommentReplies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommentRepliesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoFeedServiceServer).GetCommentReplies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoFeedService_GetCommentReplies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoFeedServiceServer).GetCommentReplies(ctx, req.(*GetCommentRepliesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoFeedService_UpdateComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoFeedServiceServer).UpdateComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoFeedService_UpdateComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoFeedServiceServer).UpdateComment(ctx, req.(*UpdateCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoFeedService_DeleteComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoFeedServiceServer).DeleteComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoFeedService_DeleteComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoFeedServiceServer).DeleteComment(ctx, req.(*DeleteCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoFeedService_HideComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HideCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoFeedServiceServer).HideComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoFeedService_HideComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoFeedServiceServer).HideComment(ctx, req.(*HideCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoFeedService_UnhideComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnhideCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoFeedServiceServer).UnhideComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoFeedService_UnhideComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoFeedServiceServer).UnhideComment(ctx, req.(*UnhideCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _GoFeedService_CreateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateAccountRequest)
+func _GoFeedService_SetCommentPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCommentPolicyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoFeedServiceServer).CreateAccount(ctx, in)
+		return srv.(GoFeedServiceServer).SetCommentPolicy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoFeedService_CreateAccount_FullMethodName,
+		FullMethod: GoFeedService_SetCommentPolicy_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoFeedServiceServer).CreateAccount(ctx, req.(*CreateAccountRequest))
+		return srv.(GoFeedServiceServer).SetCommentPolicy(ctx, req.(*SetCommentPolicyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoFeedService_CreateSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateSessionRequest)
+func _GoFeedService_CreateFollow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFollowRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoFeedServiceServer).CreateSession(ctx, in)
+		return srv.(GoFeedServiceServer).CreateFollow(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoFeedService_CreateSession_FullMethodName,
+		FullMethod: GoFeedService_CreateFollow_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoFeedServiceServer).CreateSession(ctx, req.(*CreateSessionRequest))
+		return srv.(GoFeedServiceServer).CreateFollow(ctx, req.(*CreateFollowRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoFeedService_CreatePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreatePostRequest)
+func _GoFeedService_GetFollowerCountOfAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFollowerCountOfAccountRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoFeedServiceServer).CreatePost(ctx, in)
+		return srv.(GoFeedServiceServer).GetFollowerCountOfAccount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoFeedService_CreatePost_FullMethodName,
+		FullMethod: GoFeedService_GetFollowerCountOfAccount_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoFeedServiceServer).CreatePost(ctx, req.(*CreatePostRequest))
+		return srv.(GoFeedServiceServer).GetFollowerCountOfAccount(ctx, req.(*GetFollowerCountOfAccountRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoFeedService_GetPostByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPostByIDRequest)
+func _GoFeedService_GetFollowersOfAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFollowersOfAccountRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoFeedServiceServer).GetPostByID(ctx, in)
+		return srv.(GoFeedServiceServer).GetFollowersOfAccount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoFeedService_GetPostByID_FullMethodName,
+		FullMethod: GoFeedService_GetFollowersOfAccount_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoFeedServiceServer).GetPostByID(ctx, req.(*GetPostByIDRequest))
+		return srv.(GoFeedServiceServer).GetFollowersOfAccount(ctx, req.(*GetFollowersOfAccountRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoFeedService_GetPostOfAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPostOfAccountRequest)
+func _GoFeedService_GetFollowingCountOfAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFollowingCountOfAccountRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoFeedServiceServer).GetPostOfAccount(ctx, in)
+		return srv.(GoFeedServiceServer).GetFollowingCountOfAccount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoFeedService_GetPostOfAccount_FullMethodName,
+		FullMethod: GoFeedService_GetFollowingCountOfAccount_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoFeedServiceServer).GetPostOfAccount(ctx, req.(*GetPostOfAccountRequest))
+		return srv.(GoFeedServiceServer).GetFollowingCountOfAccount(ctx, req.(*GetFollowingCountOfAccountRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoFeedService_UpdatePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdatePostRequest)
+func _GoFeedService_GetFollowingsOfAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFollowingsOfAccountRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoFeedServiceServer).UpdatePost(ctx, in)
+		return srv.(GoFeedServiceServer).GetFollowingsOfAccount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoFeedService_UpdatePost_FullMethodName,
+		FullMethod: GoFeedService_GetFollowingsOfAccount_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoFeedServiceServer).UpdatePost(ctx, req.(*UpdatePostRequest))
+		return srv.(GoFeedServiceServer).GetFollowingsOfAccount(ctx, req.(*GetFollowingsOfAccountRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoFeedService_CreateLike_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateLikeRequest)
+func _GoFeedService_DeleteFollow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFollowRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoFeedServiceServer).CreateLike(ctx, in)
+		return srv.(GoFeedServiceServer).DeleteFollow(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoFeedService_CreateLike_FullMethodName,
+		FullMethod: GoFeedService_DeleteFollow_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoFeedServiceServer).CreateLike(ctx, req.(*CreateLikeRequest))
+		return srv.(GoFeedServiceServer).DeleteFollow(ctx, req.(*DeleteFollowRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoFeedService_GetLikeCountOfPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetLikeCountOfPostRequest)
+func _GoFeedService_ListFollowRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFollowRequestsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoFeedServiceServer).GetLikeCountOfPost(ctx, in)
+		return srv.(GoFeedServiceServer).ListFollowRequests(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoFeedService_GetLikeCountOfPost_FullMethodName,
+		FullMethod: GoFeedService_ListFollowRequests_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoFeedServiceServer).GetLikeCountOfPost(ctx, req.(*GetLikeCountOfPostRequest))
+		return srv.(GoFeedServiceServer).ListFollowRequests(ctx, req.(*ListFollowRequestsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoFeedService_GetLikeAccountsOfPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetLikeAccountsOfPostRequest)
+func _GoFeedService_AcceptFollowRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptFollowRequestRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoFeedServiceServer).GetLikeAccountsOfPost(ctx, in)
+		return srv.(GoFeedServiceServer).AcceptFollowRequest(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoFeedService_GetLikeAccountsOfPost_FullMethodName,
+		FullMethod: GoFeedService_AcceptFollowRequest_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoFeedServiceServer).GetLikeAccountsOfPost(ctx, req.(*GetLikeAccountsOfPostRequest))
+		return srv.(GoFeedServiceServer).AcceptFollowRequest(ctx, req.(*AcceptFollowRequestRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoFeedService_DeleteLike_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteLikeRequest)
+func _GoFeedService_RejectFollowRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectFollowRequestRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoFeedServiceServer).DeleteLike(ctx, in)
+		return srv.(GoFeedServiceServer).RejectFollowRequest(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoFeedService_DeleteLike_FullMethodName,
+		FullMethod: GoFeedService_RejectFollowRequest_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoFeedServiceServer).DeleteLike(ctx, req.(*DeleteLikeRequest))
+		return srv.(GoFeedServiceServer).RejectFollowRequest(ctx, req.(*RejectFollowRequestRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoFeedService_CreateComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateCommentRequest)
+func _GoFeedService_GetFollowSuggestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFollowSuggestionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoFeedServiceServer).CreateComment(ctx, in)
+		return srv.(GoFeedServiceServer).GetFollowSuggestions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoFeedService_CreateComment_FullMethodName,
+		FullMethod: GoFeedService_GetFollowSuggestions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoFeedServiceServer).CreateComment(ctx, req.(*CreateCommentRequest))
+		return srv.(GoFeedServiceServer).GetFollowSuggestions(ctx, req.(*GetFollowSuggestionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoFeedService_GetCommentCountOfPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCommentCountOfPostRequest)
+func _GoFeedService_GetRelationships_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRelationshipsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoFeedServiceServer).GetCommentCountOfPost(ctx, in)
+		return srv.(GoFeedServiceServer).GetRelationships(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoFeedService_GetCommentCountOfPost_FullMethodName,
+		FullMethod: GoFeedService_GetRelationships_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoFeedServiceServer).GetCommentCountOfPost(ctx, req.(*GetCommentCountOfPostRequest))
+		return srv.(GoFeedServiceServer).GetRelationships(ctx, req.(*GetRelationshipsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoFeedService_GetCommentsOfPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCommentsOfPostRequest)
+func _GoFeedService_BlockAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockAccountRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoFeedServiceServer).GetCommentsOfPost(ctx, in)
+		return srv.(GoFeedServiceServer).BlockAccount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoFeedService_GetCommentsOfPost_FullMethodName,
+		FullMethod: GoFeedService_BlockAccount_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoFeedServiceServer).GetCommentsOfPost(ctx, req.(*GetCommentsOfPostRequest))
+		return srv.(GoFeedServiceServer).BlockAccount(ctx, req.(*BlockAccountRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoFeedService_UpdateComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateCommentRequest)
+func _GoFeedService_UnblockAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnblockAccountRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoFeedServiceServer).UpdateComment(ctx, in)
+		return srv.(GoFeedServiceServer).UnblockAccount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoFeedService_UpdateComment_FullMethodName,
+		FullMethod: GoFeedService_UnblockAccount_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoFeedServiceServer).UpdateComment(ctx, req.(*UpdateCommentRequest))
+		return srv.(GoFeedServiceServer).UnblockAccount(ctx, req.(*UnblockAccountRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoFeedService_DeleteComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteCommentRequest)
+func _GoFeedService_ListBlockedAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBlockedAccountsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoFeedServiceServer).DeleteComment(ctx, in)
+		return srv.(GoFeedServiceServer).ListBlockedAccounts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoFeedService_DeleteComment_FullMethodName,
+		FullMethod: GoFeedService_ListBlockedAccounts_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoFeedServiceServer).DeleteComment(ctx, req.(*DeleteCommentRequest))
+		return srv.(GoFeedServiceServer).ListBlockedAccounts(ctx, req.(*ListBlockedAccountsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoFeedService_CreateFollow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateFollowRequest)
+func _GoFeedService_MuteAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MuteAccountRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoFeedServiceServer).CreateFollow(ctx, in)
+		return srv.(GoFeedServiceServer).MuteAccount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoFeedService_CreateFollow_FullMethodName,
+		FullMethod: GoFeedService_MuteAccount_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoFeedServiceServer).CreateFollow(ctx, req.(*CreateFollowRequest))
+		return srv.(GoFeedServiceServer).MuteAccount(ctx, req.(*MuteAccountRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoFeedService_GetFollowerCountOfAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetFollowerCountOfAccountRequest)
+func _GoFeedService_UnmuteAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnmuteAccountRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoFeedServiceServer).GetFollowerCountOfAccount(ctx, in)
+		return srv.(GoFeedServiceServer).UnmuteAccount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoFeedService_GetFollowerCountOfAccount_FullMethodName,
+		FullMethod: GoFeedService_UnmuteAccount_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoFeedServiceServer).GetFollowerCountOfAccount(ctx, req.(*GetFollowerCountOfAccountRequest))
+		return srv.(GoFeedServiceServer).UnmuteAccount(ctx, req.(*UnmuteAccountRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoFeedService_GetFollowersOfAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetFollowersOfAccountRequest)
+func _GoFeedService_ListMutedAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMutedAccountsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoFeedServiceServer).GetFollowersOfAccount(ctx, in)
+		return srv.(GoFeedServiceServer).ListMutedAccounts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoFeedService_GetFollowersOfAccount_FullMethodName,
+		FullMethod: GoFeedService_ListMutedAccounts_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoFeedServiceServer).GetFollowersOfAccount(ctx, req.(*GetFollowersOfAccountRequest))
+		return srv.(GoFeedServiceServer).ListMutedAccounts(ctx, req.(*ListMutedAccountsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoFeedService_GetFollowingCountOfAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetFollowingCountOfAccountRequest)
+func _GoFeedService_GetNewFeeds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNewFeedsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoFeedServiceServer).GetFollowingCountOfAccount(ctx, in)
+		return srv.(GoFeedServiceServer).GetNewFeeds(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoFeedService_GetFollowingCountOfAccount_FullMethodName,
+		FullMethod: GoFeedService_GetNewFeeds_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoFeedServiceServer).GetFollowingCountOfAccount(ctx, req.(*GetFollowingCountOfAccountRequest))
+		return srv.(GoFeedServiceServer).GetNewFeeds(ctx, req.(*GetNewFeedsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoFeedService_GetFollowingsOfAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetFollowingsOfAccountRequest)
+func _GoFeedService_ReportContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportContentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoFeedServiceServer).GetFollowingsOfAccount(ctx, in)
+		return srv.(GoFeedServiceServer).ReportContent(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoFeedService_GetFollowingsOfAccount_FullMethodName,
+		FullMethod: GoFeedService_ReportContent_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoFeedServiceServer).GetFollowingsOfAccount(ctx, req.(*GetFollowingsOfAccountRequest))
+		return srv.(GoFeedServiceServer).ReportContent(ctx, req.(*ReportContentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoFeedService_DeleteFollow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteFollowRequest)
+func _GoFeedService_ListReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReportsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoFeedServiceServer).DeleteFollow(ctx, in)
+		return srv.(GoFeedServiceServer).ListReports(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoFeedService_DeleteFollow_FullMethodName,
+		FullMethod: GoFeedService_ListReports_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoFeedServiceServer).DeleteFollow(ctx, req.(*DeleteFollowRequest))
+		return srv.(GoFeedServiceServer).ListReports(ctx, req.(*ListReportsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoFeedService_GetNewFeeds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetNewFeedsRequest)
+func _GoFeedService_ResolveReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveReportRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoFeedServiceServer).GetNewFeeds(ctx, in)
+		return srv.(GoFeedServiceServer).ResolveReport(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoFeedService_GetNewFeeds_FullMethodName,
+		FullMethod: GoFeedService_ResolveReport_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoFeedServiceServer).GetNewFeeds(ctx, req.(*GetNewFeedsRequest))
+		return srv.(GoFeedServiceServer).ResolveReport(ctx, req.(*ResolveReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoFeedService_TakedownContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TakedownContentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoFeedServiceServer).TakedownContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoFeedService_TakedownContent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoFeedServiceServer).TakedownContent(ctx, req.(*TakedownContentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -833,6 +2465,18 @@ var GoFeedService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateSession",
 			Handler:    _GoFeedService_CreateSession_Handler,
 		},
+		{
+			MethodName: "SearchAccounts",
+			Handler:    _GoFeedService_SearchAccounts_Handler,
+		},
+		{
+			MethodName: "SetLikesHidden",
+			Handler:    _GoFeedService_SetLikesHidden_Handler,
+		},
+		{
+			MethodName: "SetAccountPrivate",
+			Handler:    _GoFeedService_SetAccountPrivate_Handler,
+		},
 		{
 			MethodName: "CreatePost",
 			Handler:    _GoFeedService_CreatePost_Handler,
@@ -849,10 +2493,90 @@ var GoFeedService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdatePost",
 			Handler:    _GoFeedService_UpdatePost_Handler,
 		},
+		{
+			MethodName: "DeletePost",
+			Handler:    _GoFeedService_DeletePost_Handler,
+		},
+		{
+			MethodName: "RestorePost",
+			Handler:    _GoFeedService_RestorePost_Handler,
+		},
+		{
+			MethodName: "ListDeletedPosts",
+			Handler:    _GoFeedService_ListDeletedPosts_Handler,
+		},
+		{
+			MethodName: "GetPostsByHashtag",
+			Handler:    _GoFeedService_GetPostsByHashtag_Handler,
+		},
+		{
+			MethodName: "GetPostsMentioningAccount",
+			Handler:    _GoFeedService_GetPostsMentioningAccount_Handler,
+		},
+		{
+			MethodName: "SearchPosts",
+			Handler:    _GoFeedService_SearchPosts_Handler,
+		},
+		{
+			MethodName: "CreateDraft",
+			Handler:    _GoFeedService_CreateDraft_Handler,
+		},
+		{
+			MethodName: "ListDrafts",
+			Handler:    _GoFeedService_ListDrafts_Handler,
+		},
+		{
+			MethodName: "UpdateDraft",
+			Handler:    _GoFeedService_UpdateDraft_Handler,
+		},
+		{
+			MethodName: "PublishDraft",
+			Handler:    _GoFeedService_PublishDraft_Handler,
+		},
+		{
+			MethodName: "PinPost",
+			Handler:    _GoFeedService_PinPost_Handler,
+		},
+		{
+			MethodName: "UnpinPost",
+			Handler:    _GoFeedService_UnpinPost_Handler,
+		},
+		{
+			MethodName: "CreateBookmark",
+			Handler:    _GoFeedService_CreateBookmark_Handler,
+		},
+		{
+			MethodName: "DeleteBookmark",
+			Handler:    _GoFeedService_DeleteBookmark_Handler,
+		},
+		{
+			MethodName: "ListBookmarks",
+			Handler:    _GoFeedService_ListBookmarks_Handler,
+		},
+		{
+			MethodName: "GetTrendingHashtags",
+			Handler:    _GoFeedService_GetTrendingHashtags_Handler,
+		},
+		{
+			MethodName: "GetTrendingPosts",
+			Handler:    _GoFeedService_GetTrendingPosts_Handler,
+		},
+		{
+			MethodName: "VotePoll",
+			Handler:    _GoFeedService_VotePoll_Handler,
+		},
+		{
+			MethodName: "GetPollResults",
+			Handler:    _GoFeedService_GetPollResults_Handler,
+		},
 		{
 			MethodName: "CreateLike",
 			Handler:    _GoFeedService_CreateLike_Handler,
 		},
+		{
+			MethodName: "SetReaction",
+			Handler:    _GoFeedService_SetReaction_Handler,
+		},
 		{
 			MethodName: "GetLikeCountOfPost",
 			Handler:    _GoFeedService_GetLikeCountOfPost_Handler,
@@ -865,6 +2589,30 @@ var GoFeedService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteLike",
 			Handler:    _GoFeedService_DeleteLike_Handler,
 		},
+		{
+			MethodName: "DeleteReaction",
+			Handler:    _GoFeedService_DeleteReaction_Handler,
+		},
+		{
+			MethodName: "HasLikedPosts",
+			Handler:    _GoFeedService_HasLikedPosts_Handler,
+		},
+		{
+			MethodName: "GetLikedPostsOfAccount",
+			Handler:    _GoFeedService_GetLikedPostsOfAccount_Handler,
+		},
+		{
+			MethodName: "CreateCommentLike",
+			Handler:    _GoFeedService_CreateCommentLike_Handler,
+		},
+		{
+			MethodName: "GetLikeCountOfComment",
+			Handler:    _GoFeedService_GetLikeCountOfComment_Handler,
+		},
+		{
+			MethodName: "DeleteCommentLike",
+			Handler:    _GoFeedService_DeleteCommentLike_Handler,
+		},
 		{
 			MethodName: "CreateComment",
 			Handler:    _GoFeedService_CreateComment_Handler,
@@ -877,6 +2625,10 @@ var GoFeedService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCommentsOfPost",
 			Handler:    _GoFeedService_GetCommentsOfPost_Handler,
 		},
+		{
+			MethodName: "GetCommentReplies",
+			Handler:    _GoFeedService_GetCommentReplies_Handler,
+		},
 		{
 			MethodName: "UpdateComment",
 			Handler:    _GoFeedService_UpdateComment_Handler,
@@ -885,6 +2637,18 @@ var GoFeedService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteComment",
 			Handler:    _GoFeedService_DeleteComment_Handler,
 		},
+		{
+			MethodName: "HideComment",
+			Handler:    _GoFeedService_HideComment_Handler,
+		},
+		{
+			MethodName: "UnhideComment",
+			Handler:    _GoFeedService_UnhideComment_Handler,
+		},
+		{
+			MethodName: "SetCommentPolicy",
+			Handler:    _GoFeedService_SetCommentPolicy_Handler,
+		},
 		{
 			MethodName: "CreateFollow",
 			Handler:    _GoFeedService_CreateFollow_Handler,
@@ -909,10 +2673,70 @@ var GoFeedService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteFollow",
 			Handler:    _GoFeedService_DeleteFollow_Handler,
 		},
+		{
+			MethodName: "ListFollowRequests",
+			Handler:    _GoFeedService_ListFollowRequests_Handler,
+		},
+		{
+			MethodName: "AcceptFollowRequest",
+			Handler:    _GoFeedService_AcceptFollowRequest_Handler,
+		},
+		{
+			MethodName: "RejectFollowRequest",
+			Handler:    _GoFeedService_RejectFollowRequest_Handler,
+		},
+		{
+			MethodName: "GetFollowSuggestions",
+			Handler:    _GoFeedService_GetFollowSuggestions_Handler,
+		},
+		{
+			MethodName: "GetRelationships",
+			Handler:    _GoFeedService_GetRelationships_Handler,
+		},
+		{
+			MethodName: "BlockAccount",
+			Handler:    _GoFeedService_BlockAccount_Handler,
+		},
+		{
+			MethodName: "UnblockAccount",
+			Handler:    _GoFeedService_UnblockAccount_Handler,
+		},
+		{
+			MethodName: "ListBlockedAccounts",
+			Handler:    _GoFeedService_ListBlockedAccounts_Handler,
+		},
+		{
+			MethodName: "MuteAccount",
+			Handler:    _GoFeedService_MuteAccount_Handler,
+		},
+		{
+			MethodName: "UnmuteAccount",
+			Handler:    _GoFeedService_UnmuteAccount_Handler,
+		},
+		{
+			MethodName: "ListMutedAccounts",
+			Handler:    _GoFeedService_ListMutedAccounts_Handler,
+		},
 		{
 			MethodName: "GetNewFeeds",
 			Handler:    _GoFeedService_GetNewFeeds_Handler,
 		},
+		{
+			MethodName: "ReportContent",
+			Handler:    _GoFeedService_ReportContent_Handler,
+		},
+		{
+			MethodName: "ListReports",
+			Handler:    _GoFeedService_ListReports_Handler,
+		},
+		{
+			MethodName: "ResolveReport",
+			Handler:    _GoFeedService_ResolveReport_Handler,
+		},
+		{
+			MethodName: "TakedownContent",
+			Handler:    _GoFeedService_TakedownContent_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/go_feed/go_feed.proto",
@@ -7,6 +7,7 @@
 package go_feed
 
 import (
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -216,28 +217,30 @@ func (x *CreateSessionResponse) GetAccountId() uint64 {
 	return 0
 }
 
-type CreatePostRequest struct {
+type SearchAccountsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Content string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	Query  string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Offset uint64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit  uint64 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func (x *CreatePostRequest) Reset() {
-	*x = CreatePostRequest{}
+func (x *SearchAccountsRequest) Reset() {
+	*x = SearchAccountsRequest{}
 	mi := &file_api_go_feed_request_and_response_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreatePostRequest) String() string {
+func (x *SearchAccountsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatePostRequest) ProtoMessage() {}
+func (*SearchAccountsRequest) ProtoMessage() {}
 
-func (x *CreatePostRequest) ProtoReflect() protoreflect.Message {
+func (x *SearchAccountsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_go_feed_request_and_response_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -249,40 +252,54 @@ func (x *CreatePostRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatePostRequest.ProtoReflect.Descriptor instead.
-func (*CreatePostRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SearchAccountsRequest.ProtoReflect.Descriptor instead.
+func (*SearchAccountsRequest) Descriptor() ([]byte, []int) {
 	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *CreatePostRequest) GetContent() string {
+func (x *SearchAccountsRequest) GetQuery() string {
 	if x != nil {
-		return x.Content
+		return x.Query
 	}
 	return ""
 }
 
-type CreatePostResponse struct {
+func (x *SearchAccountsRequest) GetOffset() uint64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *SearchAccountsRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SearchAccountsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PostId uint64 `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	AccountList []*Account `protobuf:"bytes,1,rep,name=account_list,json=accountList,proto3" json:"account_list,omitempty"`
 }
 
-func (x *CreatePostResponse) Reset() {
-	*x = CreatePostResponse{}
+func (x *SearchAccountsResponse) Reset() {
+	*x = SearchAccountsResponse{}
 	mi := &file_api_go_feed_request_and_response_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreatePostResponse) String() string {
+func (x *SearchAccountsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatePostResponse) ProtoMessage() {}
+func (*SearchAccountsResponse) ProtoMessage() {}
 
-func (x *CreatePostResponse) ProtoReflect() protoreflect.Message {
+func (x *SearchAccountsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_go_feed_request_and_response_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -294,40 +311,41 @@ func (x *CreatePostResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatePostResponse.ProtoReflect.Descriptor instead.
-func (*CreatePostResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SearchAccountsResponse.ProtoReflect.Descriptor instead.
+func (*SearchAccountsResponse) Descriptor() ([]byte, []int) {
 	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *CreatePostResponse) GetPostId() uint64 {
+func (x *SearchAccountsResponse) GetAccountList() []*Account {
 	if x != nil {
-		return x.PostId
+		return x.AccountList
 	}
-	return 0
+	return nil
 }
 
-type GetPostByIDRequest struct {
+// SetLikesHiddenRequest hides the posts the account liked from other accounts, or shows them again.
+type SetLikesHiddenRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PostId uint64 `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	LikesHidden bool `protobuf:"varint,1,opt,name=likes_hidden,json=likesHidden,proto3" json:"likes_hidden,omitempty"`
 }
 
-func (x *GetPostByIDRequest) Reset() {
-	*x = GetPostByIDRequest{}
+func (x *SetLikesHiddenRequest) Reset() {
+	*x = SetLikesHiddenRequest{}
 	mi := &file_api_go_feed_request_and_response_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPostByIDRequest) String() string {
+func (x *SetLikesHiddenRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPostByIDRequest) ProtoMessage() {}
+func (*SetLikesHiddenRequest) ProtoMessage() {}
 
-func (x *GetPostByIDRequest) ProtoReflect() protoreflect.Message {
+func (x *SetLikesHiddenRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_go_feed_request_and_response_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -339,40 +357,38 @@ func (x *GetPostByIDRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPostByIDRequest.ProtoReflect.Descriptor instead.
-func (*GetPostByIDRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetLikesHiddenRequest.ProtoReflect.Descriptor instead.
+func (*SetLikesHiddenRequest) Descriptor() ([]byte, []int) {
 	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *GetPostByIDRequest) GetPostId() uint64 {
+func (x *SetLikesHiddenRequest) GetLikesHidden() bool {
 	if x != nil {
-		return x.PostId
+		return x.LikesHidden
 	}
-	return 0
+	return false
 }
 
-type GetPostByIDResponse struct {
+type SetLikesHiddenResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Post *Post `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
 }
 
-func (x *GetPostByIDResponse) Reset() {
-	*x = GetPostByIDResponse{}
+func (x *SetLikesHiddenResponse) Reset() {
+	*x = SetLikesHiddenResponse{}
 	mi := &file_api_go_feed_request_and_response_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPostByIDResponse) String() string {
+func (x *SetLikesHiddenResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPostByIDResponse) ProtoMessage() {}
+func (*SetLikesHiddenResponse) ProtoMessage() {}
 
-func (x *GetPostByIDResponse) ProtoReflect() protoreflect.Message {
+func (x *SetLikesHiddenResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_go_feed_request_and_response_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -384,40 +400,35 @@ func (x *GetPostByIDResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPostByIDResponse.ProtoReflect.Descriptor instead.
-func (*GetPostByIDResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetLikesHiddenResponse.ProtoReflect.Descriptor instead.
+func (*SetLikesHiddenResponse) Descriptor() ([]byte, []int) {
 	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *GetPostByIDResponse) GetPost() *Post {
-	if x != nil {
-		return x.Post
-	}
-	return nil
-}
-
-type GetPostOfAccountRequest struct {
+// SetAccountPrivateRequest makes following the account need its approval and hides its posts from accounts that do
+// not follow it, or makes it public again.
+type SetAccountPrivateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AccountId uint64 `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	IsPrivate bool `protobuf:"varint,1,opt,name=is_private,json=isPrivate,proto3" json:"is_private,omitempty"`
 }
 
-func (x *GetPostOfAccountRequest) Reset() {
-	*x = GetPostOfAccountRequest{}
+func (x *SetAccountPrivateRequest) Reset() {
+	*x = SetAccountPrivateRequest{}
 	mi := &file_api_go_feed_request_and_response_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPostOfAccountRequest) String() string {
+func (x *SetAccountPrivateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPostOfAccountRequest) ProtoMessage() {}
+func (*SetAccountPrivateRequest) ProtoMessage() {}
 
-func (x *GetPostOfAccountRequest) ProtoReflect() protoreflect.Message {
+func (x *SetAccountPrivateRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_go_feed_request_and_response_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -429,40 +440,38 @@ func (x *GetPostOfAccountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPostOfAccountRequest.ProtoReflect.Descriptor instead.
-func (*GetPostOfAccountRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetAccountPrivateRequest.ProtoReflect.Descriptor instead.
+func (*SetAccountPrivateRequest) Descriptor() ([]byte, []int) {
 	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *GetPostOfAccountRequest) GetAccountId() uint64 {
+func (x *SetAccountPrivateRequest) GetIsPrivate() bool {
 	if x != nil {
-		return x.AccountId
+		return x.IsPrivate
 	}
-	return 0
+	return false
 }
 
-type GetPostOfAccountResponse struct {
+type SetAccountPrivateResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	PostList []*Post `protobuf:"bytes,1,rep,name=post_list,json=postList,proto3" json:"post_list,omitempty"`
 }
 
-func (x *GetPostOfAccountResponse) Reset() {
-	*x = GetPostOfAccountResponse{}
+func (x *SetAccountPrivateResponse) Reset() {
+	*x = SetAccountPrivateResponse{}
 	mi := &file_api_go_feed_request_and_response_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPostOfAccountResponse) String() string {
+func (x *SetAccountPrivateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPostOfAccountResponse) ProtoMessage() {}
+func (*SetAccountPrivateResponse) ProtoMessage() {}
 
-func (x *GetPostOfAccountResponse) ProtoReflect() protoreflect.Message {
+func (x *SetAccountPrivateResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_go_feed_request_and_response_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -474,40 +483,34 @@ func (x *GetPostOfAccountResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPostOfAccountResponse.ProtoReflect.Descriptor instead.
-func (*GetPostOfAccountResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetAccountPrivateResponse.ProtoReflect.Descriptor instead.
+func (*SetAccountPrivateResponse) Descriptor() ([]byte, []int) {
 	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *GetPostOfAccountResponse) GetPostList() []*Post {
-	if x != nil {
-		return x.PostList
-	}
-	return nil
-}
-
-type UpdatePostRequest struct {
+type CreatePostRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Post *Post `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
+	Content string   `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	Poll    *NewPoll `protobuf:"bytes,2,opt,name=poll,proto3" json:"poll,omitempty"`
 }
 
-func (x *UpdatePostRequest) Reset() {
-	*x = UpdatePostRequest{}
+func (x *CreatePostRequest) Reset() {
+	*x = CreatePostRequest{}
 	mi := &file_api_go_feed_request_and_response_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdatePostRequest) String() string {
+func (x *CreatePostRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdatePostRequest) ProtoMessage() {}
+func (*CreatePostRequest) ProtoMessage() {}
 
-func (x *UpdatePostRequest) ProtoReflect() protoreflect.Message {
+func (x *CreatePostRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_go_feed_request_and_response_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -519,38 +522,47 @@ func (x *UpdatePostRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdatePostRequest.ProtoReflect.Descriptor instead.
-func (*UpdatePostRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreatePostRequest.ProtoReflect.Descriptor instead.
+func (*CreatePostRequest) Descriptor() ([]byte, []int) {
 	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *UpdatePostRequest) GetPost() *Post {
+func (x *CreatePostRequest) GetContent() string {
 	if x != nil {
-		return x.Post
+		return x.Content
+	}
+	return ""
+}
+
+func (x *CreatePostRequest) GetPoll() *NewPoll {
+	if x != nil {
+		return x.Poll
 	}
 	return nil
 }
 
-type UpdatePostResponse struct {
+type CreatePostResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	PostId uint64 `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
 }
 
-func (x *UpdatePostResponse) Reset() {
-	*x = UpdatePostResponse{}
+func (x *CreatePostResponse) Reset() {
+	*x = CreatePostResponse{}
 	mi := &file_api_go_feed_request_and_response_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdatePostResponse) String() string {
+func (x *CreatePostResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdatePostResponse) ProtoMessage() {}
+func (*CreatePostResponse) ProtoMessage() {}
 
-func (x *UpdatePostResponse) ProtoReflect() protoreflect.Message {
+func (x *CreatePostResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_go_feed_request_and_response_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -562,12 +574,19 @@ func (x *UpdatePostResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdatePostResponse.ProtoReflect.Descriptor instead.
-func (*UpdatePostResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreatePostResponse.ProtoReflect.Descriptor instead.
+func (*CreatePostResponse) Descriptor() ([]byte, []int) {
 	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{11}
 }
 
-type CreateLikeRequest struct {
+func (x *CreatePostResponse) GetPostId() uint64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+type GetPostByIDRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -575,20 +594,20 @@ type CreateLikeRequest struct {
 	PostId uint64 `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
 }
 
-func (x *CreateLikeRequest) Reset() {
-	*x = CreateLikeRequest{}
+func (x *GetPostByIDRequest) Reset() {
+	*x = GetPostByIDRequest{}
 	mi := &file_api_go_feed_request_and_response_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateLikeRequest) String() string {
+func (x *GetPostByIDRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateLikeRequest) ProtoMessage() {}
+func (*GetPostByIDRequest) ProtoMessage() {}
 
-func (x *CreateLikeRequest) ProtoReflect() protoreflect.Message {
+func (x *GetPostByIDRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_go_feed_request_and_response_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -600,38 +619,40 @@ func (x *CreateLikeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateLikeRequest.ProtoReflect.Descriptor instead.
-func (*CreateLikeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetPostByIDRequest.ProtoReflect.Descriptor instead.
+func (*GetPostByIDRequest) Descriptor() ([]byte, []int) {
 	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *CreateLikeRequest) GetPostId() uint64 {
+func (x *GetPostByIDRequest) GetPostId() uint64 {
 	if x != nil {
 		return x.PostId
 	}
 	return 0
 }
 
-type CreateLikeResponse struct {
+type GetPostByIDResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Post *Post `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
 }
 
-func (x *CreateLikeResponse) Reset() {
-	*x = CreateLikeResponse{}
+func (x *GetPostByIDResponse) Reset() {
+	*x = GetPostByIDResponse{}
 	mi := &file_api_go_feed_request_and_response_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateLikeResponse) String() string {
+func (x *GetPostByIDResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateLikeResponse) ProtoMessage() {}
+func (*GetPostByIDResponse) ProtoMessage() {}
 
-func (x *CreateLikeResponse) ProtoReflect() protoreflect.Message {
+func (x *GetPostByIDResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_go_feed_request_and_response_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -643,33 +664,42 @@ func (x *CreateLikeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateLikeResponse.ProtoReflect.Descriptor instead.
-func (*CreateLikeResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetPostByIDResponse.ProtoReflect.Descriptor instead.
+func (*GetPostByIDResponse) Descriptor() ([]byte, []int) {
 	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{13}
 }
 
-type GetLikeCountOfPostRequest struct {
+func (x *GetPostByIDResponse) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+type GetPostOfAccountRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PostId uint64 `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	AccountId uint64 `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// The pinned posts are listed before the other posts on the first page only.
+	Page *PageRequest `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
 }
 
-func (x *GetLikeCountOfPostRequest) Reset() {
-	*x = GetLikeCountOfPostRequest{}
+func (x *GetPostOfAccountRequest) Reset() {
+	*x = GetPostOfAccountRequest{}
 	mi := &file_api_go_feed_request_and_response_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLikeCountOfPostRequest) String() string {
+func (x *GetPostOfAccountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLikeCountOfPostRequest) ProtoMessage() {}
+func (*GetPostOfAccountRequest) ProtoMessage() {}
 
-func (x *GetLikeCountOfPostRequest) ProtoReflect() protoreflect.Message {
+func (x *GetPostOfAccountRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_go_feed_request_and_response_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -681,40 +711,48 @@ func (x *GetLikeCountOfPostRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLikeCountOfPostRequest.ProtoReflect.Descriptor instead.
-func (*GetLikeCountOfPostRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetPostOfAccountRequest.ProtoReflect.Descriptor instead.
+func (*GetPostOfAccountRequest) Descriptor() ([]byte, []int) {
 	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *GetLikeCountOfPostRequest) GetPostId() uint64 {
+func (x *GetPostOfAccountRequest) GetAccountId() uint64 {
 	if x != nil {
-		return x.PostId
+		return x.AccountId
 	}
 	return 0
 }
 
-type GetLikeCountOfPostResponse struct {
+func (x *GetPostOfAccountRequest) GetPage() *PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type GetPostOfAccountResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	LikeCount uint64 `protobuf:"varint,1,opt,name=like_count,json=likeCount,proto3" json:"like_count,omitempty"`
+	PostList []*Post       `protobuf:"bytes,1,rep,name=post_list,json=postList,proto3" json:"post_list,omitempty"`
+	Page     *PageResponse `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
 }
 
-func (x *GetLikeCountOfPostResponse) Reset() {
-	*x = GetLikeCountOfPostResponse{}
+func (x *GetPostOfAccountResponse) Reset() {
+	*x = GetPostOfAccountResponse{}
 	mi := &file_api_go_feed_request_and_response_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLikeCountOfPostResponse) String() string {
+func (x *GetPostOfAccountResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLikeCountOfPostResponse) ProtoMessage() {}
+func (*GetPostOfAccountResponse) ProtoMessage() {}
 
-func (x *GetLikeCountOfPostResponse) ProtoReflect() protoreflect.Message {
+func (x *GetPostOfAccountResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_go_feed_request_and_response_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -726,40 +764,47 @@ func (x *GetLikeCountOfPostResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLikeCountOfPostResponse.ProtoReflect.Descriptor instead.
-func (*GetLikeCountOfPostResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetPostOfAccountResponse.ProtoReflect.Descriptor instead.
+func (*GetPostOfAccountResponse) Descriptor() ([]byte, []int) {
 	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *GetLikeCountOfPostResponse) GetLikeCount() uint64 {
+func (x *GetPostOfAccountResponse) GetPostList() []*Post {
 	if x != nil {
-		return x.LikeCount
+		return x.PostList
 	}
-	return 0
+	return nil
 }
 
-type GetLikeAccountsOfPostRequest struct {
+func (x *GetPostOfAccountResponse) GetPage() *PageResponse {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type UpdatePostRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PostId uint64 `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Post *Post `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
 }
 
-func (x *GetLikeAccountsOfPostRequest) Reset() {
-	*x = GetLikeAccountsOfPostRequest{}
+func (x *UpdatePostRequest) Reset() {
+	*x = UpdatePostRequest{}
 	mi := &file_api_go_feed_request_and_response_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLikeAccountsOfPostRequest) String() string {
+func (x *UpdatePostRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLikeAccountsOfPostRequest) ProtoMessage() {}
+func (*UpdatePostRequest) ProtoMessage() {}
 
-func (x *GetLikeAccountsOfPostRequest) ProtoReflect() protoreflect.Message {
+func (x *UpdatePostRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_go_feed_request_and_response_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -771,40 +816,38 @@ func (x *GetLikeAccountsOfPostRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLikeAccountsOfPostRequest.ProtoReflect.Descriptor instead.
-func (*GetLikeAccountsOfPostRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdatePostRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePostRequest) Descriptor() ([]byte, []int) {
 	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *GetLikeAccountsOfPostRequest) GetPostId() uint64 {
+func (x *UpdatePostRequest) GetPost() *Post {
 	if x != nil {
-		return x.PostId
+		return x.Post
 	}
-	return 0
+	return nil
 }
 
-type GetLikeAccountsOfPostResponse struct {
+type UpdatePostResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	AccountList []*Account `protobuf:"bytes,1,rep,name=account_list,json=accountList,proto3" json:"account_list,omitempty"`
 }
 
-func (x *GetLikeAccountsOfPostResponse) Reset() {
-	*x = GetLikeAccountsOfPostResponse{}
+func (x *UpdatePostResponse) Reset() {
+	*x = UpdatePostResponse{}
 	mi := &file_api_go_feed_request_and_response_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLikeAccountsOfPostResponse) String() string {
+func (x *UpdatePostResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLikeAccountsOfPostResponse) ProtoMessage() {}
+func (*UpdatePostResponse) ProtoMessage() {}
 
-func (x *GetLikeAccountsOfPostResponse) ProtoReflect() protoreflect.Message {
+func (x *UpdatePostResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_go_feed_request_and_response_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -816,40 +859,35 @@ func (x *GetLikeAccountsOfPostResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLikeAccountsOfPostResponse.ProtoReflect.Descriptor instead.
-func (*GetLikeAccountsOfPostResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdatePostResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePostResponse) Descriptor() ([]byte, []int) {
 	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *GetLikeAccountsOfPostResponse) GetAccountList() []*Account {
-	if x != nil {
-		return x.AccountList
-	}
-	return nil
-}
-
-type DeleteLikeRequest struct {
+type GetPostsByHashtagRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PostId uint64 `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Hashtag string `protobuf:"bytes,1,opt,name=hashtag,proto3" json:"hashtag,omitempty"`
+	Offset  uint64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit   uint64 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func (x *DeleteLikeRequest) Reset() {
-	*x = DeleteLikeRequest{}
+func (x *GetPostsByHashtagRequest) Reset() {
+	*x = GetPostsByHashtagRequest{}
 	mi := &file_api_go_feed_request_and_response_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteLikeRequest) String() string {
+func (x *GetPostsByHashtagRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteLikeRequest) ProtoMessage() {}
+func (*GetPostsByHashtagRequest) ProtoMessage() {}
 
-func (x *DeleteLikeRequest) ProtoReflect() protoreflect.Message {
+func (x *GetPostsByHashtagRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_go_feed_request_and_response_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -861,38 +899,54 @@ func (x *DeleteLikeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteLikeRequest.ProtoReflect.Descriptor instead.
-func (*DeleteLikeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetPostsByHashtagRequest.ProtoReflect.Descriptor instead.
+func (*GetPostsByHashtagRequest) Descriptor() ([]byte, []int) {
 	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *DeleteLikeRequest) GetPostId() uint64 {
+func (x *GetPostsByHashtagRequest) GetHashtag() string {
 	if x != nil {
-		return x.PostId
+		return x.Hashtag
+	}
+	return ""
+}
+
+func (x *GetPostsByHashtagRequest) GetOffset() uint64 {
+	if x != nil {
+		return x.Offset
 	}
 	return 0
 }
 
-type DeleteLikeResponse struct {
+func (x *GetPostsByHashtagRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetPostsByHashtagResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	PostList []*Post `protobuf:"bytes,1,rep,name=post_list,json=postList,proto3" json:"post_list,omitempty"`
 }
 
-func (x *DeleteLikeResponse) Reset() {
-	*x = DeleteLikeResponse{}
+func (x *GetPostsByHashtagResponse) Reset() {
+	*x = GetPostsByHashtagResponse{}
 	mi := &file_api_go_feed_request_and_response_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteLikeResponse) String() string {
+func (x *GetPostsByHashtagResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteLikeResponse) ProtoMessage() {}
+func (*GetPostsByHashtagResponse) ProtoMessage() {}
 
-func (x *DeleteLikeResponse) ProtoReflect() protoreflect.Message {
+func (x *GetPostsByHashtagResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_go_feed_request_and_response_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -904,34 +958,42 @@ func (x *DeleteLikeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteLikeResponse.ProtoReflect.Descriptor instead.
-func (*DeleteLikeResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetPostsByHashtagResponse.ProtoReflect.Descriptor instead.
+func (*GetPostsByHashtagResponse) Descriptor() ([]byte, []int) {
 	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{19}
 }
 
-type CreateCommentRequest struct {
+func (x *GetPostsByHashtagResponse) GetPostList() []*Post {
+	if x != nil {
+		return x.PostList
+	}
+	return nil
+}
+
+type GetPostsMentioningAccountRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PostId  uint64 `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
-	Content string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	AccountId uint64 `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Offset    uint64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit     uint64 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func (x *CreateCommentRequest) Reset() {
-	*x = CreateCommentRequest{}
+func (x *GetPostsMentioningAccountRequest) Reset() {
+	*x = GetPostsMentioningAccountRequest{}
 	mi := &file_api_go_feed_request_and_response_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateCommentRequest) String() string {
+func (x *GetPostsMentioningAccountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCommentRequest) ProtoMessage() {}
+func (*GetPostsMentioningAccountRequest) ProtoMessage() {}
 
-func (x *CreateCommentRequest) ProtoReflect() protoreflect.Message {
+func (x *GetPostsMentioningAccountRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_go_feed_request_and_response_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -943,47 +1005,54 @@ func (x *CreateCommentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCommentRequest.ProtoReflect.Descriptor instead.
-func (*CreateCommentRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetPostsMentioningAccountRequest.ProtoReflect.Descriptor instead.
+func (*GetPostsMentioningAccountRequest) Descriptor() ([]byte, []int) {
 	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *CreateCommentRequest) GetPostId() uint64 {
+func (x *GetPostsMentioningAccountRequest) GetAccountId() uint64 {
 	if x != nil {
-		return x.PostId
+		return x.AccountId
 	}
 	return 0
 }
 
-func (x *CreateCommentRequest) GetContent() string {
+func (x *GetPostsMentioningAccountRequest) GetOffset() uint64 {
 	if x != nil {
-		return x.Content
+		return x.Offset
 	}
-	return ""
+	return 0
 }
 
-type CreateCommentResponse struct {
+func (x *GetPostsMentioningAccountRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetPostsMentioningAccountResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CommentId uint64 `protobuf:"varint,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	PostList []*Post `protobuf:"bytes,1,rep,name=post_list,json=postList,proto3" json:"post_list,omitempty"`
 }
 
-func (x *CreateCommentResponse) Reset() {
-	*x = CreateCommentResponse{}
+func (x *GetPostsMentioningAccountResponse) Reset() {
+	*x = GetPostsMentioningAccountResponse{}
 	mi := &file_api_go_feed_request_and_response_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateCommentResponse) String() string {
+func (x *GetPostsMentioningAccountResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCommentResponse) ProtoMessage() {}
+func (*GetPostsMentioningAccountResponse) ProtoMessage() {}
 
-func (x *CreateCommentResponse) ProtoReflect() protoreflect.Message {
+func (x *GetPostsMentioningAccountResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_go_feed_request_and_response_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -995,40 +1064,41 @@ func (x *CreateCommentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCommentResponse.ProtoReflect.Descriptor instead.
-func (*CreateCommentResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetPostsMentioningAccountResponse.ProtoReflect.Descriptor instead.
+func (*GetPostsMentioningAccountResponse) Descriptor() ([]byte, []int) {
 	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *CreateCommentResponse) GetCommentId() uint64 {
+func (x *GetPostsMentioningAccountResponse) GetPostList() []*Post {
 	if x != nil {
-		return x.CommentId
+		return x.PostList
 	}
-	return 0
+	return nil
 }
 
-type GetCommentCountOfPostRequest struct {
+type CreateDraftRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PostId uint64 `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Content   string               `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	PublishAt *timestamp.Timestamp `protobuf:"bytes,2,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`
 }
 
-func (x *GetCommentCountOfPostRequest) Reset() {
-	*x = GetCommentCountOfPostRequest{}
+func (x *CreateDraftRequest) Reset() {
+	*x = CreateDraftRequest{}
 	mi := &file_api_go_feed_request_and_response_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCommentCountOfPostRequest) String() string {
+func (x *CreateDraftRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCommentCountOfPostRequest) ProtoMessage() {}
+func (*CreateDraftRequest) ProtoMessage() {}
 
-func (x *GetCommentCountOfPostRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateDraftRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_go_feed_request_and_response_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1040,40 +1110,47 @@ func (x *GetCommentCountOfPostRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCommentCountOfPostRequest.ProtoReflect.Descriptor instead.
-func (*GetCommentCountOfPostRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateDraftRequest.ProtoReflect.Descriptor instead.
+func (*CreateDraftRequest) Descriptor() ([]byte, []int) {
 	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *GetCommentCountOfPostRequest) GetPostId() uint64 {
+func (x *CreateDraftRequest) GetContent() string {
 	if x != nil {
-		return x.PostId
+		return x.Content
 	}
-	return 0
+	return ""
 }
 
-type GetCommentCountOfPostResponse struct {
+func (x *CreateDraftRequest) GetPublishAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.PublishAt
+	}
+	return nil
+}
+
+type CreateDraftResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CommentCount uint64 `protobuf:"varint,1,opt,name=comment_count,json=commentCount,proto3" json:"comment_count,omitempty"`
+	PostId uint64 `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
 }
 
-func (x *GetCommentCountOfPostResponse) Reset() {
-	*x = GetCommentCountOfPostResponse{}
+func (x *CreateDraftResponse) Reset() {
+	*x = CreateDraftResponse{}
 	mi := &file_api_go_feed_request_and_response_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCommentCountOfPostResponse) String() string {
+func (x *CreateDraftResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCommentCountOfPostResponse) ProtoMessage() {}
+func (*CreateDraftResponse) ProtoMessage() {}
 
-func (x *GetCommentCountOfPostResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateDraftResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_go_feed_request_and_response_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1085,40 +1162,38 @@ func (x *GetCommentCountOfPostResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCommentCountOfPostResponse.ProtoReflect.Descriptor instead.
-func (*GetCommentCountOfPostResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateDraftResponse.ProtoReflect.Descriptor instead.
+func (*CreateDraftResponse) Descriptor() ([]byte, []int) {
 	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *GetCommentCountOfPostResponse) GetCommentCount() uint64 {
+func (x *CreateDraftResponse) GetPostId() uint64 {
 	if x != nil {
-		return x.CommentCount
+		return x.PostId
 	}
 	return 0
 }
 
-type GetCommentsOfPostRequest struct {
+type ListDraftsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	PostId uint64 `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
 }
 
-func (x *GetCommentsOfPostRequest) Reset() {
-	*x = GetCommentsOfPostRequest{}
+func (x *ListDraftsRequest) Reset() {
+	*x = ListDraftsRequest{}
 	mi := &file_api_go_feed_request_and_response_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCommentsOfPostRequest) String() string {
+func (x *ListDraftsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCommentsOfPostRequest) ProtoMessage() {}
+func (*ListDraftsRequest) ProtoMessage() {}
 
-func (x *GetCommentsOfPostRequest) ProtoReflect() protoreflect.Message {
+func (x *ListDraftsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_go_feed_request_and_response_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1130,40 +1205,33 @@ func (x *GetCommentsOfPostRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCommentsOfPostRequest.ProtoReflect.Descriptor instead.
-func (*GetCommentsOfPostRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListDraftsRequest.ProtoReflect.Descriptor instead.
+func (*ListDraftsRequest) Descriptor() ([]byte, []int) {
 	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *GetCommentsOfPostRequest) GetPostId() uint64 {
-	if x != nil {
-		return x.PostId
-	}
-	return 0
-}
-
-type GetCommentsOfPostResponse struct {
+type ListDraftsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CommentList []*Comment `protobuf:"bytes,1,rep,name=comment_list,json=commentList,proto3" json:"comment_list,omitempty"`
+	PostList []*Post `protobuf:"bytes,1,rep,name=post_list,json=postList,proto3" json:"post_list,omitempty"`
 }
 
-func (x *GetCommentsOfPostResponse) Reset() {
-	*x = GetCommentsOfPostResponse{}
+func (x *ListDraftsResponse) Reset() {
+	*x = ListDraftsResponse{}
 	mi := &file_api_go_feed_request_and_response_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCommentsOfPostResponse) String() string {
+func (x *ListDraftsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCommentsOfPostResponse) ProtoMessage() {}
+func (*ListDraftsResponse) ProtoMessage() {}
 
-func (x *GetCommentsOfPostResponse) ProtoReflect() protoreflect.Message {
+func (x *ListDraftsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_go_feed_request_and_response_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1175,40 +1243,42 @@ func (x *GetCommentsOfPostResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCommentsOfPostResponse.ProtoReflect.Descriptor instead.
-func (*GetCommentsOfPostResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListDraftsResponse.ProtoReflect.Descriptor instead.
+func (*ListDraftsResponse) Descriptor() ([]byte, []int) {
 	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *GetCommentsOfPostResponse) GetCommentList() []*Comment {
+func (x *ListDraftsResponse) GetPostList() []*Post {
 	if x != nil {
-		return x.CommentList
+		return x.PostList
 	}
 	return nil
 }
 
-type UpdateCommentRequest struct {
+type UpdateDraftRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Comment *Comment `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
+	PostId    uint64               `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Content   string               `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	PublishAt *timestamp.Timestamp `protobuf:"bytes,3,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`
 }
 
-func (x *UpdateCommentRequest) Reset() {
-	*x = UpdateCommentRequest{}
+func (x *UpdateDraftRequest) Reset() {
+	*x = UpdateDraftRequest{}
 	mi := &file_api_go_feed_request_and_response_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateCommentRequest) String() string {
+func (x *UpdateDraftRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateCommentRequest) ProtoMessage() {}
+func (*UpdateDraftRequest) ProtoMessage() {}
 
-func (x *UpdateCommentRequest) ProtoReflect() protoreflect.Message {
+func (x *UpdateDraftRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_go_feed_request_and_response_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1220,40 +1290,52 @@ func (x *UpdateCommentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateCommentRequest.ProtoReflect.Descriptor instead.
-func (*UpdateCommentRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateDraftRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDraftRequest) Descriptor() ([]byte, []int) {
 	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *UpdateCommentRequest) GetComment() *Comment {
+func (x *UpdateDraftRequest) GetPostId() uint64 {
 	if x != nil {
-		return x.Comment
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *UpdateDraftRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *UpdateDraftRequest) GetPublishAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.PublishAt
 	}
 	return nil
 }
 
-type UpdateCommentResponse struct {
+type UpdateDraftResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	CommentId uint64 `protobuf:"varint,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
 }
 
-func (x *UpdateCommentResponse) Reset() {
-	*x = UpdateCommentResponse{}
+func (x *UpdateDraftResponse) Reset() {
+	*x = UpdateDraftResponse{}
 	mi := &file_api_go_feed_request_and_response_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateCommentResponse) String() string {
+func (x *UpdateDraftResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateCommentResponse) ProtoMessage() {}
+func (*UpdateDraftResponse) ProtoMessage() {}
 
-func (x *UpdateCommentResponse) ProtoReflect() protoreflect.Message {
+func (x *UpdateDraftResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_go_feed_request_and_response_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1265,40 +1347,34 @@ func (x *UpdateCommentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateCommentResponse.ProtoReflect.Descriptor instead.
-func (*UpdateCommentResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateDraftResponse.ProtoReflect.Descriptor instead.
+func (*UpdateDraftResponse) Descriptor() ([]byte, []int) {
 	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *UpdateCommentResponse) GetCommentId() uint64 {
-	if x != nil {
-		return x.CommentId
-	}
-	return 0
-}
-
-type DeleteCommentRequest struct {
+type PublishDraftRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CommentId uint64 `protobuf:"varint,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	PostId    uint64               `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	PublishAt *timestamp.Timestamp `protobuf:"bytes,2,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`
 }
 
-func (x *DeleteCommentRequest) Reset() {
-	*x = DeleteCommentRequest{}
+func (x *PublishDraftRequest) Reset() {
+	*x = PublishDraftRequest{}
 	mi := &file_api_go_feed_request_and_response_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteCommentRequest) String() string {
+func (x *PublishDraftRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCommentRequest) ProtoMessage() {}
+func (*PublishDraftRequest) ProtoMessage() {}
 
-func (x *DeleteCommentRequest) ProtoReflect() protoreflect.Message {
+func (x *PublishDraftRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_go_feed_request_and_response_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1310,38 +1386,45 @@ func (x *DeleteCommentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCommentRequest.ProtoReflect.Descriptor instead.
-func (*DeleteCommentRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PublishDraftRequest.ProtoReflect.Descriptor instead.
+func (*PublishDraftRequest) Descriptor() ([]byte, []int) {
 	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *DeleteCommentRequest) GetCommentId() uint64 {
+func (x *PublishDraftRequest) GetPostId() uint64 {
 	if x != nil {
-		return x.CommentId
+		return x.PostId
 	}
 	return 0
 }
 
-type DeleteCommentResponse struct {
+func (x *PublishDraftRequest) GetPublishAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.PublishAt
+	}
+	return nil
+}
+
+type PublishDraftResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *DeleteCommentResponse) Reset() {
-	*x = DeleteCommentResponse{}
+func (x *PublishDraftResponse) Reset() {
+	*x = PublishDraftResponse{}
 	mi := &file_api_go_feed_request_and_response_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteCommentResponse) String() string {
+func (x *PublishDraftResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCommentResponse) ProtoMessage() {}
+func (*PublishDraftResponse) ProtoMessage() {}
 
-func (x *DeleteCommentResponse) ProtoReflect() protoreflect.Message {
+func (x *PublishDraftResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_go_feed_request_and_response_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1353,33 +1436,33 @@ func (x *DeleteCommentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCommentResponse.ProtoReflect.Descriptor instead.
-func (*DeleteCommentResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PublishDraftResponse.ProtoReflect.Descriptor instead.
+func (*PublishDraftResponse) Descriptor() ([]byte, []int) {
 	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{29}
 }
 
-type CreateFollowRequest struct {
+type PinPostRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FollowingId uint64 `protobuf:"varint,1,opt,name=following_id,json=followingId,proto3" json:"following_id,omitempty"`
+	PostId uint64 `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
 }
 
-func (x *CreateFollowRequest) Reset() {
-	*x = CreateFollowRequest{}
+func (x *PinPostRequest) Reset() {
+	*x = PinPostRequest{}
 	mi := &file_api_go_feed_request_and_response_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateFollowRequest) String() string {
+func (x *PinPostRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateFollowRequest) ProtoMessage() {}
+func (*PinPostRequest) ProtoMessage() {}
 
-func (x *CreateFollowRequest) ProtoReflect() protoreflect.Message {
+func (x *PinPostRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_go_feed_request_and_response_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1391,38 +1474,38 @@ func (x *CreateFollowRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateFollowRequest.ProtoReflect.Descriptor instead.
-func (*CreateFollowRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PinPostRequest.ProtoReflect.Descriptor instead.
+func (*PinPostRequest) Descriptor() ([]byte, []int) {
 	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *CreateFollowRequest) GetFollowingId() uint64 {
+func (x *PinPostRequest) GetPostId() uint64 {
 	if x != nil {
-		return x.FollowingId
+		return x.PostId
 	}
 	return 0
 }
 
-type CreateFollowResponse struct {
+type PinPostResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *CreateFollowResponse) Reset() {
-	*x = CreateFollowResponse{}
+func (x *PinPostResponse) Reset() {
+	*x = PinPostResponse{}
 	mi := &file_api_go_feed_request_and_response_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateFollowResponse) String() string {
+func (x *PinPostResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateFollowResponse) ProtoMessage() {}
+func (*PinPostResponse) ProtoMessage() {}
 
-func (x *CreateFollowResponse) ProtoReflect() protoreflect.Message {
+func (x *PinPostResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_go_feed_request_and_response_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1434,33 +1517,33 @@ func (x *CreateFollowResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateFollowResponse.ProtoReflect.Descriptor instead.
-func (*CreateFollowResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PinPostResponse.ProtoReflect.Descriptor instead.
+func (*PinPostResponse) Descriptor() ([]byte, []int) {
 	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{31}
 }
 
-type GetFollowerCountOfAccountRequest struct {
+type UnpinPostRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AccountId uint64 `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	PostId uint64 `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
 }
 
-func (x *GetFollowerCountOfAccountRequest) Reset() {
-	*x = GetFollowerCountOfAccountRequest{}
+func (x *UnpinPostRequest) Reset() {
+	*x = UnpinPostRequest{}
 	mi := &file_api_go_feed_request_and_response_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFollowerCountOfAccountRequest) String() string {
+func (x *UnpinPostRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFollowerCountOfAccountRequest) ProtoMessage() {}
+func (*UnpinPostRequest) ProtoMessage() {}
 
-func (x *GetFollowerCountOfAccountRequest) ProtoReflect() protoreflect.Message {
+func (x *UnpinPostRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_go_feed_request_and_response_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1472,40 +1555,38 @@ func (x *GetFollowerCountOfAccountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFollowerCountOfAccountRequest.ProtoReflect.Descriptor instead.
-func (*GetFollowerCountOfAccountRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnpinPostRequest.ProtoReflect.Descriptor instead.
+func (*UnpinPostRequest) Descriptor() ([]byte, []int) {
 	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *GetFollowerCountOfAccountRequest) GetAccountId() uint64 {
+func (x *UnpinPostRequest) GetPostId() uint64 {
 	if x != nil {
-		return x.AccountId
+		return x.PostId
 	}
 	return 0
 }
 
-type GetFollowerCountOfAccountResponse struct {
+type UnpinPostResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	FollowerCount uint64 `protobuf:"varint,1,opt,name=follower_count,json=followerCount,proto3" json:"follower_count,omitempty"`
 }
 
-func (x *GetFollowerCountOfAccountResponse) Reset() {
-	*x = GetFollowerCountOfAccountResponse{}
+func (x *UnpinPostResponse) Reset() {
+	*x = UnpinPostResponse{}
 	mi := &file_api_go_feed_request_and_response_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFollowerCountOfAccountResponse) String() string {
+func (x *UnpinPostResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFollowerCountOfAccountResponse) ProtoMessage() {}
+func (*UnpinPostResponse) ProtoMessage() {}
 
-func (x *GetFollowerCountOfAccountResponse) ProtoReflect() protoreflect.Message {
+func (x *UnpinPostResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_go_feed_request_and_response_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1517,40 +1598,33 @@ func (x *GetFollowerCountOfAccountResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFollowerCountOfAccountResponse.ProtoReflect.Descriptor instead.
-func (*GetFollowerCountOfAccountResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnpinPostResponse.ProtoReflect.Descriptor instead.
+func (*UnpinPostResponse) Descriptor() ([]byte, []int) {
 	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *GetFollowerCountOfAccountResponse) GetFollowerCount() uint64 {
-	if x != nil {
-		return x.FollowerCount
-	}
-	return 0
-}
-
-type GetFollowersOfAccountRequest struct {
+type DeletePostRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AccountId uint64 `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	PostId uint64 `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
 }
 
-func (x *GetFollowersOfAccountRequest) Reset() {
-	*x = GetFollowersOfAccountRequest{}
+func (x *DeletePostRequest) Reset() {
+	*x = DeletePostRequest{}
 	mi := &file_api_go_feed_request_and_response_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFollowersOfAccountRequest) String() string {
+func (x *DeletePostRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFollowersOfAccountRequest) ProtoMessage() {}
+func (*DeletePostRequest) ProtoMessage() {}
 
-func (x *GetFollowersOfAccountRequest) ProtoReflect() protoreflect.Message {
+func (x *DeletePostRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_go_feed_request_and_response_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1562,40 +1636,38 @@ func (x *GetFollowersOfAccountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFollowersOfAccountRequest.ProtoReflect.Descriptor instead.
-func (*GetFollowersOfAccountRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeletePostRequest.ProtoReflect.Descriptor instead.
+func (*DeletePostRequest) Descriptor() ([]byte, []int) {
 	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *GetFollowersOfAccountRequest) GetAccountId() uint64 {
+func (x *DeletePostRequest) GetPostId() uint64 {
 	if x != nil {
-		return x.AccountId
+		return x.PostId
 	}
 	return 0
 }
 
-type GetFollowersOfAccountResponse struct {
+type DeletePostResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	FollowerList []*Account `protobuf:"bytes,1,rep,name=follower_list,json=followerList,proto3" json:"follower_list,omitempty"`
 }
 
-func (x *GetFollowersOfAccountResponse) Reset() {
-	*x = GetFollowersOfAccountResponse{}
+func (x *DeletePostResponse) Reset() {
+	*x = DeletePostResponse{}
 	mi := &file_api_go_feed_request_and_response_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFollowersOfAccountResponse) String() string {
+func (x *DeletePostResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFollowersOfAccountResponse) ProtoMessage() {}
+func (*DeletePostResponse) ProtoMessage() {}
 
-func (x *GetFollowersOfAccountResponse) ProtoReflect() protoreflect.Message {
+func (x *DeletePostResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_go_feed_request_and_response_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1607,40 +1679,33 @@ func (x *GetFollowersOfAccountResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFollowersOfAccountResponse.ProtoReflect.Descriptor instead.
-func (*GetFollowersOfAccountResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeletePostResponse.ProtoReflect.Descriptor instead.
+func (*DeletePostResponse) Descriptor() ([]byte, []int) {
 	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *GetFollowersOfAccountResponse) GetFollowerList() []*Account {
-	if x != nil {
-		return x.FollowerList
-	}
-	return nil
-}
-
-type GetFollowingCountOfAccountRequest struct {
+type RestorePostRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AccountId uint64 `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	PostId uint64 `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
 }
 
-func (x *GetFollowingCountOfAccountRequest) Reset() {
-	*x = GetFollowingCountOfAccountRequest{}
+func (x *RestorePostRequest) Reset() {
+	*x = RestorePostRequest{}
 	mi := &file_api_go_feed_request_and_response_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFollowingCountOfAccountRequest) String() string {
+func (x *RestorePostRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFollowingCountOfAccountRequest) ProtoMessage() {}
+func (*RestorePostRequest) ProtoMessage() {}
 
-func (x *GetFollowingCountOfAccountRequest) ProtoReflect() protoreflect.Message {
+func (x *RestorePostRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_go_feed_request_and_response_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1652,40 +1717,38 @@ func (x *GetFollowingCountOfAccountRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFollowingCountOfAccountRequest.ProtoReflect.Descriptor instead.
-func (*GetFollowingCountOfAccountRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RestorePostRequest.ProtoReflect.Descriptor instead.
+func (*RestorePostRequest) Descriptor() ([]byte, []int) {
 	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *GetFollowingCountOfAccountRequest) GetAccountId() uint64 {
+func (x *RestorePostRequest) GetPostId() uint64 {
 	if x != nil {
-		return x.AccountId
+		return x.PostId
 	}
 	return 0
 }
 
-type GetFollowingCountOfAccountResponse struct {
+type RestorePostResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	FollowingCount uint64 `protobuf:"varint,1,opt,name=following_count,json=followingCount,proto3" json:"following_count,omitempty"`
 }
 
-func (x *GetFollowingCountOfAccountResponse) Reset() {
-	*x = GetFollowingCountOfAccountResponse{}
+func (x *RestorePostResponse) Reset() {
+	*x = RestorePostResponse{}
 	mi := &file_api_go_feed_request_and_response_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFollowingCountOfAccountResponse) String() string {
+func (x *RestorePostResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFollowingCountOfAccountResponse) ProtoMessage() {}
+func (*RestorePostResponse) ProtoMessage() {}
 
-func (x *GetFollowingCountOfAccountResponse) ProtoReflect() protoreflect.Message {
+func (x *RestorePostResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_go_feed_request_and_response_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1697,40 +1760,31 @@ func (x *GetFollowingCountOfAccountResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFollowingCountOfAccountResponse.ProtoReflect.Descriptor instead.
-func (*GetFollowingCountOfAccountResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RestorePostResponse.ProtoReflect.Descriptor instead.
+func (*RestorePostResponse) Descriptor() ([]byte, []int) {
 	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *GetFollowingCountOfAccountResponse) GetFollowingCount() uint64 {
-	if x != nil {
-		return x.FollowingCount
-	}
-	return 0
-}
-
-type GetFollowingsOfAccountRequest struct {
+type ListDeletedPostsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	AccountId uint64 `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
 }
 
-func (x *GetFollowingsOfAccountRequest) Reset() {
-	*x = GetFollowingsOfAccountRequest{}
+func (x *ListDeletedPostsRequest) Reset() {
+	*x = ListDeletedPostsRequest{}
 	mi := &file_api_go_feed_request_and_response_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFollowingsOfAccountRequest) String() string {
+func (x *ListDeletedPostsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFollowingsOfAccountRequest) ProtoMessage() {}
+func (*ListDeletedPostsRequest) ProtoMessage() {}
 
-func (x *GetFollowingsOfAccountRequest) ProtoReflect() protoreflect.Message {
+func (x *ListDeletedPostsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_go_feed_request_and_response_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1742,40 +1796,33 @@ func (x *GetFollowingsOfAccountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFollowingsOfAccountRequest.ProtoReflect.Descriptor instead.
-func (*GetFollowingsOfAccountRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListDeletedPostsRequest.ProtoReflect.Descriptor instead.
+func (*ListDeletedPostsRequest) Descriptor() ([]byte, []int) {
 	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *GetFollowingsOfAccountRequest) GetAccountId() uint64 {
-	if x != nil {
-		return x.AccountId
-	}
-	return 0
-}
-
-type GetFollowingsOfAccountResponse struct {
+type ListDeletedPostsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FollowingList []*Account `protobuf:"bytes,1,rep,name=following_list,json=followingList,proto3" json:"following_list,omitempty"`
+	PostList []*Post `protobuf:"bytes,1,rep,name=post_list,json=postList,proto3" json:"post_list,omitempty"`
 }
 
-func (x *GetFollowingsOfAccountResponse) Reset() {
-	*x = GetFollowingsOfAccountResponse{}
+func (x *ListDeletedPostsResponse) Reset() {
+	*x = ListDeletedPostsResponse{}
 	mi := &file_api_go_feed_request_and_response_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFollowingsOfAccountResponse) String() string {
+func (x *ListDeletedPostsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFollowingsOfAccountResponse) ProtoMessage() {}
+func (*ListDeletedPostsResponse) ProtoMessage() {}
 
-func (x *GetFollowingsOfAccountResponse) ProtoReflect() protoreflect.Message {
+func (x *ListDeletedPostsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_go_feed_request_and_response_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1787,40 +1834,40 @@ func (x *GetFollowingsOfAccountResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFollowingsOfAccountResponse.ProtoReflect.Descriptor instead.
-func (*GetFollowingsOfAccountResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListDeletedPostsResponse.ProtoReflect.Descriptor instead.
+func (*ListDeletedPostsResponse) Descriptor() ([]byte, []int) {
 	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *GetFollowingsOfAccountResponse) GetFollowingList() []*Account {
+func (x *ListDeletedPostsResponse) GetPostList() []*Post {
 	if x != nil {
-		return x.FollowingList
+		return x.PostList
 	}
 	return nil
 }
 
-type DeleteFollowRequest struct {
+type CreateBookmarkRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FollowingId uint64 `protobuf:"varint,1,opt,name=following_id,json=followingId,proto3" json:"following_id,omitempty"`
+	PostId uint64 `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
 }
 
-func (x *DeleteFollowRequest) Reset() {
-	*x = DeleteFollowRequest{}
+func (x *CreateBookmarkRequest) Reset() {
+	*x = CreateBookmarkRequest{}
 	mi := &file_api_go_feed_request_and_response_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteFollowRequest) String() string {
+func (x *CreateBookmarkRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteFollowRequest) ProtoMessage() {}
+func (*CreateBookmarkRequest) ProtoMessage() {}
 
-func (x *DeleteFollowRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateBookmarkRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_go_feed_request_and_response_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1832,38 +1879,38 @@ func (x *DeleteFollowRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteFollowRequest.ProtoReflect.Descriptor instead.
-func (*DeleteFollowRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateBookmarkRequest.ProtoReflect.Descriptor instead.
+func (*CreateBookmarkRequest) Descriptor() ([]byte, []int) {
 	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *DeleteFollowRequest) GetFollowingId() uint64 {
+func (x *CreateBookmarkRequest) GetPostId() uint64 {
 	if x != nil {
-		return x.FollowingId
+		return x.PostId
 	}
 	return 0
 }
 
-type DeleteFollowResponse struct {
+type CreateBookmarkResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *DeleteFollowResponse) Reset() {
-	*x = DeleteFollowResponse{}
+func (x *CreateBookmarkResponse) Reset() {
+	*x = CreateBookmarkResponse{}
 	mi := &file_api_go_feed_request_and_response_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteFollowResponse) String() string {
+func (x *CreateBookmarkResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteFollowResponse) ProtoMessage() {}
+func (*CreateBookmarkResponse) ProtoMessage() {}
 
-func (x *DeleteFollowResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateBookmarkResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_go_feed_request_and_response_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1875,31 +1922,33 @@ func (x *DeleteFollowResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteFollowResponse.ProtoReflect.Descriptor instead.
-func (*DeleteFollowResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateBookmarkResponse.ProtoReflect.Descriptor instead.
+func (*CreateBookmarkResponse) Descriptor() ([]byte, []int) {
 	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{41}
 }
 
-type GetNewFeedsRequest struct {
+type DeleteBookmarkRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	PostId uint64 `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
 }
 
-func (x *GetNewFeedsRequest) Reset() {
-	*x = GetNewFeedsRequest{}
+func (x *DeleteBookmarkRequest) Reset() {
+	*x = DeleteBookmarkRequest{}
 	mi := &file_api_go_feed_request_and_response_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetNewFeedsRequest) String() string {
+func (x *DeleteBookmarkRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNewFeedsRequest) ProtoMessage() {}
+func (*DeleteBookmarkRequest) ProtoMessage() {}
 
-func (x *GetNewFeedsRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteBookmarkRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_go_feed_request_and_response_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1911,33 +1960,38 @@ func (x *GetNewFeedsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNewFeedsRequest.ProtoReflect.Descriptor instead.
-func (*GetNewFeedsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteBookmarkRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBookmarkRequest) Descriptor() ([]byte, []int) {
 	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{42}
 }
 
-type GetNewFeedsResponse struct {
+func (x *DeleteBookmarkRequest) GetPostId() uint64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+type DeleteBookmarkResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	PostList []*Post `protobuf:"bytes,1,rep,name=post_list,json=postList,proto3" json:"post_list,omitempty"`
 }
 
-func (x *GetNewFeedsResponse) Reset() {
-	*x = GetNewFeedsResponse{}
+func (x *DeleteBookmarkResponse) Reset() {
+	*x = DeleteBookmarkResponse{}
 	mi := &file_api_go_feed_request_and_response_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetNewFeedsResponse) String() string {
+func (x *DeleteBookmarkResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNewFeedsResponse) ProtoMessage() {}
+func (*DeleteBookmarkResponse) ProtoMessage() {}
 
-func (x *GetNewFeedsResponse) ProtoReflect() protoreflect.Message {
+func (x *DeleteBookmarkResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_go_feed_request_and_response_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1949,155 +2003,5006 @@ func (x *GetNewFeedsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNewFeedsResponse.ProtoReflect.Descriptor instead.
-func (*GetNewFeedsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteBookmarkResponse.ProtoReflect.Descriptor instead.
+func (*DeleteBookmarkResponse) Descriptor() ([]byte, []int) {
 	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *GetNewFeedsResponse) GetPostList() []*Post {
+type ListBookmarksRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// cursor is the next_cursor of the previous page, empty for the first page.
+	Cursor string `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit  uint64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ListBookmarksRequest) Reset() {
+	*x = ListBookmarksRequest{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBookmarksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBookmarksRequest) ProtoMessage() {}
+
+func (x *ListBookmarksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBookmarksRequest.ProtoReflect.Descriptor instead.
+func (*ListBookmarksRequest) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ListBookmarksRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListBookmarksRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListBookmarksResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostList []*Post `protobuf:"bytes,1,rep,name=post_list,json=postList,proto3" json:"post_list,omitempty"`
+	// next_cursor is empty on the last page.
+	NextCursor string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+}
+
+func (x *ListBookmarksResponse) Reset() {
+	*x = ListBookmarksResponse{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBookmarksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBookmarksResponse) ProtoMessage() {}
+
+func (x *ListBookmarksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBookmarksResponse.ProtoReflect.Descriptor instead.
+func (*ListBookmarksResponse) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ListBookmarksResponse) GetPostList() []*Post {
 	if x != nil {
 		return x.PostList
 	}
 	return nil
 }
 
-var File_api_go_feed_request_and_response_proto protoreflect.FileDescriptor
+func (x *ListBookmarksResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
 
-var file_api_go_feed_request_and_response_proto_rawDesc = []byte{
-	0x0a, 0x26, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2f, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65,
-	0x64, 0x1a, 0x19, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2f, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x55, 0x0a, 0x14,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
-	0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
-	0x6f, 0x72, 0x64, 0x22, 0x36, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
-	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x55, 0x0a, 0x14, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
-	0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
-	0x72, 0x64, 0x22, 0x36, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x61,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x2d, 0x0a, 0x11, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x2d, 0x0a, 0x12, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x22, 0x2d, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x50,
-	0x6f, 0x73, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
-	0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x22, 0x38, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x50, 0x6f,
-	0x73, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21,
-	0x0a, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67,
-	0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x04, 0x70, 0x6f, 0x73,
-	0x74, 0x22, 0x38, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x4f, 0x66, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
-	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x46, 0x0a, 0x18, 0x47,
-	0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x4f, 0x66, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x09, 0x70, 0x6f, 0x73, 0x74, 0x5f,
-	0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x6f, 0x5f,
-	0x66, 0x65, 0x65, 0x64, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x74, 0x4c,
-	0x69, 0x73, 0x74, 0x22, 0x36, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x04, 0x70, 0x6f, 0x73, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64,
-	0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x22, 0x14, 0x0a, 0x12, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x2c, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6b, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x22,
-	0x14, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6b, 0x65,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x66, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x22, 0x3b, 0x0a, 0x1a, 0x47,
-	0x65, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x66, 0x50, 0x6f, 0x73,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x69, 0x6b,
-	0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6c,
-	0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x37, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x4c,
-	0x69, 0x6b, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x4f, 0x66, 0x50, 0x6f, 0x73,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49,
-	0x64, 0x22, 0x54, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x73, 0x4f, 0x66, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+type GetTrendingHashtagsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// An unspecified window defaults to TRENDING_WINDOW_24H.
+	Window TrendingWindow `protobuf:"varint,1,opt,name=window,proto3,enum=go_feed.TrendingWindow" json:"window,omitempty"`
+	Limit  uint64         `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *GetTrendingHashtagsRequest) Reset() {
+	*x = GetTrendingHashtagsRequest{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTrendingHashtagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTrendingHashtagsRequest) ProtoMessage() {}
+
+func (x *GetTrendingHashtagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTrendingHashtagsRequest.ProtoReflect.Descriptor instead.
+func (*GetTrendingHashtagsRequest) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetTrendingHashtagsRequest) GetWindow() TrendingWindow {
+	if x != nil {
+		return x.Window
+	}
+	return TrendingWindow_TRENDING_WINDOW_UNSPECIFIED
+}
+
+func (x *GetTrendingHashtagsRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetTrendingHashtagsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HashtagList []*TrendingHashtag `protobuf:"bytes,1,rep,name=hashtag_list,json=hashtagList,proto3" json:"hashtag_list,omitempty"`
+}
+
+func (x *GetTrendingHashtagsResponse) Reset() {
+	*x = GetTrendingHashtagsResponse{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTrendingHashtagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTrendingHashtagsResponse) ProtoMessage() {}
+
+func (x *GetTrendingHashtagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTrendingHashtagsResponse.ProtoReflect.Descriptor instead.
+func (*GetTrendingHashtagsResponse) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetTrendingHashtagsResponse) GetHashtagList() []*TrendingHashtag {
+	if x != nil {
+		return x.HashtagList
+	}
+	return nil
+}
+
+type GetTrendingPostsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// An unspecified window defaults to TRENDING_WINDOW_24H.
+	Window TrendingWindow `protobuf:"varint,1,opt,name=window,proto3,enum=go_feed.TrendingWindow" json:"window,omitempty"`
+	Limit  uint64         `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *GetTrendingPostsRequest) Reset() {
+	*x = GetTrendingPostsRequest{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTrendingPostsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTrendingPostsRequest) ProtoMessage() {}
+
+func (x *GetTrendingPostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTrendingPostsRequest.ProtoReflect.Descriptor instead.
+func (*GetTrendingPostsRequest) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetTrendingPostsRequest) GetWindow() TrendingWindow {
+	if x != nil {
+		return x.Window
+	}
+	return TrendingWindow_TRENDING_WINDOW_UNSPECIFIED
+}
+
+func (x *GetTrendingPostsRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetTrendingPostsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostList []*Post `protobuf:"bytes,1,rep,name=post_list,json=postList,proto3" json:"post_list,omitempty"`
+}
+
+func (x *GetTrendingPostsResponse) Reset() {
+	*x = GetTrendingPostsResponse{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTrendingPostsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTrendingPostsResponse) ProtoMessage() {}
+
+func (x *GetTrendingPostsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTrendingPostsResponse.ProtoReflect.Descriptor instead.
+func (*GetTrendingPostsResponse) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetTrendingPostsResponse) GetPostList() []*Post {
+	if x != nil {
+		return x.PostList
+	}
+	return nil
+}
+
+type VotePollRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PollId    uint64   `protobuf:"varint,1,opt,name=poll_id,json=pollId,proto3" json:"poll_id,omitempty"`
+	OptionIds []uint64 `protobuf:"varint,2,rep,packed,name=option_ids,json=optionIds,proto3" json:"option_ids,omitempty"`
+}
+
+func (x *VotePollRequest) Reset() {
+	*x = VotePollRequest{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VotePollRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VotePollRequest) ProtoMessage() {}
+
+func (x *VotePollRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VotePollRequest.ProtoReflect.Descriptor instead.
+func (*VotePollRequest) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *VotePollRequest) GetPollId() uint64 {
+	if x != nil {
+		return x.PollId
+	}
+	return 0
+}
+
+func (x *VotePollRequest) GetOptionIds() []uint64 {
+	if x != nil {
+		return x.OptionIds
+	}
+	return nil
+}
+
+type VotePollResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Poll *Poll `protobuf:"bytes,1,opt,name=poll,proto3" json:"poll,omitempty"`
+}
+
+func (x *VotePollResponse) Reset() {
+	*x = VotePollResponse{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VotePollResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VotePollResponse) ProtoMessage() {}
+
+func (x *VotePollResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VotePollResponse.ProtoReflect.Descriptor instead.
+func (*VotePollResponse) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *VotePollResponse) GetPoll() *Poll {
+	if x != nil {
+		return x.Poll
+	}
+	return nil
+}
+
+type GetPollResultsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PollId uint64 `protobuf:"varint,1,opt,name=poll_id,json=pollId,proto3" json:"poll_id,omitempty"`
+}
+
+func (x *GetPollResultsRequest) Reset() {
+	*x = GetPollResultsRequest{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPollResultsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPollResultsRequest) ProtoMessage() {}
+
+func (x *GetPollResultsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPollResultsRequest.ProtoReflect.Descriptor instead.
+func (*GetPollResultsRequest) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetPollResultsRequest) GetPollId() uint64 {
+	if x != nil {
+		return x.PollId
+	}
+	return 0
+}
+
+type GetPollResultsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Poll *Poll `protobuf:"bytes,1,opt,name=poll,proto3" json:"poll,omitempty"`
+}
+
+func (x *GetPollResultsResponse) Reset() {
+	*x = GetPollResultsResponse{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPollResultsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPollResultsResponse) ProtoMessage() {}
+
+func (x *GetPollResultsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPollResultsResponse.ProtoReflect.Descriptor instead.
+func (*GetPollResultsResponse) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GetPollResultsResponse) GetPoll() *Poll {
+	if x != nil {
+		return x.Poll
+	}
+	return nil
+}
+
+type SearchPostsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Query  string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Offset uint64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit  uint64 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *SearchPostsRequest) Reset() {
+	*x = SearchPostsRequest{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchPostsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchPostsRequest) ProtoMessage() {}
+
+func (x *SearchPostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchPostsRequest.ProtoReflect.Descriptor instead.
+func (*SearchPostsRequest) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *SearchPostsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchPostsRequest) GetOffset() uint64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *SearchPostsRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SearchPostsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostList []*Post `protobuf:"bytes,1,rep,name=post_list,json=postList,proto3" json:"post_list,omitempty"`
+}
+
+func (x *SearchPostsResponse) Reset() {
+	*x = SearchPostsResponse{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchPostsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchPostsResponse) ProtoMessage() {}
+
+func (x *SearchPostsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchPostsResponse.ProtoReflect.Descriptor instead.
+func (*SearchPostsResponse) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *SearchPostsResponse) GetPostList() []*Post {
+	if x != nil {
+		return x.PostList
+	}
+	return nil
+}
+
+// CreateLike is kept for clients that predate reactions, it is the same as SetReaction with REACTION_TYPE_LIKE.
+type CreateLikeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId uint64 `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+}
+
+func (x *CreateLikeRequest) Reset() {
+	*x = CreateLikeRequest{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateLikeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLikeRequest) ProtoMessage() {}
+
+func (x *CreateLikeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLikeRequest.ProtoReflect.Descriptor instead.
+func (*CreateLikeRequest) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *CreateLikeRequest) GetPostId() uint64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+type CreateLikeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CreateLikeResponse) Reset() {
+	*x = CreateLikeResponse{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateLikeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLikeResponse) ProtoMessage() {}
+
+func (x *CreateLikeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLikeResponse.ProtoReflect.Descriptor instead.
+func (*CreateLikeResponse) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{57}
+}
+
+type SetReactionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId       uint64       `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	ReactionType ReactionType `protobuf:"varint,2,opt,name=reaction_type,json=reactionType,proto3,enum=go_feed.ReactionType" json:"reaction_type,omitempty"`
+}
+
+func (x *SetReactionRequest) Reset() {
+	*x = SetReactionRequest{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetReactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetReactionRequest) ProtoMessage() {}
+
+func (x *SetReactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetReactionRequest.ProtoReflect.Descriptor instead.
+func (*SetReactionRequest) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *SetReactionRequest) GetPostId() uint64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *SetReactionRequest) GetReactionType() ReactionType {
+	if x != nil {
+		return x.ReactionType
+	}
+	return ReactionType_REACTION_TYPE_UNSPECIFIED
+}
+
+type SetReactionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetReactionResponse) Reset() {
+	*x = SetReactionResponse{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetReactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetReactionResponse) ProtoMessage() {}
+
+func (x *SetReactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetReactionResponse.ProtoReflect.Descriptor instead.
+func (*SetReactionResponse) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{59}
+}
+
+type GetLikeCountOfPostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId uint64 `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+}
+
+func (x *GetLikeCountOfPostRequest) Reset() {
+	*x = GetLikeCountOfPostRequest{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLikeCountOfPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLikeCountOfPostRequest) ProtoMessage() {}
+
+func (x *GetLikeCountOfPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLikeCountOfPostRequest.ProtoReflect.Descriptor instead.
+func (*GetLikeCountOfPostRequest) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetLikeCountOfPostRequest) GetPostId() uint64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+type GetLikeCountOfPostResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// like_count only counts REACTION_TYPE_LIKE reactions.
+	LikeCount uint64 `protobuf:"varint,1,opt,name=like_count,json=likeCount,proto3" json:"like_count,omitempty"`
+	// reaction_counts has one entry per enabled reaction type.
+	ReactionCounts []*ReactionCount `protobuf:"bytes,2,rep,name=reaction_counts,json=reactionCounts,proto3" json:"reaction_counts,omitempty"`
+}
+
+func (x *GetLikeCountOfPostResponse) Reset() {
+	*x = GetLikeCountOfPostResponse{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLikeCountOfPostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLikeCountOfPostResponse) ProtoMessage() {}
+
+func (x *GetLikeCountOfPostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLikeCountOfPostResponse.ProtoReflect.Descriptor instead.
+func (*GetLikeCountOfPostResponse) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetLikeCountOfPostResponse) GetLikeCount() uint64 {
+	if x != nil {
+		return x.LikeCount
+	}
+	return 0
+}
+
+func (x *GetLikeCountOfPostResponse) GetReactionCounts() []*ReactionCount {
+	if x != nil {
+		return x.ReactionCounts
+	}
+	return nil
+}
+
+type GetLikeAccountsOfPostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId uint64 `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	// An unspecified reaction_type defaults to REACTION_TYPE_LIKE.
+	ReactionType ReactionType `protobuf:"varint,2,opt,name=reaction_type,json=reactionType,proto3,enum=go_feed.ReactionType" json:"reaction_type,omitempty"`
+	Page         *PageRequest `protobuf:"bytes,3,opt,name=page,proto3" json:"page,omitempty"`
+}
+
+func (x *GetLikeAccountsOfPostRequest) Reset() {
+	*x = GetLikeAccountsOfPostRequest{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLikeAccountsOfPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLikeAccountsOfPostRequest) ProtoMessage() {}
+
+func (x *GetLikeAccountsOfPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLikeAccountsOfPostRequest.ProtoReflect.Descriptor instead.
+func (*GetLikeAccountsOfPostRequest) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetLikeAccountsOfPostRequest) GetPostId() uint64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *GetLikeAccountsOfPostRequest) GetReactionType() ReactionType {
+	if x != nil {
+		return x.ReactionType
+	}
+	return ReactionType_REACTION_TYPE_UNSPECIFIED
+}
+
+func (x *GetLikeAccountsOfPostRequest) GetPage() *PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type GetLikeAccountsOfPostResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountList []*Account    `protobuf:"bytes,1,rep,name=account_list,json=accountList,proto3" json:"account_list,omitempty"`
+	Page        *PageResponse `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+}
+
+func (x *GetLikeAccountsOfPostResponse) Reset() {
+	*x = GetLikeAccountsOfPostResponse{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLikeAccountsOfPostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLikeAccountsOfPostResponse) ProtoMessage() {}
+
+func (x *GetLikeAccountsOfPostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLikeAccountsOfPostResponse.ProtoReflect.Descriptor instead.
+func (*GetLikeAccountsOfPostResponse) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *GetLikeAccountsOfPostResponse) GetAccountList() []*Account {
+	if x != nil {
+		return x.AccountList
+	}
+	return nil
+}
+
+func (x *GetLikeAccountsOfPostResponse) GetPage() *PageResponse {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+// DeleteLike only removes a REACTION_TYPE_LIKE reaction, use DeleteReaction to remove a reaction of any type.
+type DeleteLikeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId uint64 `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+}
+
+func (x *DeleteLikeRequest) Reset() {
+	*x = DeleteLikeRequest{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteLikeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLikeRequest) ProtoMessage() {}
+
+func (x *DeleteLikeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLikeRequest.ProtoReflect.Descriptor instead.
+func (*DeleteLikeRequest) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *DeleteLikeRequest) GetPostId() uint64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+type DeleteLikeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteLikeResponse) Reset() {
+	*x = DeleteLikeResponse{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteLikeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLikeResponse) ProtoMessage() {}
+
+func (x *DeleteLikeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLikeResponse.ProtoReflect.Descriptor instead.
+func (*DeleteLikeResponse) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{65}
+}
+
+type DeleteReactionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId uint64 `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+}
+
+func (x *DeleteReactionRequest) Reset() {
+	*x = DeleteReactionRequest{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteReactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteReactionRequest) ProtoMessage() {}
+
+func (x *DeleteReactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteReactionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteReactionRequest) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *DeleteReactionRequest) GetPostId() uint64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+type DeleteReactionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteReactionResponse) Reset() {
+	*x = DeleteReactionResponse{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteReactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteReactionResponse) ProtoMessage() {}
+
+func (x *DeleteReactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteReactionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteReactionResponse) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{67}
+}
+
+type HasLikedPostsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// At most 100 post ids can be looked up at once.
+	PostIds []uint64 `protobuf:"varint,1,rep,packed,name=post_ids,json=postIds,proto3" json:"post_ids,omitempty"`
+}
+
+func (x *HasLikedPostsRequest) Reset() {
+	*x = HasLikedPostsRequest{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HasLikedPostsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HasLikedPostsRequest) ProtoMessage() {}
+
+func (x *HasLikedPostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HasLikedPostsRequest.ProtoReflect.Descriptor instead.
+func (*HasLikedPostsRequest) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *HasLikedPostsRequest) GetPostIds() []uint64 {
+	if x != nil {
+		return x.PostIds
+	}
+	return nil
+}
+
+type HasLikedPostsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// liked_post_ids are the requested posts the account reacted to with REACTION_TYPE_LIKE.
+	LikedPostIds []uint64 `protobuf:"varint,1,rep,packed,name=liked_post_ids,json=likedPostIds,proto3" json:"liked_post_ids,omitempty"`
+	// reaction_types has the type of the reaction of the account for every requested post it reacted to.
+	ReactionTypes map[uint64]ReactionType `protobuf:"bytes,2,rep,name=reaction_types,json=reactionTypes,proto3" json:"reaction_types,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=go_feed.ReactionType"`
+}
+
+func (x *HasLikedPostsResponse) Reset() {
+	*x = HasLikedPostsResponse{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HasLikedPostsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HasLikedPostsResponse) ProtoMessage() {}
+
+func (x *HasLikedPostsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HasLikedPostsResponse.ProtoReflect.Descriptor instead.
+func (*HasLikedPostsResponse) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *HasLikedPostsResponse) GetLikedPostIds() []uint64 {
+	if x != nil {
+		return x.LikedPostIds
+	}
+	return nil
+}
+
+func (x *HasLikedPostsResponse) GetReactionTypes() map[uint64]ReactionType {
+	if x != nil {
+		return x.ReactionTypes
+	}
+	return nil
+}
+
+type GetLikedPostsOfAccountRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountId uint64 `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// An unspecified reaction_type defaults to REACTION_TYPE_LIKE.
+	ReactionType ReactionType `protobuf:"varint,2,opt,name=reaction_type,json=reactionType,proto3,enum=go_feed.ReactionType" json:"reaction_type,omitempty"`
+	// cursor is the next_cursor of the previous page, empty for the first page.
+	Cursor string `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit  uint64 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *GetLikedPostsOfAccountRequest) Reset() {
+	*x = GetLikedPostsOfAccountRequest{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLikedPostsOfAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLikedPostsOfAccountRequest) ProtoMessage() {}
+
+func (x *GetLikedPostsOfAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLikedPostsOfAccountRequest.ProtoReflect.Descriptor instead.
+func (*GetLikedPostsOfAccountRequest) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *GetLikedPostsOfAccountRequest) GetAccountId() uint64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *GetLikedPostsOfAccountRequest) GetReactionType() ReactionType {
+	if x != nil {
+		return x.ReactionType
+	}
+	return ReactionType_REACTION_TYPE_UNSPECIFIED
+}
+
+func (x *GetLikedPostsOfAccountRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *GetLikedPostsOfAccountRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetLikedPostsOfAccountResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostList []*Post `protobuf:"bytes,1,rep,name=post_list,json=postList,proto3" json:"post_list,omitempty"`
+	// next_cursor is empty on the last page.
+	NextCursor string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+}
+
+func (x *GetLikedPostsOfAccountResponse) Reset() {
+	*x = GetLikedPostsOfAccountResponse{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLikedPostsOfAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLikedPostsOfAccountResponse) ProtoMessage() {}
+
+func (x *GetLikedPostsOfAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLikedPostsOfAccountResponse.ProtoReflect.Descriptor instead.
+func (*GetLikedPostsOfAccountResponse) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *GetLikedPostsOfAccountResponse) GetPostList() []*Post {
+	if x != nil {
+		return x.PostList
+	}
+	return nil
+}
+
+func (x *GetLikedPostsOfAccountResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type CreateCommentLikeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommentId uint64 `protobuf:"varint,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+}
+
+func (x *CreateCommentLikeRequest) Reset() {
+	*x = CreateCommentLikeRequest{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCommentLikeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCommentLikeRequest) ProtoMessage() {}
+
+func (x *CreateCommentLikeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCommentLikeRequest.ProtoReflect.Descriptor instead.
+func (*CreateCommentLikeRequest) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *CreateCommentLikeRequest) GetCommentId() uint64 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
+type CreateCommentLikeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CreateCommentLikeResponse) Reset() {
+	*x = CreateCommentLikeResponse{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCommentLikeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCommentLikeResponse) ProtoMessage() {}
+
+func (x *CreateCommentLikeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCommentLikeResponse.ProtoReflect.Descriptor instead.
+func (*CreateCommentLikeResponse) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{73}
+}
+
+type GetLikeCountOfCommentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommentId uint64 `protobuf:"varint,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+}
+
+func (x *GetLikeCountOfCommentRequest) Reset() {
+	*x = GetLikeCountOfCommentRequest{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLikeCountOfCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLikeCountOfCommentRequest) ProtoMessage() {}
+
+func (x *GetLikeCountOfCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLikeCountOfCommentRequest.ProtoReflect.Descriptor instead.
+func (*GetLikeCountOfCommentRequest) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *GetLikeCountOfCommentRequest) GetCommentId() uint64 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
+type GetLikeCountOfCommentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LikeCount uint64 `protobuf:"varint,1,opt,name=like_count,json=likeCount,proto3" json:"like_count,omitempty"`
+}
+
+func (x *GetLikeCountOfCommentResponse) Reset() {
+	*x = GetLikeCountOfCommentResponse{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLikeCountOfCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLikeCountOfCommentResponse) ProtoMessage() {}
+
+func (x *GetLikeCountOfCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLikeCountOfCommentResponse.ProtoReflect.Descriptor instead.
+func (*GetLikeCountOfCommentResponse) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *GetLikeCountOfCommentResponse) GetLikeCount() uint64 {
+	if x != nil {
+		return x.LikeCount
+	}
+	return 0
+}
+
+type DeleteCommentLikeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommentId uint64 `protobuf:"varint,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+}
+
+func (x *DeleteCommentLikeRequest) Reset() {
+	*x = DeleteCommentLikeRequest{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCommentLikeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCommentLikeRequest) ProtoMessage() {}
+
+func (x *DeleteCommentLikeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCommentLikeRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCommentLikeRequest) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *DeleteCommentLikeRequest) GetCommentId() uint64 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
+type DeleteCommentLikeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteCommentLikeResponse) Reset() {
+	*x = DeleteCommentLikeResponse{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCommentLikeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCommentLikeResponse) ProtoMessage() {}
+
+func (x *DeleteCommentLikeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCommentLikeResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCommentLikeResponse) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{77}
+}
+
+type CreateCommentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId  uint64 `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Content string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	// parent_comment_id is set to reply to a comment of the same post.
+	ParentCommentId uint64 `protobuf:"varint,3,opt,name=parent_comment_id,json=parentCommentId,proto3" json:"parent_comment_id,omitempty"`
+}
+
+func (x *CreateCommentRequest) Reset() {
+	*x = CreateCommentRequest{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCommentRequest) ProtoMessage() {}
+
+func (x *CreateCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCommentRequest.ProtoReflect.Descriptor instead.
+func (*CreateCommentRequest) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *CreateCommentRequest) GetPostId() uint64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *CreateCommentRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *CreateCommentRequest) GetParentCommentId() uint64 {
+	if x != nil {
+		return x.ParentCommentId
+	}
+	return 0
+}
+
+type CreateCommentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommentId uint64 `protobuf:"varint,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+}
+
+func (x *CreateCommentResponse) Reset() {
+	*x = CreateCommentResponse{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCommentResponse) ProtoMessage() {}
+
+func (x *CreateCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCommentResponse.ProtoReflect.Descriptor instead.
+func (*CreateCommentResponse) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *CreateCommentResponse) GetCommentId() uint64 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
+type GetCommentCountOfPostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId uint64 `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+}
+
+func (x *GetCommentCountOfPostRequest) Reset() {
+	*x = GetCommentCountOfPostRequest{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCommentCountOfPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommentCountOfPostRequest) ProtoMessage() {}
+
+func (x *GetCommentCountOfPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommentCountOfPostRequest.ProtoReflect.Descriptor instead.
+func (*GetCommentCountOfPostRequest) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *GetCommentCountOfPostRequest) GetPostId() uint64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+type GetCommentCountOfPostResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommentCount uint64 `protobuf:"varint,1,opt,name=comment_count,json=commentCount,proto3" json:"comment_count,omitempty"`
+}
+
+func (x *GetCommentCountOfPostResponse) Reset() {
+	*x = GetCommentCountOfPostResponse{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCommentCountOfPostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommentCountOfPostResponse) ProtoMessage() {}
+
+func (x *GetCommentCountOfPostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommentCountOfPostResponse.ProtoReflect.Descriptor instead.
+func (*GetCommentCountOfPostResponse) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *GetCommentCountOfPostResponse) GetCommentCount() uint64 {
+	if x != nil {
+		return x.CommentCount
+	}
+	return 0
+}
+
+type GetCommentsOfPostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId uint64 `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	// threaded returns only the top level comments, each with its first replies inlined.
+	Threaded bool   `protobuf:"varint,2,opt,name=threaded,proto3" json:"threaded,omitempty"`
+	PageSize uint64 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// cursor is the next_cursor of the previous page, empty for the first page. It is only valid with the sort it was
+	// returned for.
+	Cursor string `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// An unspecified sort defaults to COMMENT_SORT_OLDEST.
+	Sort CommentSort `protobuf:"varint,5,opt,name=sort,proto3,enum=go_feed.CommentSort" json:"sort,omitempty"`
+}
+
+func (x *GetCommentsOfPostRequest) Reset() {
+	*x = GetCommentsOfPostRequest{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCommentsOfPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommentsOfPostRequest) ProtoMessage() {}
+
+func (x *GetCommentsOfPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommentsOfPostRequest.ProtoReflect.Descriptor instead.
+func (*GetCommentsOfPostRequest) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *GetCommentsOfPostRequest) GetPostId() uint64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *GetCommentsOfPostRequest) GetThreaded() bool {
+	if x != nil {
+		return x.Threaded
+	}
+	return false
+}
+
+func (x *GetCommentsOfPostRequest) GetPageSize() uint64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetCommentsOfPostRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *GetCommentsOfPostRequest) GetSort() CommentSort {
+	if x != nil {
+		return x.Sort
+	}
+	return CommentSort_COMMENT_SORT_UNSPECIFIED
+}
+
+type GetCommentsOfPostResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommentList []*Comment `protobuf:"bytes,1,rep,name=comment_list,json=commentList,proto3" json:"comment_list,omitempty"`
+	// next_cursor is empty on the last page.
+	NextCursor string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+}
+
+func (x *GetCommentsOfPostResponse) Reset() {
+	*x = GetCommentsOfPostResponse{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCommentsOfPostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommentsOfPostResponse) ProtoMessage() {}
+
+func (x *GetCommentsOfPostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommentsOfPostResponse.ProtoReflect.Descriptor instead.
+func (*GetCommentsOfPostResponse) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *GetCommentsOfPostResponse) GetCommentList() []*Comment {
+	if x != nil {
+		return x.CommentList
+	}
+	return nil
+}
+
+func (x *GetCommentsOfPostResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type GetCommentRepliesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommentId uint64 `protobuf:"varint,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	// cursor is the next_cursor of the previous page, empty for the first page.
+	Cursor string `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit  uint64 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *GetCommentRepliesRequest) Reset() {
+	*x = GetCommentRepliesRequest{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCommentRepliesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommentRepliesRequest) ProtoMessage() {}
+
+func (x *GetCommentRepliesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommentRepliesRequest.ProtoReflect.Descriptor instead.
+func (*GetCommentRepliesRequest) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *GetCommentRepliesRequest) GetCommentId() uint64 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
+func (x *GetCommentRepliesRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *GetCommentRepliesRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetCommentRepliesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommentList []*Comment `protobuf:"bytes,1,rep,name=comment_list,json=commentList,proto3" json:"comment_list,omitempty"`
+	// next_cursor is empty on the last page.
+	NextCursor string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+}
+
+func (x *GetCommentRepliesResponse) Reset() {
+	*x = GetCommentRepliesResponse{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCommentRepliesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommentRepliesResponse) ProtoMessage() {}
+
+func (x *GetCommentRepliesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommentRepliesResponse.ProtoReflect.Descriptor instead.
+func (*GetCommentRepliesResponse) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *GetCommentRepliesResponse) GetCommentList() []*Comment {
+	if x != nil {
+		return x.CommentList
+	}
+	return nil
+}
+
+func (x *GetCommentRepliesResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type UpdateCommentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Comment *Comment `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
+}
+
+func (x *UpdateCommentRequest) Reset() {
+	*x = UpdateCommentRequest{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCommentRequest) ProtoMessage() {}
+
+func (x *UpdateCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCommentRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCommentRequest) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *UpdateCommentRequest) GetComment() *Comment {
+	if x != nil {
+		return x.Comment
+	}
+	return nil
+}
+
+type UpdateCommentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommentId uint64 `protobuf:"varint,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+}
+
+func (x *UpdateCommentResponse) Reset() {
+	*x = UpdateCommentResponse{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCommentResponse) ProtoMessage() {}
+
+func (x *UpdateCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCommentResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCommentResponse) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *UpdateCommentResponse) GetCommentId() uint64 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
+type DeleteCommentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommentId uint64 `protobuf:"varint,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+}
+
+func (x *DeleteCommentRequest) Reset() {
+	*x = DeleteCommentRequest{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCommentRequest) ProtoMessage() {}
+
+func (x *DeleteCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCommentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCommentRequest) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *DeleteCommentRequest) GetCommentId() uint64 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
+type DeleteCommentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteCommentResponse) Reset() {
+	*x = DeleteCommentResponse{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCommentResponse) ProtoMessage() {}
+
+func (x *DeleteCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCommentResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCommentResponse) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{89}
+}
+
+type HideCommentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommentId uint64 `protobuf:"varint,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+}
+
+func (x *HideCommentRequest) Reset() {
+	*x = HideCommentRequest{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HideCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HideCommentRequest) ProtoMessage() {}
+
+func (x *HideCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HideCommentRequest.ProtoReflect.Descriptor instead.
+func (*HideCommentRequest) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *HideCommentRequest) GetCommentId() uint64 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
+type HideCommentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *HideCommentResponse) Reset() {
+	*x = HideCommentResponse{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HideCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HideCommentResponse) ProtoMessage() {}
+
+func (x *HideCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HideCommentResponse.ProtoReflect.Descriptor instead.
+func (*HideCommentResponse) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{91}
+}
+
+type UnhideCommentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommentId uint64 `protobuf:"varint,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+}
+
+func (x *UnhideCommentRequest) Reset() {
+	*x = UnhideCommentRequest{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnhideCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnhideCommentRequest) ProtoMessage() {}
+
+func (x *UnhideCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnhideCommentRequest.ProtoReflect.Descriptor instead.
+func (*UnhideCommentRequest) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *UnhideCommentRequest) GetCommentId() uint64 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
+type UnhideCommentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UnhideCommentResponse) Reset() {
+	*x = UnhideCommentResponse{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnhideCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnhideCommentResponse) ProtoMessage() {}
+
+func (x *UnhideCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnhideCommentResponse.ProtoReflect.Descriptor instead.
+func (*UnhideCommentResponse) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{93}
+}
+
+type SetCommentPolicyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId        uint64        `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	CommentPolicy CommentPolicy `protobuf:"varint,2,opt,name=comment_policy,json=commentPolicy,proto3,enum=go_feed.CommentPolicy" json:"comment_policy,omitempty"`
+}
+
+func (x *SetCommentPolicyRequest) Reset() {
+	*x = SetCommentPolicyRequest{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCommentPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCommentPolicyRequest) ProtoMessage() {}
+
+func (x *SetCommentPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCommentPolicyRequest.ProtoReflect.Descriptor instead.
+func (*SetCommentPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *SetCommentPolicyRequest) GetPostId() uint64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *SetCommentPolicyRequest) GetCommentPolicy() CommentPolicy {
+	if x != nil {
+		return x.CommentPolicy
+	}
+	return CommentPolicy_COMMENT_POLICY_UNSPECIFIED
+}
+
+type SetCommentPolicyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetCommentPolicyResponse) Reset() {
+	*x = SetCommentPolicyResponse{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCommentPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCommentPolicyResponse) ProtoMessage() {}
+
+func (x *SetCommentPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCommentPolicyResponse.ProtoReflect.Descriptor instead.
+func (*SetCommentPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{95}
+}
+
+type CreateFollowRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FollowingId uint64 `protobuf:"varint,1,opt,name=following_id,json=followingId,proto3" json:"following_id,omitempty"`
+}
+
+func (x *CreateFollowRequest) Reset() {
+	*x = CreateFollowRequest{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFollowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFollowRequest) ProtoMessage() {}
+
+func (x *CreateFollowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFollowRequest.ProtoReflect.Descriptor instead.
+func (*CreateFollowRequest) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *CreateFollowRequest) GetFollowingId() uint64 {
+	if x != nil {
+		return x.FollowingId
+	}
+	return 0
+}
+
+type CreateFollowResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// pending is set when the account is private, the follow then waits for its approval.
+	Pending bool `protobuf:"varint,1,opt,name=pending,proto3" json:"pending,omitempty"`
+}
+
+func (x *CreateFollowResponse) Reset() {
+	*x = CreateFollowResponse{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFollowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFollowResponse) ProtoMessage() {}
+
+func (x *CreateFollowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFollowResponse.ProtoReflect.Descriptor instead.
+func (*CreateFollowResponse) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *CreateFollowResponse) GetPending() bool {
+	if x != nil {
+		return x.Pending
+	}
+	return false
+}
+
+type GetFollowerCountOfAccountRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountId uint64 `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+}
+
+func (x *GetFollowerCountOfAccountRequest) Reset() {
+	*x = GetFollowerCountOfAccountRequest{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFollowerCountOfAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFollowerCountOfAccountRequest) ProtoMessage() {}
+
+func (x *GetFollowerCountOfAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFollowerCountOfAccountRequest.ProtoReflect.Descriptor instead.
+func (*GetFollowerCountOfAccountRequest) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *GetFollowerCountOfAccountRequest) GetAccountId() uint64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+type GetFollowerCountOfAccountResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FollowerCount uint64 `protobuf:"varint,1,opt,name=follower_count,json=followerCount,proto3" json:"follower_count,omitempty"`
+}
+
+func (x *GetFollowerCountOfAccountResponse) Reset() {
+	*x = GetFollowerCountOfAccountResponse{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFollowerCountOfAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFollowerCountOfAccountResponse) ProtoMessage() {}
+
+func (x *GetFollowerCountOfAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFollowerCountOfAccountResponse.ProtoReflect.Descriptor instead.
+func (*GetFollowerCountOfAccountResponse) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *GetFollowerCountOfAccountResponse) GetFollowerCount() uint64 {
+	if x != nil {
+		return x.FollowerCount
+	}
+	return 0
+}
+
+type GetFollowersOfAccountRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountId uint64       `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Page      *PageRequest `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+}
+
+func (x *GetFollowersOfAccountRequest) Reset() {
+	*x = GetFollowersOfAccountRequest{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFollowersOfAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFollowersOfAccountRequest) ProtoMessage() {}
+
+func (x *GetFollowersOfAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFollowersOfAccountRequest.ProtoReflect.Descriptor instead.
+func (*GetFollowersOfAccountRequest) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *GetFollowersOfAccountRequest) GetAccountId() uint64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *GetFollowersOfAccountRequest) GetPage() *PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type GetFollowersOfAccountResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FollowerList []*Account    `protobuf:"bytes,1,rep,name=follower_list,json=followerList,proto3" json:"follower_list,omitempty"`
+	Page         *PageResponse `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+}
+
+func (x *GetFollowersOfAccountResponse) Reset() {
+	*x = GetFollowersOfAccountResponse{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFollowersOfAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFollowersOfAccountResponse) ProtoMessage() {}
+
+func (x *GetFollowersOfAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFollowersOfAccountResponse.ProtoReflect.Descriptor instead.
+func (*GetFollowersOfAccountResponse) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *GetFollowersOfAccountResponse) GetFollowerList() []*Account {
+	if x != nil {
+		return x.FollowerList
+	}
+	return nil
+}
+
+func (x *GetFollowersOfAccountResponse) GetPage() *PageResponse {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type GetFollowingCountOfAccountRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountId uint64 `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+}
+
+func (x *GetFollowingCountOfAccountRequest) Reset() {
+	*x = GetFollowingCountOfAccountRequest{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFollowingCountOfAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFollowingCountOfAccountRequest) ProtoMessage() {}
+
+func (x *GetFollowingCountOfAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFollowingCountOfAccountRequest.ProtoReflect.Descriptor instead.
+func (*GetFollowingCountOfAccountRequest) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *GetFollowingCountOfAccountRequest) GetAccountId() uint64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+type GetFollowingCountOfAccountResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FollowingCount uint64 `protobuf:"varint,1,opt,name=following_count,json=followingCount,proto3" json:"following_count,omitempty"`
+}
+
+func (x *GetFollowingCountOfAccountResponse) Reset() {
+	*x = GetFollowingCountOfAccountResponse{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFollowingCountOfAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFollowingCountOfAccountResponse) ProtoMessage() {}
+
+func (x *GetFollowingCountOfAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFollowingCountOfAccountResponse.ProtoReflect.Descriptor instead.
+func (*GetFollowingCountOfAccountResponse) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *GetFollowingCountOfAccountResponse) GetFollowingCount() uint64 {
+	if x != nil {
+		return x.FollowingCount
+	}
+	return 0
+}
+
+type GetFollowingsOfAccountRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountId uint64       `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Page      *PageRequest `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+}
+
+func (x *GetFollowingsOfAccountRequest) Reset() {
+	*x = GetFollowingsOfAccountRequest{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFollowingsOfAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFollowingsOfAccountRequest) ProtoMessage() {}
+
+func (x *GetFollowingsOfAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFollowingsOfAccountRequest.ProtoReflect.Descriptor instead.
+func (*GetFollowingsOfAccountRequest) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *GetFollowingsOfAccountRequest) GetAccountId() uint64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *GetFollowingsOfAccountRequest) GetPage() *PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type GetFollowingsOfAccountResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FollowingList []*Account    `protobuf:"bytes,1,rep,name=following_list,json=followingList,proto3" json:"following_list,omitempty"`
+	Page          *PageResponse `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+}
+
+func (x *GetFollowingsOfAccountResponse) Reset() {
+	*x = GetFollowingsOfAccountResponse{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFollowingsOfAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFollowingsOfAccountResponse) ProtoMessage() {}
+
+func (x *GetFollowingsOfAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFollowingsOfAccountResponse.ProtoReflect.Descriptor instead.
+func (*GetFollowingsOfAccountResponse) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *GetFollowingsOfAccountResponse) GetFollowingList() []*Account {
+	if x != nil {
+		return x.FollowingList
+	}
+	return nil
+}
+
+func (x *GetFollowingsOfAccountResponse) GetPage() *PageResponse {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type DeleteFollowRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FollowingId uint64 `protobuf:"varint,1,opt,name=following_id,json=followingId,proto3" json:"following_id,omitempty"`
+}
+
+func (x *DeleteFollowRequest) Reset() {
+	*x = DeleteFollowRequest{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFollowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFollowRequest) ProtoMessage() {}
+
+func (x *DeleteFollowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFollowRequest.ProtoReflect.Descriptor instead.
+func (*DeleteFollowRequest) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *DeleteFollowRequest) GetFollowingId() uint64 {
+	if x != nil {
+		return x.FollowingId
+	}
+	return 0
+}
+
+type DeleteFollowResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteFollowResponse) Reset() {
+	*x = DeleteFollowResponse{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFollowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFollowResponse) ProtoMessage() {}
+
+func (x *DeleteFollowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFollowResponse.ProtoReflect.Descriptor instead.
+func (*DeleteFollowResponse) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{107}
+}
+
+type ListFollowRequestsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// cursor is the next_cursor of the previous page, empty for the first page.
+	Cursor string `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit  uint64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ListFollowRequestsRequest) Reset() {
+	*x = ListFollowRequestsRequest{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFollowRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFollowRequestsRequest) ProtoMessage() {}
+
+func (x *ListFollowRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFollowRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ListFollowRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *ListFollowRequestsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListFollowRequestsRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListFollowRequestsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountList []*Account `protobuf:"bytes,1,rep,name=account_list,json=accountList,proto3" json:"account_list,omitempty"`
+	// next_cursor is empty on the last page.
+	NextCursor string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+}
+
+func (x *ListFollowRequestsResponse) Reset() {
+	*x = ListFollowRequestsResponse{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFollowRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFollowRequestsResponse) ProtoMessage() {}
+
+func (x *ListFollowRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFollowRequestsResponse.ProtoReflect.Descriptor instead.
+func (*ListFollowRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *ListFollowRequestsResponse) GetAccountList() []*Account {
+	if x != nil {
+		return x.AccountList
+	}
+	return nil
+}
+
+func (x *ListFollowRequestsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type AcceptFollowRequestRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountId uint64 `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+}
+
+func (x *AcceptFollowRequestRequest) Reset() {
+	*x = AcceptFollowRequestRequest{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptFollowRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptFollowRequestRequest) ProtoMessage() {}
+
+func (x *AcceptFollowRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptFollowRequestRequest.ProtoReflect.Descriptor instead.
+func (*AcceptFollowRequestRequest) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *AcceptFollowRequestRequest) GetAccountId() uint64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+type AcceptFollowRequestResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AcceptFollowRequestResponse) Reset() {
+	*x = AcceptFollowRequestResponse{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptFollowRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptFollowRequestResponse) ProtoMessage() {}
+
+func (x *AcceptFollowRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptFollowRequestResponse.ProtoReflect.Descriptor instead.
+func (*AcceptFollowRequestResponse) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{111}
+}
+
+type RejectFollowRequestRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountId uint64 `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+}
+
+func (x *RejectFollowRequestRequest) Reset() {
+	*x = RejectFollowRequestRequest{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectFollowRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectFollowRequestRequest) ProtoMessage() {}
+
+func (x *RejectFollowRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectFollowRequestRequest.ProtoReflect.Descriptor instead.
+func (*RejectFollowRequestRequest) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *RejectFollowRequestRequest) GetAccountId() uint64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+type RejectFollowRequestResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RejectFollowRequestResponse) Reset() {
+	*x = RejectFollowRequestResponse{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectFollowRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectFollowRequestResponse) ProtoMessage() {}
+
+func (x *RejectFollowRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectFollowRequestResponse.ProtoReflect.Descriptor instead.
+func (*RejectFollowRequestResponse) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{113}
+}
+
+// GetFollowSuggestionsRequest returns accounts followed by the accounts the caller follows, the ones followed by most
+// of them first, then popular accounts when there are not enough of them.
+type GetFollowSuggestionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Limit uint64 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *GetFollowSuggestionsRequest) Reset() {
+	*x = GetFollowSuggestionsRequest{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFollowSuggestionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFollowSuggestionsRequest) ProtoMessage() {}
+
+func (x *GetFollowSuggestionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFollowSuggestionsRequest.ProtoReflect.Descriptor instead.
+func (*GetFollowSuggestionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *GetFollowSuggestionsRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetFollowSuggestionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SuggestionList []*FollowSuggestion `protobuf:"bytes,1,rep,name=suggestion_list,json=suggestionList,proto3" json:"suggestion_list,omitempty"`
+}
+
+func (x *GetFollowSuggestionsResponse) Reset() {
+	*x = GetFollowSuggestionsResponse{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFollowSuggestionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFollowSuggestionsResponse) ProtoMessage() {}
+
+func (x *GetFollowSuggestionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFollowSuggestionsResponse.ProtoReflect.Descriptor instead.
+func (*GetFollowSuggestionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *GetFollowSuggestionsResponse) GetSuggestionList() []*FollowSuggestion {
+	if x != nil {
+		return x.SuggestionList
+	}
+	return nil
+}
+
+type GetRelationshipsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// account_ids holds at most 100 accounts.
+	AccountIds []uint64 `protobuf:"varint,1,rep,packed,name=account_ids,json=accountIds,proto3" json:"account_ids,omitempty"`
+}
+
+func (x *GetRelationshipsRequest) Reset() {
+	*x = GetRelationshipsRequest{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRelationshipsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRelationshipsRequest) ProtoMessage() {}
+
+func (x *GetRelationshipsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRelationshipsRequest.ProtoReflect.Descriptor instead.
+func (*GetRelationshipsRequest) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *GetRelationshipsRequest) GetAccountIds() []uint64 {
+	if x != nil {
+		return x.AccountIds
+	}
+	return nil
+}
+
+type GetRelationshipsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// relationship_list is in the order of account_ids.
+	RelationshipList []*Relationship `protobuf:"bytes,1,rep,name=relationship_list,json=relationshipList,proto3" json:"relationship_list,omitempty"`
+}
+
+func (x *GetRelationshipsResponse) Reset() {
+	*x = GetRelationshipsResponse{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRelationshipsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRelationshipsResponse) ProtoMessage() {}
+
+func (x *GetRelationshipsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRelationshipsResponse.ProtoReflect.Descriptor instead.
+func (*GetRelationshipsResponse) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *GetRelationshipsResponse) GetRelationshipList() []*Relationship {
+	if x != nil {
+		return x.RelationshipList
+	}
+	return nil
+}
+
+// BlockAccountRequest blocks an account, removing the follows between the two accounts. Neither account can then
+// follow the other or comment on, react to or see the posts of the other.
+type BlockAccountRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountId uint64 `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+}
+
+func (x *BlockAccountRequest) Reset() {
+	*x = BlockAccountRequest{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockAccountRequest) ProtoMessage() {}
+
+func (x *BlockAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockAccountRequest.ProtoReflect.Descriptor instead.
+func (*BlockAccountRequest) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *BlockAccountRequest) GetAccountId() uint64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+type BlockAccountResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *BlockAccountResponse) Reset() {
+	*x = BlockAccountResponse{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockAccountResponse) ProtoMessage() {}
+
+func (x *BlockAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockAccountResponse.ProtoReflect.Descriptor instead.
+func (*BlockAccountResponse) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{119}
+}
+
+type UnblockAccountRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountId uint64 `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+}
+
+func (x *UnblockAccountRequest) Reset() {
+	*x = UnblockAccountRequest{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnblockAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnblockAccountRequest) ProtoMessage() {}
+
+func (x *UnblockAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnblockAccountRequest.ProtoReflect.Descriptor instead.
+func (*UnblockAccountRequest) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *UnblockAccountRequest) GetAccountId() uint64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+type UnblockAccountResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UnblockAccountResponse) Reset() {
+	*x = UnblockAccountResponse{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnblockAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnblockAccountResponse) ProtoMessage() {}
+
+func (x *UnblockAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnblockAccountResponse.ProtoReflect.Descriptor instead.
+func (*UnblockAccountResponse) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{121}
+}
+
+type ListBlockedAccountsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// cursor is the next_cursor of the previous page, empty for the first page.
+	Cursor string `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit  uint64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ListBlockedAccountsRequest) Reset() {
+	*x = ListBlockedAccountsRequest{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBlockedAccountsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBlockedAccountsRequest) ProtoMessage() {}
+
+func (x *ListBlockedAccountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBlockedAccountsRequest.ProtoReflect.Descriptor instead.
+func (*ListBlockedAccountsRequest) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *ListBlockedAccountsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListBlockedAccountsRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListBlockedAccountsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountList []*Account `protobuf:"bytes,1,rep,name=account_list,json=accountList,proto3" json:"account_list,omitempty"`
+	// next_cursor is empty on the last page.
+	NextCursor string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+}
+
+func (x *ListBlockedAccountsResponse) Reset() {
+	*x = ListBlockedAccountsResponse{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBlockedAccountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBlockedAccountsResponse) ProtoMessage() {}
+
+func (x *ListBlockedAccountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBlockedAccountsResponse.ProtoReflect.Descriptor instead.
+func (*ListBlockedAccountsResponse) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *ListBlockedAccountsResponse) GetAccountList() []*Account {
+	if x != nil {
+		return x.AccountList
+	}
+	return nil
+}
+
+func (x *ListBlockedAccountsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+// MuteAccountRequest silently leaves the posts of an account out of the lists of posts the caller browses.
+type MuteAccountRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountId uint64 `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+}
+
+func (x *MuteAccountRequest) Reset() {
+	*x = MuteAccountRequest{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MuteAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MuteAccountRequest) ProtoMessage() {}
+
+func (x *MuteAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MuteAccountRequest.ProtoReflect.Descriptor instead.
+func (*MuteAccountRequest) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *MuteAccountRequest) GetAccountId() uint64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+type MuteAccountResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MuteAccountResponse) Reset() {
+	*x = MuteAccountResponse{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MuteAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MuteAccountResponse) ProtoMessage() {}
+
+func (x *MuteAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MuteAccountResponse.ProtoReflect.Descriptor instead.
+func (*MuteAccountResponse) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{125}
+}
+
+type UnmuteAccountRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountId uint64 `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+}
+
+func (x *UnmuteAccountRequest) Reset() {
+	*x = UnmuteAccountRequest{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnmuteAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnmuteAccountRequest) ProtoMessage() {}
+
+func (x *UnmuteAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnmuteAccountRequest.ProtoReflect.Descriptor instead.
+func (*UnmuteAccountRequest) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *UnmuteAccountRequest) GetAccountId() uint64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+type UnmuteAccountResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UnmuteAccountResponse) Reset() {
+	*x = UnmuteAccountResponse{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnmuteAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnmuteAccountResponse) ProtoMessage() {}
+
+func (x *UnmuteAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnmuteAccountResponse.ProtoReflect.Descriptor instead.
+func (*UnmuteAccountResponse) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{127}
+}
+
+type ListMutedAccountsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// cursor is the next_cursor of the previous page, empty for the first page.
+	Cursor string `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit  uint64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ListMutedAccountsRequest) Reset() {
+	*x = ListMutedAccountsRequest{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMutedAccountsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMutedAccountsRequest) ProtoMessage() {}
+
+func (x *ListMutedAccountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMutedAccountsRequest.ProtoReflect.Descriptor instead.
+func (*ListMutedAccountsRequest) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *ListMutedAccountsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListMutedAccountsRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListMutedAccountsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountList []*Account `protobuf:"bytes,1,rep,name=account_list,json=accountList,proto3" json:"account_list,omitempty"`
+	// next_cursor is empty on the last page.
+	NextCursor string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+}
+
+func (x *ListMutedAccountsResponse) Reset() {
+	*x = ListMutedAccountsResponse{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMutedAccountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMutedAccountsResponse) ProtoMessage() {}
+
+func (x *ListMutedAccountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMutedAccountsResponse.ProtoReflect.Descriptor instead.
+func (*ListMutedAccountsResponse) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *ListMutedAccountsResponse) GetAccountList() []*Account {
+	if x != nil {
+		return x.AccountList
+	}
+	return nil
+}
+
+func (x *ListMutedAccountsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type GetNewFeedsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetNewFeedsRequest) Reset() {
+	*x = GetNewFeedsRequest{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNewFeedsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNewFeedsRequest) ProtoMessage() {}
+
+func (x *GetNewFeedsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNewFeedsRequest.ProtoReflect.Descriptor instead.
+func (*GetNewFeedsRequest) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{130}
+}
+
+type GetNewFeedsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostList []*Post `protobuf:"bytes,1,rep,name=post_list,json=postList,proto3" json:"post_list,omitempty"`
+}
+
+func (x *GetNewFeedsResponse) Reset() {
+	*x = GetNewFeedsResponse{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNewFeedsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNewFeedsResponse) ProtoMessage() {}
+
+func (x *GetNewFeedsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNewFeedsResponse.ProtoReflect.Descriptor instead.
+func (*GetNewFeedsResponse) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *GetNewFeedsResponse) GetPostList() []*Post {
+	if x != nil {
+		return x.PostList
+	}
+	return nil
+}
+
+type ReportContentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContentType ContentType `protobuf:"varint,1,opt,name=content_type,json=contentType,proto3,enum=go_feed.ContentType" json:"content_type,omitempty"`
+	ContentId   uint64      `protobuf:"varint,2,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`
+	Reason      string      `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *ReportContentRequest) Reset() {
+	*x = ReportContentRequest{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportContentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportContentRequest) ProtoMessage() {}
+
+func (x *ReportContentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[132]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportContentRequest.ProtoReflect.Descriptor instead.
+func (*ReportContentRequest) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *ReportContentRequest) GetContentType() ContentType {
+	if x != nil {
+		return x.ContentType
+	}
+	return ContentType_CONTENT_TYPE_UNSPECIFIED
+}
+
+func (x *ReportContentRequest) GetContentId() uint64 {
+	if x != nil {
+		return x.ContentId
+	}
+	return 0
+}
+
+func (x *ReportContentRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ReportContentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReportId uint64 `protobuf:"varint,1,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+}
+
+func (x *ReportContentResponse) Reset() {
+	*x = ReportContentResponse{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportContentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportContentResponse) ProtoMessage() {}
+
+func (x *ReportContentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[133]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportContentResponse.ProtoReflect.Descriptor instead.
+func (*ReportContentResponse) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *ReportContentResponse) GetReportId() uint64 {
+	if x != nil {
+		return x.ReportId
+	}
+	return 0
+}
+
+type ListReportsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// An unspecified status lists reports of every status.
+	Status ReportStatus `protobuf:"varint,1,opt,name=status,proto3,enum=go_feed.ReportStatus" json:"status,omitempty"`
+	Offset uint64       `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit  uint64       `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ListReportsRequest) Reset() {
+	*x = ListReportsRequest{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[134]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReportsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReportsRequest) ProtoMessage() {}
+
+func (x *ListReportsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[134]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReportsRequest.ProtoReflect.Descriptor instead.
+func (*ListReportsRequest) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *ListReportsRequest) GetStatus() ReportStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ReportStatus_REPORT_STATUS_UNSPECIFIED
+}
+
+func (x *ListReportsRequest) GetOffset() uint64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListReportsRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListReportsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReportList []*Report `protobuf:"bytes,1,rep,name=report_list,json=reportList,proto3" json:"report_list,omitempty"`
+}
+
+func (x *ListReportsResponse) Reset() {
+	*x = ListReportsResponse{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[135]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReportsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReportsResponse) ProtoMessage() {}
+
+func (x *ListReportsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[135]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReportsResponse.ProtoReflect.Descriptor instead.
+func (*ListReportsResponse) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *ListReportsResponse) GetReportList() []*Report {
+	if x != nil {
+		return x.ReportList
+	}
+	return nil
+}
+
+type ResolveReportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReportId uint64 `protobuf:"varint,1,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+	// status must be REPORT_STATUS_RESOLVED or REPORT_STATUS_DISMISSED.
+	Status ReportStatus `protobuf:"varint,2,opt,name=status,proto3,enum=go_feed.ReportStatus" json:"status,omitempty"`
+	Note   string       `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+}
+
+func (x *ResolveReportRequest) Reset() {
+	*x = ResolveReportRequest{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveReportRequest) ProtoMessage() {}
+
+func (x *ResolveReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[136]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveReportRequest.ProtoReflect.Descriptor instead.
+func (*ResolveReportRequest) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *ResolveReportRequest) GetReportId() uint64 {
+	if x != nil {
+		return x.ReportId
+	}
+	return 0
+}
+
+func (x *ResolveReportRequest) GetStatus() ReportStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ReportStatus_REPORT_STATUS_UNSPECIFIED
+}
+
+func (x *ResolveReportRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type ResolveReportResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ResolveReportResponse) Reset() {
+	*x = ResolveReportResponse{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[137]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveReportResponse) ProtoMessage() {}
+
+func (x *ResolveReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[137]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveReportResponse.ProtoReflect.Descriptor instead.
+func (*ResolveReportResponse) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{137}
+}
+
+type TakedownContentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContentType ContentType `protobuf:"varint,1,opt,name=content_type,json=contentType,proto3,enum=go_feed.ContentType" json:"content_type,omitempty"`
+	ContentId   uint64      `protobuf:"varint,2,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`
+	Reason      string      `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *TakedownContentRequest) Reset() {
+	*x = TakedownContentRequest{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TakedownContentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TakedownContentRequest) ProtoMessage() {}
+
+func (x *TakedownContentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[138]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TakedownContentRequest.ProtoReflect.Descriptor instead.
+func (*TakedownContentRequest) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *TakedownContentRequest) GetContentType() ContentType {
+	if x != nil {
+		return x.ContentType
+	}
+	return ContentType_CONTENT_TYPE_UNSPECIFIED
+}
+
+func (x *TakedownContentRequest) GetContentId() uint64 {
+	if x != nil {
+		return x.ContentId
+	}
+	return 0
+}
+
+func (x *TakedownContentRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type TakedownContentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *TakedownContentResponse) Reset() {
+	*x = TakedownContentResponse{}
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[139]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TakedownContentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TakedownContentResponse) ProtoMessage() {}
+
+func (x *TakedownContentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_go_feed_request_and_response_proto_msgTypes[139]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TakedownContentResponse.ProtoReflect.Descriptor instead.
+func (*TakedownContentResponse) Descriptor() ([]byte, []int) {
+	return file_api_go_feed_request_and_response_proto_rawDescGZIP(), []int{139}
+}
+
+var File_api_go_feed_request_and_response_proto protoreflect.FileDescriptor
+
+var file_api_go_feed_request_and_response_proto_rawDesc = []byte{
+	0x0a, 0x26, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2f, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65,
+	0x64, 0x1a, 0x19, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2f, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x55, 0x0a,
+	0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x22, 0x36, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x55, 0x0a, 0x14,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x22, 0x36, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x5b, 0x0a, 0x15, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x4d, 0x0a, 0x16, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
 	0x73, 0x65, 0x12, 0x33, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6c, 0x69,
 	0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65,
 	0x65, 0x64, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x2c, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x3a, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x4c, 0x69,
+	0x6b, 0x65, 0x73, 0x48, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x5f, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x48, 0x69, 0x64,
+	0x64, 0x65, 0x6e, 0x22, 0x18, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x48,
+	0x69, 0x64, 0x64, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x39, 0x0a,
+	0x18, 0x53, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x69, 0x76, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f,
+	0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69,
+	0x73, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x22, 0x1b, 0x0a, 0x19, 0x53, 0x65, 0x74, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x53, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50,
+	0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x04, 0x70, 0x6f, 0x6c, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x4e, 0x65, 0x77,
+	0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x04, 0x70, 0x6f, 0x6c, 0x6c, 0x22, 0x2d, 0x0a, 0x12, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x22, 0x2d, 0x0a, 0x12, 0x47, 0x65, 0x74,
+	0x50, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x22, 0x38, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x50,
+	0x6f, 0x73, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x21, 0x0a, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
+	0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x04, 0x70, 0x6f,
+	0x73, 0x74, 0x22, 0x62, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x4f, 0x66, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x04,
+	0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x5f,
+	0x66, 0x65, 0x65, 0x64, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x22, 0x71, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73,
+	0x74, 0x4f, 0x66, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2a, 0x0a, 0x09, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e,
+	0x50, 0x6f, 0x73, 0x74, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x29,
+	0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67,
+	0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x22, 0x36, 0x0a, 0x11, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21,
+	0x0a, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67,
+	0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x04, 0x70, 0x6f, 0x73,
+	0x74, 0x22, 0x14, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x62, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x50, 0x6f,
+	0x73, 0x74, 0x73, 0x42, 0x79, 0x48, 0x61, 0x73, 0x68, 0x74, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x61, 0x73, 0x68, 0x74, 0x61, 0x67, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x68, 0x61, 0x73, 0x68, 0x74, 0x61, 0x67, 0x12, 0x16, 0x0a,
+	0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x47, 0x0a, 0x19, 0x47,
+	0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x42, 0x79, 0x48, 0x61, 0x73, 0x68, 0x74, 0x61, 0x67,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x09, 0x70, 0x6f, 0x73, 0x74,
+	0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x6f,
+	0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x74,
+	0x4c, 0x69, 0x73, 0x74, 0x22, 0x6f, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73,
+	0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x4f, 0x0a, 0x21, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74,
+	0x73, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x09, 0x70, 0x6f,
+	0x73, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
+	0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x08, 0x70, 0x6f,
+	0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x69, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73,
+	0x68, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x41,
+	0x74, 0x22, 0x2e, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x72, 0x61, 0x66, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49,
+	0x64, 0x22, 0x13, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x72, 0x61, 0x66, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x40, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x72,
+	0x61, 0x66, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x09,
+	0x70, 0x6f, 0x73, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x08,
+	0x70, 0x6f, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x82, 0x01, 0x0a, 0x12, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x5f, 0x61, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x41, 0x74, 0x22, 0x15, 0x0a,
+	0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x69, 0x0a, 0x13, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x44,
+	0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70,
+	0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x6f,
+	0x73, 0x74, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x5f,
+	0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x41, 0x74, 0x22,
+	0x16, 0x0a, 0x14, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29, 0x0a, 0x0e, 0x50, 0x69, 0x6e, 0x50, 0x6f,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74,
+	0x49, 0x64, 0x22, 0x11, 0x0a, 0x0f, 0x50, 0x69, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x0a, 0x10, 0x55, 0x6e, 0x70, 0x69, 0x6e, 0x50, 0x6f,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74,
+	0x49, 0x64, 0x22, 0x13, 0x0a, 0x11, 0x55, 0x6e, 0x70, 0x69, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70,
+	0x6f, 0x73, 0x74, 0x49, 0x64, 0x22, 0x14, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50,
+	0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x0a, 0x12, 0x52,
+	0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x22, 0x15, 0x0a, 0x13, 0x52, 0x65,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x19, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x46, 0x0a, 0x18,
+	0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x09, 0x70, 0x6f, 0x73, 0x74,
+	0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x6f,
+	0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x74,
+	0x4c, 0x69, 0x73, 0x74, 0x22, 0x30, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6f,
+	0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
+	0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x22, 0x18, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x30, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61,
+	0x72, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74,
+	0x49, 0x64, 0x22, 0x18, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b,
+	0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x44, 0x0a, 0x14,
+	0x4c, 0x69, 0x73, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x22, 0x64, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61,
+	0x72, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x09, 0x70,
+	0x6f, 0x73, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d,
+	0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x08, 0x70,
+	0x6f, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f,
+	0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65,
+	0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x63, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x54,
+	0x72, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x48, 0x61, 0x73, 0x68, 0x74, 0x61, 0x67, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64,
+	0x2e, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52,
+	0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x5a, 0x0a,
+	0x1b, 0x47, 0x65, 0x74, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x48, 0x61, 0x73, 0x68,
+	0x74, 0x61, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0c,
+	0x68, 0x61, 0x73, 0x68, 0x74, 0x61, 0x67, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x54, 0x72, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x48, 0x61, 0x73, 0x68, 0x74, 0x61, 0x67, 0x52, 0x0b, 0x68, 0x61,
+	0x73, 0x68, 0x74, 0x61, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x60, 0x0a, 0x17, 0x47, 0x65, 0x74,
+	0x54, 0x72, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x54,
+	0x72, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x06, 0x77,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x46, 0x0a, 0x18, 0x47,
+	0x65, 0x74, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x09, 0x70, 0x6f, 0x73, 0x74, 0x5f,
+	0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x6f, 0x5f,
+	0x66, 0x65, 0x65, 0x64, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x74, 0x4c,
+	0x69, 0x73, 0x74, 0x22, 0x49, 0x0a, 0x0f, 0x56, 0x6f, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x6c, 0x6c, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x6c, 0x49, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x04, 0x52, 0x09, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x22, 0x35,
+	0x0a, 0x10, 0x56, 0x6f, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x21, 0x0a, 0x04, 0x70, 0x6f, 0x6c, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0d, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x50, 0x6f, 0x6c, 0x6c, 0x52,
+	0x04, 0x70, 0x6f, 0x6c, 0x6c, 0x22, 0x30, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6c, 0x6c,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x70, 0x6f, 0x6c, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x70, 0x6f, 0x6c, 0x6c, 0x49, 0x64, 0x22, 0x3b, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x50, 0x6f,
+	0x6c, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x21, 0x0a, 0x04, 0x70, 0x6f, 0x6c, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x04,
+	0x70, 0x6f, 0x6c, 0x6c, 0x22, 0x58, 0x0a, 0x12, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x6f,
+	0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x41,
+	0x0a, 0x13, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x09, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x6c, 0x69,
+	0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65,
+	0x65, 0x64, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x74, 0x4c, 0x69, 0x73,
+	0x74, 0x22, 0x2c, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6b, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x22,
+	0x14, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x69, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x52, 0x65, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70,
+	0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x6f,
+	0x73, 0x74, 0x49, 0x64, 0x12, 0x3a, 0x0a, 0x0d, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x67, 0x6f,
+	0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x0c, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65,
+	0x22, 0x15, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x4c, 0x69,
+	0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x66, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x22, 0x7c, 0x0a,
+	0x1a, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x66, 0x50,
+	0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6c,
+	0x69, 0x6b, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x09, 0x6c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3f, 0x0a, 0x0f, 0x72, 0x65,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x52, 0x65,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0e, 0x72, 0x65, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x22, 0x9d, 0x01, 0x0a, 0x1c,
+	0x47, 0x65, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x4f,
+	0x66, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
 	0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70,
-	0x6f, 0x73, 0x74, 0x49, 0x64, 0x22, 0x14, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c,
-	0x69, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x49, 0x0a, 0x14, 0x43,
+	0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x3a, 0x0a, 0x0d, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x67,
+	0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x0c, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x28, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x22, 0x7f, 0x0a, 0x1d, 0x47,
+	0x65, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x4f, 0x66,
+	0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x0c,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x29, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x22, 0x2c, 0x0a, 0x11,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x22, 0x14, 0x0a, 0x12, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x30, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74,
+	0x49, 0x64, 0x22, 0x18, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31, 0x0a, 0x14,
+	0x48, 0x61, 0x73, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x73, 0x22,
+	0xf0, 0x01, 0x0a, 0x15, 0x48, 0x61, 0x73, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x6c, 0x69, 0x6b,
+	0x65, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x04, 0x52, 0x0c, 0x6c, 0x69, 0x6b, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x73, 0x12,
+	0x58, 0x0a, 0x0e, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65,
+	0x64, 0x2e, 0x48, 0x61, 0x73, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x79, 0x70, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x72, 0x65, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x73, 0x1a, 0x57, 0x0a, 0x12, 0x52, 0x65, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x2b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x15, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x52, 0x65, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0xa8, 0x01, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x50,
+	0x6f, 0x73, 0x74, 0x73, 0x4f, 0x66, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x49, 0x64, 0x12, 0x3a, 0x0a, 0x0d, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x67, 0x6f, 0x5f,
+	0x66, 0x65, 0x65, 0x64, 0x2e, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x0c, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x6d, 0x0a,
+	0x1e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x4f, 0x66,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2a, 0x0a, 0x09, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x50, 0x6f, 0x73,
+	0x74, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6e,
+	0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x39, 0x0a, 0x18,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6b,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x1b, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3d, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x66, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x64, 0x22, 0x3e, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x4f, 0x66, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x69, 0x6b, 0x65, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6c, 0x69, 0x6b, 0x65, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0x39, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x1b,
+	0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4c,
+	0x69, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x75, 0x0a, 0x14, 0x43,
 	0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
 	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07,
 	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
-	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x36, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x37,
-	0x0a, 0x1c, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x4f, 0x66, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
-	0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x22, 0x44, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x43, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x66, 0x50, 0x6f, 0x73, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x33, 0x0a,
-	0x18, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x4f, 0x66, 0x50, 0x6f,
-	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74,
-	0x49, 0x64, 0x22, 0x50, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x4f, 0x66, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x33, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e,
-	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x4c, 0x69, 0x73, 0x74, 0x22, 0x42, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x07,
-	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
+	0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x22, 0x36, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x37, 0x0a, 0x1c, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x66, 0x50,
+	0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f,
+	0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x6f, 0x73,
+	0x74, 0x49, 0x64, 0x22, 0x44, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x66, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xae, 0x01, 0x0a, 0x18, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x4f, 0x66, 0x50, 0x6f, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x12, 0x28, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14,
+	0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x53, 0x6f, 0x72, 0x74, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x22, 0x71, 0x0a, 0x19, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x4f, 0x66, 0x50, 0x6f, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e,
 	0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52,
-	0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x36, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64,
-	0x22, 0x35, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x63, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x17, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x67, 0x0a,
+	0x18, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x69,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x71, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6c,
+	0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x67, 0x6f, 0x5f, 0x66,
+	0x65, 0x65, 0x64, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74,
+	0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e,
+	0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x42, 0x0a, 0x14, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x2a, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x36, 0x0a,
+	0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x35, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x17, 0x0a, 0x15,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33, 0x0a, 0x12, 0x48, 0x69, 0x64, 0x65, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x15, 0x0a, 0x13, 0x48, 0x69,
+	0x64, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x35, 0x0a, 0x14, 0x55, 0x6e, 0x68, 0x69, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x17, 0x0a, 0x15, 0x55, 0x6e, 0x68, 0x69,
+	0x64, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x71, 0x0a, 0x17, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70,
+	0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x3d, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e,
+	0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x22, 0x1a, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
 	0x22, 0x38, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x6f, 0x6c, 0x6c, 0x6f,
 	0x77, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x66,
-	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x22, 0x16, 0x0a, 0x14, 0x43, 0x72,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x22, 0x30, 0x0a, 0x14, 0x43, 0x72,
 	0x65, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x41, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65,
-	0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x66, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x4a, 0x0a, 0x21, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c,
-	0x6f, 0x77, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x66, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x6f,
-	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x0d, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x22, 0x3d, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72,
-	0x73, 0x4f, 0x66, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64,
-	0x22, 0x56, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73,
-	0x4f, 0x66, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x35, 0x0a, 0x0d, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x6c, 0x69,
-	0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65,
-	0x65, 0x64, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0c, 0x66, 0x6f, 0x6c, 0x6c,
-	0x6f, 0x77, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x42, 0x0a, 0x21, 0x47, 0x65, 0x74, 0x46,
+	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x41, 0x0a, 0x20,
+	0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x4f, 0x66, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x22,
+	0x4a, 0x0a, 0x21, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x4f, 0x66, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x66, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x67, 0x0a, 0x1c, 0x47,
+	0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x4f, 0x66, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x04, 0x70, 0x61,
+	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65,
+	0x65, 0x64, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x04,
+	0x70, 0x61, 0x67, 0x65, 0x22, 0x81, 0x01, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x77, 0x65, 0x72, 0x73, 0x4f, 0x66, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x0d, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
+	0x65, 0x72, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x0c, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x29, 0x0a,
+	0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x6f,
+	0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x22, 0x42, 0x0a, 0x21, 0x47, 0x65, 0x74, 0x46,
 	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x66, 0x41,
 	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
 	0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
@@ -2106,29 +7011,164 @@ var file_api_go_feed_request_and_response_proto_rawDesc = []byte{
 	0x74, 0x4f, 0x66, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
 	0x73, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x5f,
 	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x66, 0x6f, 0x6c,
-	0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x3e, 0x0a, 0x1d, 0x47,
+	0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x68, 0x0a, 0x1d, 0x47,
 	0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x73, 0x4f, 0x66, 0x41, 0x63,
 	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
 	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x59, 0x0a, 0x1e, 0x47,
-	0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x73, 0x4f, 0x66, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a,
-	0x0e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0d, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69,
-	0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x38, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a,
-	0x0c, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x0b, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x49, 0x64,
-	0x22, 0x16, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x14, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4e,
-	0x65, 0x77, 0x46, 0x65, 0x65, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x41,
-	0x0a, 0x13, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x77, 0x46, 0x65, 0x65, 0x64, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x09, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x6c, 0x69,
-	0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65,
-	0x65, 0x64, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x74, 0x4c, 0x69, 0x73,
-	0x74, 0x42, 0x15, 0x5a, 0x13, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64,
-	0x3b, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x04, 0x70,
+	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x5f, 0x66,
+	0x65, 0x65, 0x64, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x04, 0x70, 0x61, 0x67, 0x65, 0x22, 0x84, 0x01, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c,
+	0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x73, 0x4f, 0x66, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x0e, 0x66, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x77, 0x69, 0x6e, 0x67, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x0d, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x29, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x22, 0x38, 0x0a, 0x13,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x66, 0x6f, 0x6c, 0x6c, 0x6f,
+	0x77, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x22, 0x16, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x49,
+	0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x72, 0x0a, 0x1a, 0x4c, 0x69, 0x73,
+	0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x3b, 0x0a,
+	0x1a, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x1d, 0x0a, 0x1b, 0x41, 0x63,
+	0x63, 0x65, 0x70, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3b, 0x0a, 0x1a, 0x52, 0x65, 0x6a,
+	0x65, 0x63, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x1d, 0x0a, 0x1b, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74,
+	0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x77, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x62, 0x0a, 0x1c, 0x47, 0x65,
+	0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x0f, 0x73, 0x75,
+	0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x46, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e,
+	0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x3a,
+	0x0a, 0x17, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69,
+	0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0a,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x73, 0x22, 0x5e, 0x0a, 0x18, 0x47, 0x65,
+	0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x11, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x52, 0x65, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x52, 0x10, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x34, 0x0a, 0x13, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64,
+	0x22, 0x16, 0x0a, 0x14, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x36, 0x0a, 0x15, 0x55, 0x6e, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64,
+	0x22, 0x18, 0x0a, 0x16, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4a, 0x0a, 0x1a, 0x4c, 0x69,
+	0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x73, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x65, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x67, 0x6f,
+	0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0b, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65,
+	0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x33, 0x0a, 0x12, 0x4d,
+	0x75, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64,
+	0x22, 0x15, 0x0a, 0x13, 0x4d, 0x75, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35, 0x0a, 0x14, 0x55, 0x6e, 0x6d, 0x75, 0x74,
+	0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x17,
+	0x0a, 0x15, 0x55, 0x6e, 0x6d, 0x75, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x48, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x4d,
+	0x75, 0x74, 0x65, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x22, 0x71, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x75, 0x74, 0x65, 0x64, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33,
+	0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x22, 0x14, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x77, 0x46, 0x65,
+	0x65, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x41, 0x0a, 0x13, 0x47, 0x65,
+	0x74, 0x4e, 0x65, 0x77, 0x46, 0x65, 0x65, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2a, 0x0a, 0x09, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x50,
+	0x6f, 0x73, 0x74, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x86, 0x01,
+	0x0a, 0x14, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x67,
+	0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x34, 0x0a, 0x15, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x22, 0x71, 0x0a, 0x12,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x2d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x15, 0x2e, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22,
+	0x47, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x0b, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x67, 0x6f,
+	0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x0a, 0x72, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x76, 0x0a, 0x14, 0x52, 0x65, 0x73, 0x6f,
+	0x6c, 0x76, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x12, 0x2d, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e,
+	0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x6f, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65,
+	0x22, 0x17, 0x0a, 0x15, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x88, 0x01, 0x0a, 0x16, 0x54, 0x61,
+	0x6b, 0x65, 0x64, 0x6f, 0x77, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x5f,
+	0x66, 0x65, 0x65, 0x64, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x22, 0x19, 0x0a, 0x17, 0x54, 0x61, 0x6b, 0x65, 0x64, 0x6f, 0x77, 0x6e,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42,
+	0x15, 0x5a, 0x13, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x3b, 0x67,
+	0x6f, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2143,71 +7183,229 @@ func file_api_go_feed_request_and_response_proto_rawDescGZIP() []byte {
 	return file_api_go_feed_request_and_response_proto_rawDescData
 }
 
-var file_api_go_feed_request_and_response_proto_msgTypes = make([]protoimpl.MessageInfo, 44)
+var file_api_go_feed_request_and_response_proto_msgTypes = make([]protoimpl.MessageInfo, 141)
 var file_api_go_feed_request_and_response_proto_goTypes = []any{
 	(*CreateAccountRequest)(nil),               // 0: go_feed.CreateAccountRequest
 	(*CreateAccountResponse)(nil),              // 1: go_feed.CreateAccountResponse
 	(*CreateSessionRequest)(nil),               // 2: go_feed.CreateSessionRequest
 	(*CreateSessionResponse)(nil),              // 3: go_feed.CreateSessionResponse
-	(*CreatePostRequest)(nil),                  // 4: go_feed.CreatePostRequest
-	(*CreatePostResponse)(nil),                 // 5: go_feed.CreatePostResponse
-	(*GetPostByIDRequest)(nil),                 // 6: go_feed.GetPostByIDRequest
-	(*GetPostByIDResponse)(nil),                // 7: go_feed.GetPostByIDResponse
-	(*GetPostOfAccountRequest)(nil),            // 8: go_feed.GetPostOfAccountRequest
-	(*GetPostOfAccountResponse)(nil),           // 9: go_feed.GetPostOfAccountResponse
-	(*UpdatePostRequest)(nil),                  // 10: go_feed.UpdatePostRequest
-	(*UpdatePostResponse)(nil),                 // 11: go_feed.UpdatePostResponse
-	(*CreateLikeRequest)(nil),                  // 12: go_feed.CreateLikeRequest
-	(*CreateLikeResponse)(nil),                 // 13: go_feed.CreateLikeResponse
-	(*GetLikeCountOfPostRequest)(nil),          // 14: go_feed.GetLikeCountOfPostRequest
-	(*GetLikeCountOfPostResponse)(nil),         // 15: go_feed.GetLikeCountOfPostResponse
-	(*GetLikeAccountsOfPostRequest)(nil),       // 16: go_feed.GetLikeAccountsOfPostRequest
-	(*GetLikeAccountsOfPostResponse)(nil),      // 17: go_feed.GetLikeAccountsOfPostResponse
-	(*DeleteLikeRequest)(nil),                  // 18: go_feed.DeleteLikeRequest
-	(*DeleteLikeResponse)(nil),                 // 19: go_feed.DeleteLikeResponse
-	(*CreateCommentRequest)(nil),               // 20: go_feed.CreateCommentRequest
-	(*CreateCommentResponse)(nil),              // 21: go_feed.CreateCommentResponse
-	(*GetCommentCountOfPostRequest)(nil),       // 22: go_feed.GetCommentCountOfPostRequest
-	(*GetCommentCountOfPostResponse)(nil),      // 23: go_feed.GetCommentCountOfPostResponse
-	(*GetCommentsOfPostRequest)(nil),           // 24: go_feed.GetCommentsOfPostRequest
-	(*GetCommentsOfPostResponse)(nil),          // 25: go_feed.GetCommentsOfPostResponse
-	(*UpdateCommentRequest)(nil),               // 26: go_feed.UpdateCommentRequest
-	(*UpdateCommentResponse)(nil),              // 27: go_feed.UpdateCommentResponse
-	(*DeleteCommentRequest)(nil),               // 28: go_feed.DeleteCommentRequest
-	(*DeleteCommentResponse)(nil),              // 29: go_feed.DeleteCommentResponse
-	(*CreateFollowRequest)(nil),                // 30: go_feed.CreateFollowRequest
-	(*CreateFollowResponse)(nil),               // 31: go_feed.CreateFollowResponse
-	(*GetFollowerCountOfAccountRequest)(nil),   // 32: go_feed.GetFollowerCountOfAccountRequest
-	(*GetFollowerCountOfAccountResponse)(nil),  // 33: go_feed.GetFollowerCountOfAccountResponse
-	(*GetFollowersOfAccountRequest)(nil),       // 34: go_feed.GetFollowersOfAccountRequest
-	(*GetFollowersOfAccountResponse)(nil),      // 35: go_feed.GetFollowersOfAccountResponse
-	(*GetFollowingCountOfAccountRequest)(nil),  // 36: go_feed.GetFollowingCountOfAccountRequest
-	(*GetFollowingCountOfAccountResponse)(nil), // 37: go_feed.GetFollowingCountOfAccountResponse
-	(*GetFollowingsOfAccountRequest)(nil),      // 38: go_feed.GetFollowingsOfAccountRequest
-	(*GetFollowingsOfAccountResponse)(nil),     // 39: go_feed.GetFollowingsOfAccountResponse
-	(*DeleteFollowRequest)(nil),                // 40: go_feed.DeleteFollowRequest
-	(*DeleteFollowResponse)(nil),               // 41: go_feed.DeleteFollowResponse
-	(*GetNewFeedsRequest)(nil),                 // 42: go_feed.GetNewFeedsRequest
-	(*GetNewFeedsResponse)(nil),                // 43: go_feed.GetNewFeedsResponse
-	(*Post)(nil),                               // 44: go_feed.Post
-	(*Account)(nil),                            // 45: go_feed.Account
-	(*Comment)(nil),                            // 46: go_feed.Comment
+	(*SearchAccountsRequest)(nil),              // 4: go_feed.SearchAccountsRequest
+	(*SearchAccountsResponse)(nil),             // 5: go_feed.SearchAccountsResponse
+	(*SetLikesHiddenRequest)(nil),              // 6: go_feed.SetLikesHiddenRequest
+	(*SetLikesHiddenResponse)(nil),             // 7: go_feed.SetLikesHiddenResponse
+	(*SetAccountPrivateRequest)(nil),           // 8: go_feed.SetAccountPrivateRequest
+	(*SetAccountPrivateResponse)(nil),          // 9: go_feed.SetAccountPrivateResponse
+	(*CreatePostRequest)(nil),                  // 10: go_feed.CreatePostRequest
+	(*CreatePostResponse)(nil),                 // 11: go_feed.CreatePostResponse
+	(*GetPostByIDRequest)(nil),                 // 12: go_feed.GetPostByIDRequest
+	(*GetPostByIDResponse)(nil),                // 13: go_feed.GetPostByIDResponse
+	(*GetPostOfAccountRequest)(nil),            // 14: go_feed.GetPostOfAccountRequest
+	(*GetPostOfAccountResponse)(nil),           // 15: go_feed.GetPostOfAccountResponse
+	(*UpdatePostRequest)(nil),                  // 16: go_feed.UpdatePostRequest
+	(*UpdatePostResponse)(nil),                 // 17: go_feed.UpdatePostResponse
+	(*GetPostsByHashtagRequest)(nil),           // 18: go_feed.GetPostsByHashtagRequest
+	(*GetPostsByHashtagResponse)(nil),          // 19: go_feed.GetPostsByHashtagResponse
+	(*GetPostsMentioningAccountRequest)(nil),   // 20: go_feed.GetPostsMentioningAccountRequest
+	(*GetPostsMentioningAccountResponse)(nil),  // 21: go_feed.GetPostsMentioningAccountResponse
+	(*CreateDraftRequest)(nil),                 // 22: go_feed.CreateDraftRequest
+	(*CreateDraftResponse)(nil),                // 23: go_feed.CreateDraftResponse
+	(*ListDraftsRequest)(nil),                  // 24: go_feed.ListDraftsRequest
+	(*ListDraftsResponse)(nil),                 // 25: go_feed.ListDraftsResponse
+	(*UpdateDraftRequest)(nil),                 // 26: go_feed.UpdateDraftRequest
+	(*UpdateDraftResponse)(nil),                // 27: go_feed.UpdateDraftResponse
+	(*PublishDraftRequest)(nil),                // 28: go_feed.PublishDraftRequest
+	(*PublishDraftResponse)(nil),               // 29: go_feed.PublishDraftResponse
+	(*PinPostRequest)(nil),                     // 30: go_feed.PinPostRequest
+	(*PinPostResponse)(nil),                    // 31: go_feed.PinPostResponse
+	(*UnpinPostRequest)(nil),                   // 32: go_feed.UnpinPostRequest
+	(*UnpinPostResponse)(nil),                  // 33: go_feed.UnpinPostResponse
+	(*DeletePostRequest)(nil),                  // 34: go_feed.DeletePostRequest
+	(*DeletePostResponse)(nil),                 // 35: go_feed.DeletePostResponse
+	(*RestorePostRequest)(nil),                 // 36: go_feed.RestorePostRequest
+	(*RestorePostResponse)(nil),                // 37: go_feed.RestorePostResponse
+	(*ListDeletedPostsRequest)(nil),            // 38: go_feed.ListDeletedPostsRequest
+	(*ListDeletedPostsResponse)(nil),           // 39: go_feed.ListDeletedPostsResponse
+	(*CreateBookmarkRequest)(nil),              // 40: go_feed.CreateBookmarkRequest
+	(*CreateBookmarkResponse)(nil),             // 41: go_feed.CreateBookmarkResponse
+	(*DeleteBookmarkRequest)(nil),              // 42: go_feed.DeleteBookmarkRequest
+	(*DeleteBookmarkResponse)(nil),             // 43: go_feed.DeleteBookmarkResponse
+	(*ListBookmarksRequest)(nil),               // 44: go_feed.ListBookmarksRequest
+	(*ListBookmarksResponse)(nil),              // 45: go_feed.ListBookmarksResponse
+	(*GetTrendingHashtagsRequest)(nil),         // 46: go_feed.GetTrendingHashtagsRequest
+	(*GetTrendingHashtagsResponse)(nil),        // 47: go_feed.GetTrendingHashtagsResponse
+	(*GetTrendingPostsRequest)(nil),            // 48: go_feed.GetTrendingPostsRequest
+	(*GetTrendingPostsResponse)(nil),           // 49: go_feed.GetTrendingPostsResponse
+	(*VotePollRequest)(nil),                    // 50: go_feed.VotePollRequest
+	(*VotePollResponse)(nil),                   // 51: go_feed.VotePollResponse
+	(*GetPollResultsRequest)(nil),              // 52: go_feed.GetPollResultsRequest
+	(*GetPollResultsResponse)(nil),             // 53: go_feed.GetPollResultsResponse
+	(*SearchPostsRequest)(nil),                 // 54: go_feed.SearchPostsRequest
+	(*SearchPostsResponse)(nil),                // 55: go_feed.SearchPostsResponse
+	(*CreateLikeRequest)(nil),                  // 56: go_feed.CreateLikeRequest
+	(*CreateLikeResponse)(nil),                 // 57: go_feed.CreateLikeResponse
+	(*SetReactionRequest)(nil),                 // 58: go_feed.SetReactionRequest
+	(*SetReactionResponse)(nil),                // 59: go_feed.SetReactionResponse
+	(*GetLikeCountOfPostRequest)(nil),          // 60: go_feed.GetLikeCountOfPostRequest
+	(*GetLikeCountOfPostResponse)(nil),         // 61: go_feed.GetLikeCountOfPostResponse
+	(*GetLikeAccountsOfPostRequest)(nil),       // 62: go_feed.GetLikeAccountsOfPostRequest
+	(*GetLikeAccountsOfPostResponse)(nil),      // 63: go_feed.GetLikeAccountsOfPostResponse
+	(*DeleteLikeRequest)(nil),                  // 64: go_feed.DeleteLikeRequest
+	(*DeleteLikeResponse)(nil),                 // 65: go_feed.DeleteLikeResponse
+	(*DeleteReactionRequest)(nil),              // 66: go_feed.DeleteReactionRequest
+	(*DeleteReactionResponse)(nil),             // 67: go_feed.DeleteReactionResponse
+	(*HasLikedPostsRequest)(nil),               // 68: go_feed.HasLikedPostsRequest
+	(*HasLikedPostsResponse)(nil),              // 69: go_feed.HasLikedPostsResponse
+	(*GetLikedPostsOfAccountRequest)(nil),      // 70: go_feed.GetLikedPostsOfAccountRequest
+	(*GetLikedPostsOfAccountResponse)(nil),     // 71: go_feed.GetLikedPostsOfAccountResponse
+	(*CreateCommentLikeRequest)(nil),           // 72: go_feed.CreateCommentLikeRequest
+	(*CreateCommentLikeResponse)(nil),          // 73: go_feed.CreateCommentLikeResponse
+	(*GetLikeCountOfCommentRequest)(nil),       // 74: go_feed.GetLikeCountOfCommentRequest
+	(*GetLikeCountOfCommentResponse)(nil),      // 75: go_feed.GetLikeCountOfCommentResponse
+	(*DeleteCommentLikeRequest)(nil),           // 76: go_feed.DeleteCommentLikeRequest
+	(*DeleteCommentLikeResponse)(nil),          // 77: go_feed.DeleteCommentLikeResponse
+	(*CreateCommentRequest)(nil),               // 78: go_feed.CreateCommentRequest
+	(*CreateCommentResponse)(nil),              // 79: go_feed.CreateCommentResponse
+	(*GetCommentCountOfPostRequest)(nil),       // 80: go_feed.GetCommentCountOfPostRequest
+	(*GetCommentCountOfPostResponse)(nil),      // 81: go_feed.GetCommentCountOfPostResponse
+	(*GetCommentsOfPostRequest)(nil),           // 82: go_feed.GetCommentsOfPostRequest
+	(*GetCommentsOfPostResponse)(nil),          // 83: go_feed.GetCommentsOfPostResponse
+	(*GetCommentRepliesRequest)(nil),           // 84: go_feed.GetCommentRepliesRequest
+	(*GetCommentRepliesResponse)(nil),          // 85: go_feed.GetCommentRepliesResponse
+	(*UpdateCommentRequest)(nil),               // 86: go_feed.UpdateCommentRequest
+	(*UpdateCommentResponse)(nil),              // 87: go_feed.UpdateCommentResponse
+	(*DeleteCommentRequest)(nil),               // 88: go_feed.DeleteCommentRequest
+	(*DeleteCommentResponse)(nil),              // 89: go_feed.DeleteCommentResponse
+	(*HideCommentRequest)(nil),                 // 90: go_feed.HideCommentRequest
+	(*HideCommentResponse)(nil),                // 91: go_feed.HideCommentResponse
+	(*UnhideCommentRequest)(nil),               // 92: go_feed.UnhideCommentRequest
+	(*UnhideCommentResponse)(nil),              // 93: go_feed.UnhideCommentResponse
+	(*SetCommentPolicyRequest)(nil),            // 94: go_feed.SetCommentPolicyRequest
+	(*SetCommentPolicyResponse)(nil),           // 95: go_feed.SetCommentPolicyResponse
+	(*CreateFollowRequest)(nil),                // 96: go_feed.CreateFollowRequest
+	(*CreateFollowResponse)(nil),               // 97: go_feed.CreateFollowResponse
+	(*GetFollowerCountOfAccountRequest)(nil),   // 98: go_feed.GetFollowerCountOfAccountRequest
+	(*GetFollowerCountOfAccountResponse)(nil),  // 99: go_feed.GetFollowerCountOfAccountResponse
+	(*GetFollowersOfAccountRequest)(nil),       // 100: go_feed.GetFollowersOfAccountRequest
+	(*GetFollowersOfAccountResponse)(nil),      // 101: go_feed.GetFollowersOfAccountResponse
+	(*GetFollowingCountOfAccountRequest)(nil),  // 102: go_feed.GetFollowingCountOfAccountRequest
+	(*GetFollowingCountOfAccountResponse)(nil), // 103: go_feed.GetFollowingCountOfAccountResponse
+	(*GetFollowingsOfAccountRequest)(nil),      // 104: go_feed.GetFollowingsOfAccountRequest
+	(*GetFollowingsOfAccountResponse)(nil),     // 105: go_feed.GetFollowingsOfAccountResponse
+	(*DeleteFollowRequest)(nil),                // 106: go_feed.DeleteFollowRequest
+	(*DeleteFollowResponse)(nil),               // 107: go_feed.DeleteFollowResponse
+	(*ListFollowRequestsRequest)(nil),          // 108: go_feed.ListFollowRequestsRequest
+	(*ListFollowRequestsResponse)(nil),         // 109: go_feed.ListFollowRequestsResponse
+	(*AcceptFollowRequestRequest)(nil),         // 110: go_feed.AcceptFollowRequestRequest
+	(*AcceptFollowRequestResponse)(nil),        // 111: go_feed.AcceptFollowRequestResponse
+	(*RejectFollowRequestRequest)(nil),         // 112: go_feed.RejectFollowRequestRequest
+	(*RejectFollowRequestResponse)(nil),        // 113: go_feed.RejectFollowRequestResponse
+	(*GetFollowSuggestionsRequest)(nil),        // 114: go_feed.GetFollowSuggestionsRequest
+	(*GetFollowSuggestionsResponse)(nil),       // 115: go_feed.GetFollowSuggestionsResponse
+	(*GetRelationshipsRequest)(nil),            // 116: go_feed.GetRelationshipsRequest
+	(*GetRelationshipsResponse)(nil),           // 117: go_feed.GetRelationshipsResponse
+	(*BlockAccountRequest)(nil),                // 118: go_feed.BlockAccountRequest
+	(*BlockAccountResponse)(nil),               // 119: go_feed.BlockAccountResponse
+	(*UnblockAccountRequest)(nil),              // 120: go_feed.UnblockAccountRequest
+	(*UnblockAccountResponse)(nil),             // 121: go_feed.UnblockAccountResponse
+	(*ListBlockedAccountsRequest)(nil),         // 122: go_feed.ListBlockedAccountsRequest
+	(*ListBlockedAccountsResponse)(nil),        // 123: go_feed.ListBlockedAccountsResponse
+	(*MuteAccountRequest)(nil),                 // 124: go_feed.MuteAccountRequest
+	(*MuteAccountResponse)(nil),                // 125: go_feed.MuteAccountResponse
+	(*UnmuteAccountRequest)(nil),               // 126: go_feed.UnmuteAccountRequest
+	(*UnmuteAccountResponse)(nil),              // 127: go_feed.UnmuteAccountResponse
+	(*ListMutedAccountsRequest)(nil),           // 128: go_feed.ListMutedAccountsRequest
+	(*ListMutedAccountsResponse)(nil),          // 129: go_feed.ListMutedAccountsResponse
+	(*GetNewFeedsRequest)(nil),                 // 130: go_feed.GetNewFeedsRequest
+	(*GetNewFeedsResponse)(nil),                // 131: go_feed.GetNewFeedsResponse
+	(*ReportContentRequest)(nil),               // 132: go_feed.ReportContentRequest
+	(*ReportContentResponse)(nil),              // 133: go_feed.ReportContentResponse
+	(*ListReportsRequest)(nil),                 // 134: go_feed.ListReportsRequest
+	(*ListReportsResponse)(nil),                // 135: go_feed.ListReportsResponse
+	(*ResolveReportRequest)(nil),               // 136: go_feed.ResolveReportRequest
+	(*ResolveReportResponse)(nil),              // 137: go_feed.ResolveReportResponse
+	(*TakedownContentRequest)(nil),             // 138: go_feed.TakedownContentRequest
+	(*TakedownContentResponse)(nil),            // 139: go_feed.TakedownContentResponse
+	nil,                                        // 140: go_feed.HasLikedPostsResponse.ReactionTypesEntry
+	(*Account)(nil),                            // 141: go_feed.Account
+	(*NewPoll)(nil),                            // 142: go_feed.NewPoll
+	(*Post)(nil),                               // 143: go_feed.Post
+	(*PageRequest)(nil),                        // 144: go_feed.PageRequest
+	(*PageResponse)(nil),                       // 145: go_feed.PageResponse
+	(*timestamp.Timestamp)(nil),                // 146: google.protobuf.Timestamp
+	(TrendingWindow)(0),                        // 147: go_feed.TrendingWindow
+	(*TrendingHashtag)(nil),                    // 148: go_feed.TrendingHashtag
+	(*Poll)(nil),                               // 149: go_feed.Poll
+	(ReactionType)(0),                          // 150: go_feed.ReactionType
+	(*ReactionCount)(nil),                      // 151: go_feed.ReactionCount
+	(CommentSort)(0),                           // 152: go_feed.CommentSort
+	(*Comment)(nil),                            // 153: go_feed.Comment
+	(CommentPolicy)(0),                         // 154: go_feed.CommentPolicy
+	(*FollowSuggestion)(nil),                   // 155: go_feed.FollowSuggestion
+	(*Relationship)(nil),                       // 156: go_feed.Relationship
+	(ContentType)(0),                           // 157: go_feed.ContentType
+	(ReportStatus)(0),                          // 158: go_feed.ReportStatus
+	(*Report)(nil),                             // 159: go_feed.Report
 }
 var file_api_go_feed_request_and_response_proto_depIdxs = []int32{
-	44, // 0: go_feed.GetPostByIDResponse.post:type_name -> go_feed.Post
-	44, // 1: go_feed.GetPostOfAccountResponse.post_list:type_name -> go_feed.Post
-	44, // 2: go_feed.UpdatePostRequest.post:type_name -> go_feed.Post
-	45, // 3: go_feed.GetLikeAccountsOfPostResponse.account_list:type_name -> go_feed.Account
-	46, // 4: go_feed.GetCommentsOfPostResponse.comment_list:type_name -> go_feed.Comment
-	46, // 5: go_feed.UpdateCommentRequest.comment:type_name -> go_feed.Comment
-	45, // 6: go_feed.GetFollowersOfAccountResponse.follower_list:type_name -> go_feed.Account
-	45, // 7: go_feed.GetFollowingsOfAccountResponse.following_list:type_name -> go_feed.Account
-	44, // 8: go_feed.GetNewFeedsResponse.post_list:type_name -> go_feed.Post
-	9,  // [9:9] is the sub-list for method output_type
-	9,  // [9:9] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	141, // 0: go_feed.SearchAccountsResponse.account_list:type_name -> go_feed.Account
+	142, // 1: go_feed.CreatePostRequest.poll:type_name -> go_feed.NewPoll
+	143, // 2: go_feed.GetPostByIDResponse.post:type_name -> go_feed.Post
+	144, // 3: go_feed.GetPostOfAccountRequest.page:type_name -> go_feed.PageRequest
+	143, // 4: go_feed.GetPostOfAccountResponse.post_list:type_name -> go_feed.Post
+	145, // 5: go_feed.GetPostOfAccountResponse.page:type_name -> go_feed.PageResponse
+	143, // 6: go_feed.UpdatePostRequest.post:type_name -> go_feed.Post
+	143, // 7: go_feed.GetPostsByHashtagResponse.post_list:type_name -> go_feed.Post
+	143, // 8: go_feed.GetPostsMentioningAccountResponse.post_list:type_name -> go_feed.Post
+	146, // 9: go_feed.CreateDraftRequest.publish_at:type_name -> google.protobuf.Timestamp
+	143, // 10: go_feed.ListDraftsResponse.post_list:type_name -> go_feed.Post
+	146, // 11: go_feed.UpdateDraftRequest.publish_at:type_name -> google.protobuf.Timestamp
+	146, // 12: go_feed.PublishDraftRequest.publish_at:type_name -> google.protobuf.Timestamp
+	143, // 13: go_feed.ListDeletedPostsResponse.post_list:type_name -> go_feed.Post
+	143, // 14: go_feed.ListBookmarksResponse.post_list:type_name -> go_feed.Post
+	147, // 15: go_feed.GetTrendingHashtagsRequest.window:type_name -> go_feed.TrendingWindow
+	148, // 16: go_feed.GetTrendingHashtagsResponse.hashtag_list:type_name -> go_feed.TrendingHashtag
+	147, // 17: go_feed.GetTrendingPostsRequest.window:type_name -> go_feed.TrendingWindow
+	143, // 18: go_feed.GetTrendingPostsResponse.post_list:type_name -> go_feed.Post
+	149, // 19: go_feed.VotePollResponse.poll:type_name -> go_feed.Poll
+	149, // 20: go_feed.GetPollResultsResponse.poll:type_name -> go_feed.Poll
+	143, // 21: go_feed.SearchPostsResponse.post_list:type_name -> go_feed.Post
+	150, // 22: go_feed.SetReactionRequest.reaction_type:type_name -> go_feed.ReactionType
+	151, // 23: go_feed.GetLikeCountOfPostResponse.reaction_counts:type_name -> go_feed.ReactionCount
+	150, // 24: go_feed.GetLikeAccountsOfPostRequest.reaction_type:type_name -> go_feed.ReactionType
+	144, // 25: go_feed.GetLikeAccountsOfPostRequest.page:type_name -> go_feed.PageRequest
+	141, // 26: go_feed.GetLikeAccountsOfPostResponse.account_list:type_name -> go_feed.Account
+	145, // 27: go_feed.GetLikeAccountsOfPostResponse.page:type_name -> go_feed.PageResponse
+	140, // 28: go_feed.HasLikedPostsResponse.reaction_types:type_name -> go_feed.HasLikedPostsResponse.ReactionTypesEntry
+	150, // 29: go_feed.GetLikedPostsOfAccountRequest.reaction_type:type_name -> go_feed.ReactionType
+	143, // 30: go_feed.GetLikedPostsOfAccountResponse.post_list:type_name -> go_feed.Post
+	152, // 31: go_feed.GetCommentsOfPostRequest.sort:type_name -> go_feed.CommentSort
+	153, // 32: go_feed.GetCommentsOfPostResponse.comment_list:type_name -> go_feed.Comment
+	153, // 33: go_feed.GetCommentRepliesResponse.comment_list:type_name -> go_feed.Comment
+	153, // 34: go_feed.UpdateCommentRequest.comment:type_name -> go_feed.Comment
+	154, // 35: go_feed.SetCommentPolicyRequest.comment_policy:type_name -> go_feed.CommentPolicy
+	144, // 36: go_feed.GetFollowersOfAccountRequest.page:type_name -> go_feed.PageRequest
+	141, // 37: go_feed.GetFollowersOfAccountResponse.follower_list:type_name -> go_feed.Account
+	145, // 38: go_feed.GetFollowersOfAccountResponse.page:type_name -> go_feed.PageResponse
+	144, // 39: go_feed.GetFollowingsOfAccountRequest.page:type_name -> go_feed.PageRequest
+	141, // 40: go_feed.GetFollowingsOfAccountResponse.following_list:type_name -> go_feed.Account
+	145, // 41: go_feed.GetFollowingsOfAccountResponse.page:type_name -> go_feed.PageResponse
+	141, // 42: go_feed.ListFollowRequestsResponse.account_list:type_name -> go_feed.Account
+	155, // 43: go_feed.GetFollowSuggestionsResponse.suggestion_list:type_name -> go_feed.FollowSuggestion
+	156, // 44: go_feed.GetRelationshipsResponse.relationship_list:type_name -> go_feed.Relationship
+	141, // 45: go_feed.ListBlockedAccountsResponse.account_list:type_name -> go_feed.Account
+	141, // 46: go_feed.ListMutedAccountsResponse.account_list:type_name -> go_feed.Account
+	143, // 47: go_feed.GetNewFeedsResponse.post_list:type_name -> go_feed.Post
+	157, // 48: go_feed.ReportContentRequest.content_type:type_name -> go_feed.ContentType
+	158, // 49: go_feed.ListReportsRequest.status:type_name -> go_feed.ReportStatus
+	159, // 50: go_feed.ListReportsResponse.report_list:type_name -> go_feed.Report
+	158, // 51: go_feed.ResolveReportRequest.status:type_name -> go_feed.ReportStatus
+	157, // 52: go_feed.TakedownContentRequest.content_type:type_name -> go_feed.ContentType
+	150, // 53: go_feed.HasLikedPostsResponse.ReactionTypesEntry.value:type_name -> go_feed.ReactionType
+	54,  // [54:54] is the sub-list for method output_type
+	54,  // [54:54] is the sub-list for method input_type
+	54,  // [54:54] is the sub-list for extension type_name
+	54,  // [54:54] is the sub-list for extension extendee
+	0,   // [0:54] is the sub-list for field type_name
 }
 
 func init() { file_api_go_feed_request_and_response_proto_init() }
@@ -2222,7 +7420,7 @@ func file_api_go_feed_request_and_response_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_go_feed_request_and_response_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   44,
+			NumMessages:   141,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
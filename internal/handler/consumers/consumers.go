@@ -0,0 +1,72 @@
+package consumers
+
+import (
+	"context"
+	"encoding/json"
+
+	"go.uber.org/zap"
+
+	"GoFeed/internal/dataaccess/mq/consumer"
+	"GoFeed/internal/dataaccess/mq/producer"
+	"GoFeed/internal/utils"
+)
+
+type Root interface {
+	Start(ctx context.Context) error
+}
+
+type root struct {
+	linkPreviewJobHandler   LinkPreviewJob
+	trendingActivityHandler TrendingActivity
+	mqConsumer              consumer.Consumer
+	logger                  *zap.Logger
+}
+
+func NewRoot(
+	linkPreviewJobHandler LinkPreviewJob,
+	trendingActivityHandler TrendingActivity,
+	mqConsumer consumer.Consumer,
+	logger *zap.Logger,
+) Root {
+	return &root{
+		linkPreviewJobHandler:   linkPreviewJobHandler,
+		trendingActivityHandler: trendingActivityHandler,
+		mqConsumer:              mqConsumer,
+		logger:                  logger,
+	}
+}
+
+func (r root) Start(ctx context.Context) error {
+	r.mqConsumer.RegisterHandler(
+		producer.MessageQueueLinkPreviewJob,
+		func(ctx context.Context, queueName string, payload []byte) error {
+			logger := utils.LoggerWithContext(ctx, r.logger).With(zap.String("queue_name", queueName))
+
+			var event producer.LinkPreviewJob
+			if err := json.Unmarshal(payload, &event); err != nil {
+				// A malformed message will never succeed, skip it instead of blocking the queue.
+				logger.With(zap.Error(err)).Error("failed to unmarshal link preview job event")
+				return nil
+			}
+
+			return r.linkPreviewJobHandler.Handle(ctx, event)
+		},
+	)
+
+	r.mqConsumer.RegisterHandler(
+		producer.MessageQueueTrendingActivity,
+		func(ctx context.Context, queueName string, payload []byte) error {
+			logger := utils.LoggerWithContext(ctx, r.logger).With(zap.String("queue_name", queueName))
+
+			var event producer.TrendingActivity
+			if err := json.Unmarshal(payload, &event); err != nil {
+				logger.With(zap.Error(err)).Error("failed to unmarshal trending activity event")
+				return nil
+			}
+
+			return r.trendingActivityHandler.Handle(ctx, event)
+		},
+	)
+
+	return r.mqConsumer.Start(ctx)
+}
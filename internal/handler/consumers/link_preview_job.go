@@ -0,0 +1,26 @@
+package consumers
+
+import (
+	"context"
+
+	"GoFeed/internal/dataaccess/mq/producer"
+	"GoFeed/internal/logic"
+)
+
+type LinkPreviewJob interface {
+	Handle(ctx context.Context, event producer.LinkPreviewJob) error
+}
+
+type linkPreviewJob struct {
+	postLogic logic.PostLogic
+}
+
+func NewLinkPreviewJob(postLogic logic.PostLogic) LinkPreviewJob {
+	return &linkPreviewJob{
+		postLogic: postLogic,
+	}
+}
+
+func (l linkPreviewJob) Handle(ctx context.Context, event producer.LinkPreviewJob) error {
+	return l.postLogic.GenerateLinkPreview(ctx, event.PostID)
+}
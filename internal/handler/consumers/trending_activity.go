@@ -0,0 +1,26 @@
+package consumers
+
+import (
+	"context"
+
+	"GoFeed/internal/dataaccess/mq/producer"
+	"GoFeed/internal/logic"
+)
+
+type TrendingActivity interface {
+	Handle(ctx context.Context, event producer.TrendingActivity) error
+}
+
+type trendingActivity struct {
+	postLogic logic.PostLogic
+}
+
+func NewTrendingActivity(postLogic logic.PostLogic) TrendingActivity {
+	return &trendingActivity{
+		postLogic: postLogic,
+	}
+}
+
+func (t trendingActivity) Handle(ctx context.Context, event producer.TrendingActivity) error {
+	return t.postLogic.RecordTrendingActivity(ctx, event)
+}
@@ -4,9 +4,11 @@ import (
 	"GoFeed/internal/generated/api/go_feed"
 	"GoFeed/internal/logic"
 	"context"
+	"time"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
@@ -17,11 +19,13 @@ const (
 type grpcHandler struct {
 	go_feed.UnimplementedGoFeedServiceServer
 
-	accountLogic logic.AccountLogic
-	postLogic    logic.PostLogic
-	commentLogic logic.CommentLogic
-	followLogic  logic.FollowLogic
-	likeLogic    logic.LikeLogic
+	accountLogic    logic.AccountLogic
+	postLogic       logic.PostLogic
+	commentLogic    logic.CommentLogic
+	followLogic     logic.FollowLogic
+	likeLogic       logic.LikeLogic
+	blockLogic      logic.BlockLogic
+	moderationLogic logic.ModerationLogic
 }
 
 func NewHandler(
@@ -30,13 +34,17 @@ func NewHandler(
 	commentLogic logic.CommentLogic,
 	followLogic logic.FollowLogic,
 	likeLogic logic.LikeLogic,
+	blockLogic logic.BlockLogic,
+	moderationLogic logic.ModerationLogic,
 ) go_feed.GoFeedServiceServer {
 	return &grpcHandler{
-		accountLogic: accountLogic,
-		postLogic:    postLogic,
-		commentLogic: commentLogic,
-		followLogic:  followLogic,
-		likeLogic:    likeLogic,
+		accountLogic:    accountLogic,
+		postLogic:       postLogic,
+		commentLogic:    commentLogic,
+		followLogic:     followLogic,
+		likeLogic:       likeLogic,
+		blockLogic:      blockLogic,
+		moderationLogic: moderationLogic,
 	}
 }
 
@@ -54,6 +62,24 @@ func (g grpcHandler) getAuthTokenMetadata(ctx context.Context) string {
 	return metadataValues[0]
 }
 
+func (g grpcHandler) timestampToTime(timestamp *timestamppb.Timestamp) time.Time {
+	if timestamp == nil {
+		return time.Time{}
+	}
+	return timestamp.AsTime()
+}
+
+func (g grpcHandler) newPollToNewPollParams(poll *go_feed.NewPoll) *logic.NewPollParams {
+	if poll == nil {
+		return nil
+	}
+	return &logic.NewPollParams{
+		Options:        poll.GetOptions(),
+		ClosesAt:       g.timestampToTime(poll.GetClosesAt()),
+		MultipleChoice: poll.GetMultipleChoice(),
+	}
+}
+
 func (g grpcHandler) CreateAccount(ctx context.Context, request *go_feed.CreateAccountRequest) (*go_feed.CreateAccountResponse, error) {
 	output, err := g.accountLogic.CreateAccount(ctx, logic.CreateAccountParams{
 		AccountName: request.GetAccountName(),
@@ -85,11 +111,51 @@ func (g grpcHandler) CreateSession(ctx context.Context, request *go_feed.CreateS
 		AccountId: output.Account.Id,
 	}, nil
 }
+func (g grpcHandler) SearchAccounts(ctx context.Context, request *go_feed.SearchAccountsRequest) (*go_feed.SearchAccountsResponse, error) {
+	output, err := g.accountLogic.SearchAccounts(ctx, logic.SearchAccountsParams{
+		Token:  g.getAuthTokenMetadata(ctx),
+		Query:  request.GetQuery(),
+		Offset: request.GetOffset(),
+		Limit:  request.GetLimit(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_feed.SearchAccountsResponse{
+		AccountList: output.AccountList,
+	}, nil
+}
+
+func (g grpcHandler) SetLikesHidden(ctx context.Context, request *go_feed.SetLikesHiddenRequest) (*go_feed.SetLikesHiddenResponse, error) {
+	err := g.accountLogic.SetLikesHidden(ctx, logic.SetLikesHiddenParams{
+		Token:       g.getAuthTokenMetadata(ctx),
+		LikesHidden: request.GetLikesHidden(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_feed.SetLikesHiddenResponse{}, nil
+}
+
+func (g grpcHandler) SetAccountPrivate(ctx context.Context, request *go_feed.SetAccountPrivateRequest) (*go_feed.SetAccountPrivateResponse, error) {
+	err := g.accountLogic.SetAccountPrivate(ctx, logic.SetAccountPrivateParams{
+		Token:     g.getAuthTokenMetadata(ctx),
+		IsPrivate: request.GetIsPrivate(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_feed.SetAccountPrivateResponse{}, nil
+}
 
 func (g grpcHandler) CreatePost(ctx context.Context, request *go_feed.CreatePostRequest) (*go_feed.CreatePostResponse, error) {
 	output, err := g.postLogic.CreatePost(ctx, logic.CreatePostParams{
 		Token:   g.getAuthTokenMetadata(ctx),
 		Content: request.GetContent(),
+		Poll:    g.newPollToNewPollParams(request.GetPoll()),
 	})
 	if err != nil {
 		return nil, err
@@ -116,6 +182,8 @@ func (g grpcHandler) GetPostOfAccount(ctx context.Context, request *go_feed.GetP
 	output, err := g.postLogic.GetPostOfAccount(ctx, logic.GetPostOfAccountParams{
 		Token:      g.getAuthTokenMetadata(ctx),
 		Of_account: request.GetAccountId(),
+		Cursor:     request.GetPage().GetCursor(),
+		Limit:      request.GetPage().GetPageSize(),
 	})
 	if err != nil {
 		return nil, err
@@ -123,6 +191,7 @@ func (g grpcHandler) GetPostOfAccount(ctx context.Context, request *go_feed.GetP
 
 	return &go_feed.GetPostOfAccountResponse{
 		PostList: output.PostList,
+		Page:     &go_feed.PageResponse{NextCursor: output.NextCursor},
 	}, nil
 }
 func (g grpcHandler) UpdatePost(ctx context.Context, request *go_feed.UpdatePostRequest) (*go_feed.UpdatePostResponse, error) {
@@ -137,6 +206,250 @@ func (g grpcHandler) UpdatePost(ctx context.Context, request *go_feed.UpdatePost
 
 	return &go_feed.UpdatePostResponse{}, nil
 }
+func (g grpcHandler) DeletePost(ctx context.Context, request *go_feed.DeletePostRequest) (*go_feed.DeletePostResponse, error) {
+	err := g.postLogic.DeletePost(ctx, logic.DeletePostParams{
+		Token: g.getAuthTokenMetadata(ctx),
+		ID:    request.GetPostId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_feed.DeletePostResponse{}, nil
+}
+func (g grpcHandler) RestorePost(ctx context.Context, request *go_feed.RestorePostRequest) (*go_feed.RestorePostResponse, error) {
+	err := g.postLogic.RestorePost(ctx, logic.RestorePostParams{
+		Token: g.getAuthTokenMetadata(ctx),
+		ID:    request.GetPostId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_feed.RestorePostResponse{}, nil
+}
+func (g grpcHandler) ListDeletedPosts(ctx context.Context, request *go_feed.ListDeletedPostsRequest) (*go_feed.ListDeletedPostsResponse, error) {
+	output, err := g.postLogic.ListDeletedPosts(ctx, logic.ListDeletedPostsParams{
+		Token: g.getAuthTokenMetadata(ctx),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_feed.ListDeletedPostsResponse{
+		PostList: output.PostList,
+	}, nil
+}
+func (g grpcHandler) GetPostsByHashtag(ctx context.Context, request *go_feed.GetPostsByHashtagRequest) (*go_feed.GetPostsByHashtagResponse, error) {
+	output, err := g.postLogic.GetPostsByHashtag(ctx, logic.GetPostsByHashtagParams{
+		Token:   g.getAuthTokenMetadata(ctx),
+		Hashtag: request.GetHashtag(),
+		Offset:  request.GetOffset(),
+		Limit:   request.GetLimit(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_feed.GetPostsByHashtagResponse{
+		PostList: output.PostList,
+	}, nil
+}
+func (g grpcHandler) GetPostsMentioningAccount(ctx context.Context, request *go_feed.GetPostsMentioningAccountRequest) (*go_feed.GetPostsMentioningAccountResponse, error) {
+	output, err := g.postLogic.GetPostsMentioningAccount(ctx, logic.GetPostsMentioningAccountParams{
+		Token:     g.getAuthTokenMetadata(ctx),
+		AccountID: request.GetAccountId(),
+		Offset:    request.GetOffset(),
+		Limit:     request.GetLimit(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_feed.GetPostsMentioningAccountResponse{
+		PostList: output.PostList,
+	}, nil
+}
+func (g grpcHandler) SearchPosts(ctx context.Context, request *go_feed.SearchPostsRequest) (*go_feed.SearchPostsResponse, error) {
+	output, err := g.postLogic.SearchPosts(ctx, logic.SearchPostsParams{
+		Token:  g.getAuthTokenMetadata(ctx),
+		Query:  request.GetQuery(),
+		Offset: request.GetOffset(),
+		Limit:  request.GetLimit(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_feed.SearchPostsResponse{
+		PostList: output.PostList,
+	}, nil
+}
+func (g grpcHandler) CreateDraft(ctx context.Context, request *go_feed.CreateDraftRequest) (*go_feed.CreateDraftResponse, error) {
+	output, err := g.postLogic.CreateDraft(ctx, logic.CreateDraftParams{
+		Token:     g.getAuthTokenMetadata(ctx),
+		Content:   request.GetContent(),
+		PublishAt: g.timestampToTime(request.GetPublishAt()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_feed.CreateDraftResponse{
+		PostId: output.ID,
+	}, nil
+}
+func (g grpcHandler) ListDrafts(ctx context.Context, request *go_feed.ListDraftsRequest) (*go_feed.ListDraftsResponse, error) {
+	output, err := g.postLogic.ListDrafts(ctx, logic.ListDraftsParams{
+		Token: g.getAuthTokenMetadata(ctx),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_feed.ListDraftsResponse{
+		PostList: output.PostList,
+	}, nil
+}
+func (g grpcHandler) UpdateDraft(ctx context.Context, request *go_feed.UpdateDraftRequest) (*go_feed.UpdateDraftResponse, error) {
+	err := g.postLogic.UpdateDraft(ctx, logic.UpdateDraftParams{
+		Token:     g.getAuthTokenMetadata(ctx),
+		ID:        request.GetPostId(),
+		Content:   request.GetContent(),
+		PublishAt: g.timestampToTime(request.GetPublishAt()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_feed.UpdateDraftResponse{}, nil
+}
+func (g grpcHandler) PublishDraft(ctx context.Context, request *go_feed.PublishDraftRequest) (*go_feed.PublishDraftResponse, error) {
+	err := g.postLogic.PublishDraft(ctx, logic.PublishDraftParams{
+		Token:     g.getAuthTokenMetadata(ctx),
+		ID:        request.GetPostId(),
+		PublishAt: g.timestampToTime(request.GetPublishAt()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_feed.PublishDraftResponse{}, nil
+}
+func (g grpcHandler) PinPost(ctx context.Context, request *go_feed.PinPostRequest) (*go_feed.PinPostResponse, error) {
+	err := g.postLogic.PinPost(ctx, logic.PinPostParams{
+		Token: g.getAuthTokenMetadata(ctx),
+		ID:    request.GetPostId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_feed.PinPostResponse{}, nil
+}
+func (g grpcHandler) UnpinPost(ctx context.Context, request *go_feed.UnpinPostRequest) (*go_feed.UnpinPostResponse, error) {
+	err := g.postLogic.UnpinPost(ctx, logic.UnpinPostParams{
+		Token: g.getAuthTokenMetadata(ctx),
+		ID:    request.GetPostId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_feed.UnpinPostResponse{}, nil
+}
+func (g grpcHandler) CreateBookmark(ctx context.Context, request *go_feed.CreateBookmarkRequest) (*go_feed.CreateBookmarkResponse, error) {
+	err := g.postLogic.CreateBookmark(ctx, logic.CreateBookmarkParams{
+		Token:  g.getAuthTokenMetadata(ctx),
+		PostID: request.GetPostId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_feed.CreateBookmarkResponse{}, nil
+}
+func (g grpcHandler) DeleteBookmark(ctx context.Context, request *go_feed.DeleteBookmarkRequest) (*go_feed.DeleteBookmarkResponse, error) {
+	err := g.postLogic.DeleteBookmark(ctx, logic.DeleteBookmarkParams{
+		Token:  g.getAuthTokenMetadata(ctx),
+		PostID: request.GetPostId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_feed.DeleteBookmarkResponse{}, nil
+}
+func (g grpcHandler) ListBookmarks(ctx context.Context, request *go_feed.ListBookmarksRequest) (*go_feed.ListBookmarksResponse, error) {
+	output, err := g.postLogic.ListBookmarks(ctx, logic.ListBookmarksParams{
+		Token:  g.getAuthTokenMetadata(ctx),
+		Cursor: request.GetCursor(),
+		Limit:  request.GetLimit(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_feed.ListBookmarksResponse{
+		PostList:   output.PostList,
+		NextCursor: output.NextCursor,
+	}, nil
+}
+func (g grpcHandler) GetTrendingHashtags(ctx context.Context, request *go_feed.GetTrendingHashtagsRequest) (*go_feed.GetTrendingHashtagsResponse, error) {
+	output, err := g.postLogic.GetTrendingHashtags(ctx, logic.GetTrendingHashtagsParams{
+		Token:  g.getAuthTokenMetadata(ctx),
+		Window: request.GetWindow(),
+		Limit:  request.GetLimit(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_feed.GetTrendingHashtagsResponse{
+		HashtagList: output.HashtagList,
+	}, nil
+}
+func (g grpcHandler) GetTrendingPosts(ctx context.Context, request *go_feed.GetTrendingPostsRequest) (*go_feed.GetTrendingPostsResponse, error) {
+	output, err := g.postLogic.GetTrendingPosts(ctx, logic.GetTrendingPostsParams{
+		Token:  g.getAuthTokenMetadata(ctx),
+		Window: request.GetWindow(),
+		Limit:  request.GetLimit(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_feed.GetTrendingPostsResponse{
+		PostList: output.PostList,
+	}, nil
+}
+func (g grpcHandler) VotePoll(ctx context.Context, request *go_feed.VotePollRequest) (*go_feed.VotePollResponse, error) {
+	output, err := g.postLogic.VotePoll(ctx, logic.VotePollParams{
+		Token:     g.getAuthTokenMetadata(ctx),
+		PollID:    request.GetPollId(),
+		OptionIDs: request.GetOptionIds(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_feed.VotePollResponse{
+		Poll: output.Poll,
+	}, nil
+}
+func (g grpcHandler) GetPollResults(ctx context.Context, request *go_feed.GetPollResultsRequest) (*go_feed.GetPollResultsResponse, error) {
+	output, err := g.postLogic.GetPollResults(ctx, logic.GetPollResultsParams{
+		Token:  g.getAuthTokenMetadata(ctx),
+		PollID: request.GetPollId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_feed.GetPollResultsResponse{
+		Poll: output.Poll,
+	}, nil
+}
 
 func (g grpcHandler) CreateLike(ctx context.Context, request *go_feed.CreateLikeRequest) (*go_feed.CreateLikeResponse, error) {
 	err := g.likeLogic.CreateLike(ctx, logic.CreateLikeParams{
@@ -149,6 +462,18 @@ func (g grpcHandler) CreateLike(ctx context.Context, request *go_feed.CreateLike
 
 	return &go_feed.CreateLikeResponse{}, nil
 }
+func (g grpcHandler) SetReaction(ctx context.Context, request *go_feed.SetReactionRequest) (*go_feed.SetReactionResponse, error) {
+	err := g.likeLogic.SetReaction(ctx, logic.SetReactionParams{
+		Token:        g.getAuthTokenMetadata(ctx),
+		PostID:       request.GetPostId(),
+		ReactionType: request.GetReactionType(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_feed.SetReactionResponse{}, nil
+}
 func (g grpcHandler) GetLikeCountOfPost(ctx context.Context, request *go_feed.GetLikeCountOfPostRequest) (*go_feed.GetLikeCountOfPostResponse, error) {
 	output, err := g.likeLogic.GetLikeCountOfPost(ctx, logic.GetLikeCountOfPostParams{
 		Token:  g.getAuthTokenMetadata(ctx),
@@ -159,13 +484,17 @@ func (g grpcHandler) GetLikeCountOfPost(ctx context.Context, request *go_feed.Ge
 	}
 
 	return &go_feed.GetLikeCountOfPostResponse{
-		LikeCount: uint64(output.LikeCount),
+		LikeCount:      uint64(output.LikeCount),
+		ReactionCounts: output.ReactionCounts,
 	}, nil
 }
 func (g grpcHandler) GetLikeAccountsOfPost(ctx context.Context, request *go_feed.GetLikeAccountsOfPostRequest) (*go_feed.GetLikeAccountsOfPostResponse, error) {
 	output, err := g.likeLogic.GetLikeAccountsOfPost(ctx, logic.GetLikeAccountsOfPostParams{
-		Token:  g.getAuthTokenMetadata(ctx),
-		PostID: request.GetPostId(),
+		Token:        g.getAuthTokenMetadata(ctx),
+		PostID:       request.GetPostId(),
+		ReactionType: request.GetReactionType(),
+		Cursor:       request.GetPage().GetCursor(),
+		Limit:        request.GetPage().GetPageSize(),
 	})
 	if err != nil {
 		return nil, err
@@ -173,6 +502,7 @@ func (g grpcHandler) GetLikeAccountsOfPost(ctx context.Context, request *go_feed
 
 	return &go_feed.GetLikeAccountsOfPostResponse{
 		AccountList: output.AccountList,
+		Page:        &go_feed.PageResponse{NextCursor: output.NextCursor},
 	}, nil
 }
 func (g grpcHandler) DeleteLike(ctx context.Context, request *go_feed.DeleteLikeRequest) (*go_feed.DeleteLikeResponse, error) {
@@ -186,12 +516,90 @@ func (g grpcHandler) DeleteLike(ctx context.Context, request *go_feed.DeleteLike
 
 	return &go_feed.DeleteLikeResponse{}, nil
 }
+func (g grpcHandler) DeleteReaction(ctx context.Context, request *go_feed.DeleteReactionRequest) (*go_feed.DeleteReactionResponse, error) {
+	err := g.likeLogic.DeleteReaction(ctx, logic.DeleteReactionParams{
+		Token:  g.getAuthTokenMetadata(ctx),
+		PostID: request.GetPostId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_feed.DeleteReactionResponse{}, nil
+}
+func (g grpcHandler) HasLikedPosts(ctx context.Context, request *go_feed.HasLikedPostsRequest) (*go_feed.HasLikedPostsResponse, error) {
+	output, err := g.likeLogic.HasLikedPosts(ctx, logic.HasLikedPostsParams{
+		Token:   g.getAuthTokenMetadata(ctx),
+		PostIDs: request.GetPostIds(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_feed.HasLikedPostsResponse{
+		LikedPostIds:  output.LikedPostIDs,
+		ReactionTypes: output.ReactionTypes,
+	}, nil
+}
+func (g grpcHandler) GetLikedPostsOfAccount(ctx context.Context, request *go_feed.GetLikedPostsOfAccountRequest) (*go_feed.GetLikedPostsOfAccountResponse, error) {
+	output, err := g.postLogic.GetLikedPostsOfAccount(ctx, logic.GetLikedPostsOfAccountParams{
+		Token:        g.getAuthTokenMetadata(ctx),
+		AccountID:    request.GetAccountId(),
+		ReactionType: request.GetReactionType(),
+		Cursor:       request.GetCursor(),
+		Limit:        request.GetLimit(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_feed.GetLikedPostsOfAccountResponse{
+		PostList:   output.PostList,
+		NextCursor: output.NextCursor,
+	}, nil
+}
+func (g grpcHandler) CreateCommentLike(ctx context.Context, request *go_feed.CreateCommentLikeRequest) (*go_feed.CreateCommentLikeResponse, error) {
+	err := g.likeLogic.CreateCommentLike(ctx, logic.CreateCommentLikeParams{
+		Token:     g.getAuthTokenMetadata(ctx),
+		CommentID: request.GetCommentId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_feed.CreateCommentLikeResponse{}, nil
+}
+func (g grpcHandler) GetLikeCountOfComment(ctx context.Context, request *go_feed.GetLikeCountOfCommentRequest) (*go_feed.GetLikeCountOfCommentResponse, error) {
+	output, err := g.likeLogic.GetLikeCountOfComment(ctx, logic.GetLikeCountOfCommentParams{
+		Token:     g.getAuthTokenMetadata(ctx),
+		CommentID: request.GetCommentId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_feed.GetLikeCountOfCommentResponse{
+		LikeCount: output.LikeCount,
+	}, nil
+}
+func (g grpcHandler) DeleteCommentLike(ctx context.Context, request *go_feed.DeleteCommentLikeRequest) (*go_feed.DeleteCommentLikeResponse, error) {
+	err := g.likeLogic.DeleteCommentLike(ctx, logic.DeleteCommentLikeParams{
+		Token:     g.getAuthTokenMetadata(ctx),
+		CommentID: request.GetCommentId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_feed.DeleteCommentLikeResponse{}, nil
+}
 
 func (g grpcHandler) CreateComment(ctx context.Context, request *go_feed.CreateCommentRequest) (*go_feed.CreateCommentResponse, error) {
 	output, err := g.commentLogic.CreateComment(ctx, logic.CreateCommentParams{
-		Token:   g.getAuthTokenMetadata(ctx),
-		PostID:  request.GetPostId(),
-		Content: request.GetContent(),
+		Token:           g.getAuthTokenMetadata(ctx),
+		PostID:          request.GetPostId(),
+		ParentCommentID: request.GetParentCommentId(),
+		Content:         request.GetContent(),
 	})
 	if err != nil {
 		return nil, err
@@ -216,8 +624,12 @@ func (g grpcHandler) GetCommentCountOfPost(ctx context.Context, request *go_feed
 }
 func (g grpcHandler) GetCommentsOfPost(ctx context.Context, request *go_feed.GetCommentsOfPostRequest) (*go_feed.GetCommentsOfPostResponse, error) {
 	output, err := g.commentLogic.GetCommentsOfPost(ctx, logic.GetCommentsOfPostParams{
-		Token:  g.getAuthTokenMetadata(ctx),
-		PostID: request.GetPostId(),
+		Token:    g.getAuthTokenMetadata(ctx),
+		PostID:   request.GetPostId(),
+		Threaded: request.GetThreaded(),
+		Sort:     request.GetSort(),
+		Cursor:   request.GetCursor(),
+		PageSize: request.GetPageSize(),
 	})
 	if err != nil {
 		return nil, err
@@ -225,6 +637,23 @@ func (g grpcHandler) GetCommentsOfPost(ctx context.Context, request *go_feed.Get
 
 	return &go_feed.GetCommentsOfPostResponse{
 		CommentList: output.CommentList,
+		NextCursor:  output.NextCursor,
+	}, nil
+}
+func (g grpcHandler) GetCommentReplies(ctx context.Context, request *go_feed.GetCommentRepliesRequest) (*go_feed.GetCommentRepliesResponse, error) {
+	output, err := g.commentLogic.GetCommentReplies(ctx, logic.GetCommentRepliesParams{
+		Token:     g.getAuthTokenMetadata(ctx),
+		CommentID: request.GetCommentId(),
+		Cursor:    request.GetCursor(),
+		Limit:     request.GetLimit(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_feed.GetCommentRepliesResponse{
+		CommentList: output.CommentList,
+		NextCursor:  output.NextCursor,
 	}, nil
 }
 func (g grpcHandler) UpdateComment(ctx context.Context, request *go_feed.UpdateCommentRequest) (*go_feed.UpdateCommentResponse, error) {
@@ -253,8 +682,45 @@ func (g grpcHandler) DeleteComment(ctx context.Context, request *go_feed.DeleteC
 	return &go_feed.DeleteCommentResponse{}, nil
 }
 
+func (g grpcHandler) HideComment(ctx context.Context, request *go_feed.HideCommentRequest) (*go_feed.HideCommentResponse, error) {
+	err := g.commentLogic.HideComment(ctx, logic.HideCommentParams{
+		Token: g.getAuthTokenMetadata(ctx),
+		ID:    request.GetCommentId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_feed.HideCommentResponse{}, nil
+}
+
+func (g grpcHandler) UnhideComment(ctx context.Context, request *go_feed.UnhideCommentRequest) (*go_feed.UnhideCommentResponse, error) {
+	err := g.commentLogic.UnhideComment(ctx, logic.UnhideCommentParams{
+		Token: g.getAuthTokenMetadata(ctx),
+		ID:    request.GetCommentId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_feed.UnhideCommentResponse{}, nil
+}
+
+func (g grpcHandler) SetCommentPolicy(ctx context.Context, request *go_feed.SetCommentPolicyRequest) (*go_feed.SetCommentPolicyResponse, error) {
+	err := g.commentLogic.SetCommentPolicy(ctx, logic.SetCommentPolicyParams{
+		Token:         g.getAuthTokenMetadata(ctx),
+		PostID:        request.GetPostId(),
+		CommentPolicy: request.GetCommentPolicy(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_feed.SetCommentPolicyResponse{}, nil
+}
+
 func (g grpcHandler) CreateFollow(ctx context.Context, request *go_feed.CreateFollowRequest) (*go_feed.CreateFollowResponse, error) {
-	err := g.followLogic.CreateFollow(ctx, logic.CreateFollowParams{
+	output, err := g.followLogic.CreateFollow(ctx, logic.CreateFollowParams{
 		Token:       g.getAuthTokenMetadata(ctx),
 		FollowingID: request.GetFollowingId(),
 	})
@@ -262,7 +728,9 @@ func (g grpcHandler) CreateFollow(ctx context.Context, request *go_feed.CreateFo
 		return nil, err
 	}
 
-	return &go_feed.CreateFollowResponse{}, nil
+	return &go_feed.CreateFollowResponse{
+		Pending: output.Pending,
+	}, nil
 }
 func (g grpcHandler) GetFollowerCountOfAccount(ctx context.Context, request *go_feed.GetFollowerCountOfAccountRequest) (*go_feed.GetFollowerCountOfAccountResponse, error) {
 	output, err := g.followLogic.GetFollowerCountOfAccount(ctx, logic.GetFollowerCountOfAccountParams{
@@ -281,6 +749,8 @@ func (g grpcHandler) GetFollowersOfAccount(ctx context.Context, request *go_feed
 	output, err := g.followLogic.GetFollowersOfAccount(ctx, logic.GetFollowersOfAccountParams{
 		Token:     g.getAuthTokenMetadata(ctx),
 		AccountID: request.GetAccountId(),
+		Cursor:    request.GetPage().GetCursor(),
+		Limit:     request.GetPage().GetPageSize(),
 	})
 	if err != nil {
 		return nil, err
@@ -288,6 +758,7 @@ func (g grpcHandler) GetFollowersOfAccount(ctx context.Context, request *go_feed
 
 	return &go_feed.GetFollowersOfAccountResponse{
 		FollowerList: output.FollowerList,
+		Page:         &go_feed.PageResponse{NextCursor: output.NextCursor},
 	}, nil
 }
 func (g grpcHandler) GetFollowingCountOfAccount(ctx context.Context, request *go_feed.GetFollowingCountOfAccountRequest) (*go_feed.GetFollowingCountOfAccountResponse, error) {
@@ -307,6 +778,8 @@ func (g grpcHandler) GetFollowingsOfAccount(ctx context.Context, request *go_fee
 	output, err := g.followLogic.GetFollowingsOfAccount(ctx, logic.GetFollowingsOfAccountParams{
 		Token:     g.getAuthTokenMetadata(ctx),
 		AccountID: request.GetAccountId(),
+		Cursor:    request.GetPage().GetCursor(),
+		Limit:     request.GetPage().GetPageSize(),
 	})
 	if err != nil {
 		return nil, err
@@ -314,6 +787,7 @@ func (g grpcHandler) GetFollowingsOfAccount(ctx context.Context, request *go_fee
 
 	return &go_feed.GetFollowingsOfAccountResponse{
 		FollowingList: output.FollowingList,
+		Page:          &go_feed.PageResponse{NextCursor: output.NextCursor},
 	}, nil
 }
 func (g grpcHandler) DeleteFollow(ctx context.Context, request *go_feed.DeleteFollowRequest) (*go_feed.DeleteFollowResponse, error) {
@@ -327,5 +801,199 @@ func (g grpcHandler) DeleteFollow(ctx context.Context, request *go_feed.DeleteFo
 
 	return &go_feed.DeleteFollowResponse{}, nil
 }
+func (g grpcHandler) ListFollowRequests(ctx context.Context, request *go_feed.ListFollowRequestsRequest) (*go_feed.ListFollowRequestsResponse, error) {
+	output, err := g.followLogic.ListFollowRequests(ctx, logic.ListFollowRequestsParams{
+		Token:  g.getAuthTokenMetadata(ctx),
+		Cursor: request.GetCursor(),
+		Limit:  request.GetLimit(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_feed.ListFollowRequestsResponse{
+		AccountList: output.AccountList,
+		NextCursor:  output.NextCursor,
+	}, nil
+}
+func (g grpcHandler) AcceptFollowRequest(ctx context.Context, request *go_feed.AcceptFollowRequestRequest) (*go_feed.AcceptFollowRequestResponse, error) {
+	err := g.followLogic.AcceptFollowRequest(ctx, logic.AcceptFollowRequestParams{
+		Token:     g.getAuthTokenMetadata(ctx),
+		AccountID: request.GetAccountId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_feed.AcceptFollowRequestResponse{}, nil
+}
+func (g grpcHandler) RejectFollowRequest(ctx context.Context, request *go_feed.RejectFollowRequestRequest) (*go_feed.RejectFollowRequestResponse, error) {
+	err := g.followLogic.RejectFollowRequest(ctx, logic.RejectFollowRequestParams{
+		Token:     g.getAuthTokenMetadata(ctx),
+		AccountID: request.GetAccountId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_feed.RejectFollowRequestResponse{}, nil
+}
+func (g grpcHandler) GetFollowSuggestions(ctx context.Context, request *go_feed.GetFollowSuggestionsRequest) (*go_feed.GetFollowSuggestionsResponse, error) {
+	output, err := g.followLogic.GetFollowSuggestions(ctx, logic.GetFollowSuggestionsParams{
+		Token: g.getAuthTokenMetadata(ctx),
+		Limit: request.GetLimit(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_feed.GetFollowSuggestionsResponse{
+		SuggestionList: output.SuggestionList,
+	}, nil
+}
+func (g grpcHandler) GetRelationships(ctx context.Context, request *go_feed.GetRelationshipsRequest) (*go_feed.GetRelationshipsResponse, error) {
+	output, err := g.followLogic.GetRelationships(ctx, logic.GetRelationshipsParams{
+		Token:      g.getAuthTokenMetadata(ctx),
+		AccountIDs: request.GetAccountIds(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_feed.GetRelationshipsResponse{
+		RelationshipList: output.RelationshipList,
+	}, nil
+}
+func (g grpcHandler) BlockAccount(ctx context.Context, request *go_feed.BlockAccountRequest) (*go_feed.BlockAccountResponse, error) {
+	err := g.blockLogic.BlockAccount(ctx, logic.BlockAccountParams{
+		Token:     g.getAuthTokenMetadata(ctx),
+		AccountID: request.GetAccountId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_feed.BlockAccountResponse{}, nil
+}
+func (g grpcHandler) UnblockAccount(ctx context.Context, request *go_feed.UnblockAccountRequest) (*go_feed.UnblockAccountResponse, error) {
+	err := g.blockLogic.UnblockAccount(ctx, logic.UnblockAccountParams{
+		Token:     g.getAuthTokenMetadata(ctx),
+		AccountID: request.GetAccountId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_feed.UnblockAccountResponse{}, nil
+}
+func (g grpcHandler) ListBlockedAccounts(ctx context.Context, request *go_feed.ListBlockedAccountsRequest) (*go_feed.ListBlockedAccountsResponse, error) {
+	output, err := g.blockLogic.ListBlockedAccounts(ctx, logic.ListBlockedAccountsParams{
+		Token:  g.getAuthTokenMetadata(ctx),
+		Cursor: request.GetCursor(),
+		Limit:  request.GetLimit(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_feed.ListBlockedAccountsResponse{
+		AccountList: output.AccountList,
+		NextCursor:  output.NextCursor,
+	}, nil
+}
+func (g grpcHandler) MuteAccount(ctx context.Context, request *go_feed.MuteAccountRequest) (*go_feed.MuteAccountResponse, error) {
+	err := g.blockLogic.MuteAccount(ctx, logic.MuteAccountParams{
+		Token:     g.getAuthTokenMetadata(ctx),
+		AccountID: request.GetAccountId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_feed.MuteAccountResponse{}, nil
+}
+func (g grpcHandler) UnmuteAccount(ctx context.Context, request *go_feed.UnmuteAccountRequest) (*go_feed.UnmuteAccountResponse, error) {
+	err := g.blockLogic.UnmuteAccount(ctx, logic.UnmuteAccountParams{
+		Token:     g.getAuthTokenMetadata(ctx),
+		AccountID: request.GetAccountId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_feed.UnmuteAccountResponse{}, nil
+}
+func (g grpcHandler) ListMutedAccounts(ctx context.Context, request *go_feed.ListMutedAccountsRequest) (*go_feed.ListMutedAccountsResponse, error) {
+	output, err := g.blockLogic.ListMutedAccounts(ctx, logic.ListMutedAccountsParams{
+		Token:  g.getAuthTokenMetadata(ctx),
+		Cursor: request.GetCursor(),
+		Limit:  request.GetLimit(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_feed.ListMutedAccountsResponse{
+		AccountList: output.AccountList,
+		NextCursor:  output.NextCursor,
+	}, nil
+}
 
 // func (g grpcHandler) GetNewFeeds(ctx context.Context, request *go_feed.GetNewFeedsRequest) (*go_feed.GetNewFeedsResponse, error)
+
+func (g grpcHandler) ReportContent(ctx context.Context, request *go_feed.ReportContentRequest) (*go_feed.ReportContentResponse, error) {
+	output, err := g.moderationLogic.ReportContent(ctx, logic.ReportContentParams{
+		Token:       g.getAuthTokenMetadata(ctx),
+		ContentType: request.GetContentType(),
+		ContentID:   request.GetContentId(),
+		Reason:      request.GetReason(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_feed.ReportContentResponse{
+		ReportId: output.ReportID,
+	}, nil
+}
+func (g grpcHandler) ListReports(ctx context.Context, request *go_feed.ListReportsRequest) (*go_feed.ListReportsResponse, error) {
+	output, err := g.moderationLogic.ListReports(ctx, logic.ListReportsParams{
+		Token:  g.getAuthTokenMetadata(ctx),
+		Status: request.GetStatus(),
+		Offset: request.GetOffset(),
+		Limit:  request.GetLimit(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_feed.ListReportsResponse{
+		ReportList: output.ReportList,
+	}, nil
+}
+func (g grpcHandler) ResolveReport(ctx context.Context, request *go_feed.ResolveReportRequest) (*go_feed.ResolveReportResponse, error) {
+	err := g.moderationLogic.ResolveReport(ctx, logic.ResolveReportParams{
+		Token:    g.getAuthTokenMetadata(ctx),
+		ReportID: request.GetReportId(),
+		Status:   request.GetStatus(),
+		Note:     request.GetNote(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_feed.ResolveReportResponse{}, nil
+}
+func (g grpcHandler) TakedownContent(ctx context.Context, request *go_feed.TakedownContentRequest) (*go_feed.TakedownContentResponse, error) {
+	err := g.moderationLogic.TakedownContent(ctx, logic.TakedownContentParams{
+		Token:       g.getAuthTokenMetadata(ctx),
+		ContentType: request.GetContentType(),
+		ContentID:   request.GetContentId(),
+		Reason:      request.GetReason(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_feed.TakedownContentResponse{}, nil
+}
@@ -6,6 +6,7 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"strconv"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
@@ -121,3 +122,124 @@ func (h accountHandler) CreateSession(w http.ResponseWriter, r *http.Request) {
 		"token":     token[0],
 	})
 }
+
+func (h accountHandler) SearchAccounts(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		WriteError(w, http.StatusMethodNotAllowed, "Method not allowed, expected GET")
+		return
+	}
+
+	query := r.URL.Query().Get("query")
+	if query == "" {
+		WriteError(w, http.StatusBadRequest, "query is required")
+		return
+	}
+	offset, err := h.parseOptionalQueryParamUint64(r, "offset")
+	if err != nil {
+		WriteError(w, http.StatusBadRequest, "offset is invalid")
+		return
+	}
+	limit, err := h.parseOptionalQueryParamUint64(r, "limit")
+	if err != nil {
+		WriteError(w, http.StatusBadRequest, "limit is invalid")
+		return
+	}
+
+	client, err := h.clientPool.GetClient()
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	mData := metadata.MD{}
+	ctx := metadata.NewOutgoingContext(context.Background(), mData)
+
+	output, err := client.SearchAccounts(ctx, &go_feed.SearchAccountsRequest{
+		Query:  query,
+		Offset: offset,
+		Limit:  limit,
+	})
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, "Failed to search accounts: "+err.Error())
+		return
+	}
+
+	WriteJSON(w, http.StatusOK, output)
+}
+
+func (h accountHandler) SetLikesHidden(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		WriteError(w, http.StatusMethodNotAllowed, "Method not allowed, expected POST")
+		return
+	}
+
+	var body struct {
+		LikesHidden bool `json:"likes_hidden"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		WriteError(w, http.StatusBadRequest, "Invalid JSON body")
+		return
+	}
+
+	client, err := h.clientPool.GetClient()
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	mData := metadata.MD{}
+	ctx := metadata.NewOutgoingContext(context.Background(), mData)
+
+	output, err := client.SetLikesHidden(ctx, &go_feed.SetLikesHiddenRequest{
+		LikesHidden: body.LikesHidden,
+	})
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, "Failed to set likes hidden: "+err.Error())
+		return
+	}
+
+	WriteJSON(w, http.StatusOK, output)
+}
+
+func (h accountHandler) SetAccountPrivate(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		WriteError(w, http.StatusMethodNotAllowed, "Method not allowed, expected POST")
+		return
+	}
+
+	var body struct {
+		IsPrivate bool `json:"is_private"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		WriteError(w, http.StatusBadRequest, "Invalid JSON body")
+		return
+	}
+
+	client, err := h.clientPool.GetClient()
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	mData := metadata.MD{}
+	ctx := metadata.NewOutgoingContext(context.Background(), mData)
+
+	output, err := client.SetAccountPrivate(ctx, &go_feed.SetAccountPrivateRequest{
+		IsPrivate: body.IsPrivate,
+	})
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, "Failed to set account private: "+err.Error())
+		return
+	}
+
+	WriteJSON(w, http.StatusOK, output)
+}
+
+// Helper method to parse a uint64 query parameter that defaults to 0 when absent
+func (h accountHandler) parseOptionalQueryParamUint64(r *http.Request, param string) (uint64, error) {
+	paramValue := r.URL.Query().Get(param)
+	if paramValue == "" {
+		return 0, nil
+	}
+	return strconv.ParseUint(paramValue, 10, 64)
+}
@@ -0,0 +1,240 @@
+package http
+
+import (
+	"GoFeed/internal/generated/api/go_feed"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"google.golang.org/grpc/metadata"
+)
+
+type blockHandler struct {
+	clientPool *grpcClientPool
+}
+
+func NewBlockHandler(clientPool *grpcClientPool) *blockHandler {
+	return &blockHandler{clientPool: clientPool}
+}
+
+func (h blockHandler) BlockAccount(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		WriteError(w, http.StatusMethodNotAllowed, "Method not allowed, expected POST")
+		return
+	}
+
+	var body struct {
+		AccountID uint64 `json:"account_id"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		WriteError(w, http.StatusBadRequest, "invalid JSON body")
+		return
+	}
+	if body.AccountID == 0 {
+		WriteError(w, http.StatusBadRequest, "account_id is required and must be a uint64")
+		return
+	}
+
+	client, err := h.clientPool.GetClient()
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, "Failed to get gRPC client: "+err.Error())
+		return
+	}
+
+	mData := metadata.MD{}
+	ctx := metadata.NewOutgoingContext(context.Background(), mData)
+
+	output, err := client.BlockAccount(ctx, &go_feed.BlockAccountRequest{
+		AccountId: body.AccountID,
+	})
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	WriteJSON(w, http.StatusOK, output)
+}
+
+func (h blockHandler) UnblockAccount(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		WriteError(w, http.StatusMethodNotAllowed, "Method not allowed, expected POST")
+		return
+	}
+
+	var body struct {
+		AccountID uint64 `json:"account_id"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		WriteError(w, http.StatusBadRequest, "invalid JSON body")
+		return
+	}
+	if body.AccountID == 0 {
+		WriteError(w, http.StatusBadRequest, "account_id is required and must be a uint64")
+		return
+	}
+
+	client, err := h.clientPool.GetClient()
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, "Failed to get gRPC client: "+err.Error())
+		return
+	}
+
+	mData := metadata.MD{}
+	ctx := metadata.NewOutgoingContext(context.Background(), mData)
+
+	output, err := client.UnblockAccount(ctx, &go_feed.UnblockAccountRequest{
+		AccountId: body.AccountID,
+	})
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	WriteJSON(w, http.StatusOK, output)
+}
+
+func (h blockHandler) ListBlockedAccounts(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		WriteError(w, http.StatusMethodNotAllowed, "Method not allowed, expected GET")
+		return
+	}
+
+	limit, err := h.parseOptionalQueryParamUint64(r, "limit")
+	if err != nil {
+		WriteError(w, http.StatusBadRequest, "limit is invalid")
+		return
+	}
+
+	client, err := h.clientPool.GetClient()
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, "Failed to get gRPC client: "+err.Error())
+		return
+	}
+
+	mData := metadata.MD{}
+	ctx := metadata.NewOutgoingContext(context.Background(), mData)
+
+	output, err := client.ListBlockedAccounts(ctx, &go_feed.ListBlockedAccountsRequest{
+		Cursor: r.URL.Query().Get("cursor"),
+		Limit:  limit,
+	})
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	WriteJSON(w, http.StatusOK, output)
+}
+
+func (h blockHandler) MuteAccount(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		WriteError(w, http.StatusMethodNotAllowed, "Method not allowed, expected POST")
+		return
+	}
+
+	var body struct {
+		AccountID uint64 `json:"account_id"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		WriteError(w, http.StatusBadRequest, "invalid JSON body")
+		return
+	}
+	if body.AccountID == 0 {
+		WriteError(w, http.StatusBadRequest, "account_id is required and must be a uint64")
+		return
+	}
+
+	client, err := h.clientPool.GetClient()
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, "Failed to get gRPC client: "+err.Error())
+		return
+	}
+
+	mData := metadata.MD{}
+	ctx := metadata.NewOutgoingContext(context.Background(), mData)
+
+	output, err := client.MuteAccount(ctx, &go_feed.MuteAccountRequest{
+		AccountId: body.AccountID,
+	})
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	WriteJSON(w, http.StatusOK, output)
+}
+
+func (h blockHandler) UnmuteAccount(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		WriteError(w, http.StatusMethodNotAllowed, "Method not allowed, expected POST")
+		return
+	}
+
+	var body struct {
+		AccountID uint64 `json:"account_id"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		WriteError(w, http.StatusBadRequest, "invalid JSON body")
+		return
+	}
+	if body.AccountID == 0 {
+		WriteError(w, http.StatusBadRequest, "account_id is required and must be a uint64")
+		return
+	}
+
+	client, err := h.clientPool.GetClient()
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, "Failed to get gRPC client: "+err.Error())
+		return
+	}
+
+	mData := metadata.MD{}
+	ctx := metadata.NewOutgoingContext(context.Background(), mData)
+
+	output, err := client.UnmuteAccount(ctx, &go_feed.UnmuteAccountRequest{
+		AccountId: body.AccountID,
+	})
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	WriteJSON(w, http.StatusOK, output)
+}
+
+func (h blockHandler) ListMutedAccounts(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		WriteError(w, http.StatusMethodNotAllowed, "Method not allowed, expected GET")
+		return
+	}
+
+	limit, err := h.parseOptionalQueryParamUint64(r, "limit")
+	if err != nil {
+		WriteError(w, http.StatusBadRequest, "limit is invalid")
+		return
+	}
+
+	client, err := h.clientPool.GetClient()
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, "Failed to get gRPC client: "+err.Error())
+		return
+	}
+
+	mData := metadata.MD{}
+	ctx := metadata.NewOutgoingContext(context.Background(), mData)
+
+	output, err := client.ListMutedAccounts(ctx, &go_feed.ListMutedAccountsRequest{
+		Cursor: r.URL.Query().Get("cursor"),
+		Limit:  limit,
+	})
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	WriteJSON(w, http.StatusOK, output)
+}
+
+// Helper method to parse a uint64 query parameter that defaults to 0 when absent
+func (h blockHandler) parseOptionalQueryParamUint64(r *http.Request, param string) (uint64, error) {
+	paramValue := r.URL.Query().Get(param)
+	if paramValue == "" {
+		return 0, nil
+	}
+	return strconv.ParseUint(paramValue, 10, 64)
+}
@@ -0,0 +1,137 @@
+package http
+
+import (
+	"GoFeed/internal/generated/api/go_feed"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"google.golang.org/grpc/metadata"
+)
+
+type bookmarkHandler struct {
+	clientPool *grpcClientPool
+}
+
+func NewBookmarkHandler(clientPool *grpcClientPool) *bookmarkHandler {
+	return &bookmarkHandler{clientPool: clientPool}
+}
+
+func (h bookmarkHandler) CreateBookmark(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		WriteError(w, http.StatusMethodNotAllowed, "Method not allowed, expected POST")
+		return
+	}
+
+	var body struct {
+		PostID uint64 `json:"post_id"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		WriteError(w, http.StatusBadRequest, "Invalid JSON body")
+		return
+	}
+	if body.PostID == 0 {
+		WriteError(w, http.StatusBadRequest, "post_id is required and must be a non-zero uint64")
+		return
+	}
+
+	client, err := h.clientPool.GetClient()
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, "Failed to get gRPC client: "+err.Error())
+		return
+	}
+
+	mData := metadata.MD{}
+	ctx := metadata.NewOutgoingContext(context.Background(), mData)
+
+	output, err := client.CreateBookmark(ctx, &go_feed.CreateBookmarkRequest{
+		PostId: body.PostID,
+	})
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, "Failed to create bookmark: "+err.Error())
+		return
+	}
+
+	WriteJSON(w, http.StatusOK, output)
+}
+
+func (h bookmarkHandler) DeleteBookmark(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		WriteError(w, http.StatusMethodNotAllowed, "Method not allowed, expected POST")
+		return
+	}
+
+	var body struct {
+		PostID uint64 `json:"post_id"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		WriteError(w, http.StatusBadRequest, "Invalid JSON body")
+		return
+	}
+	if body.PostID == 0 {
+		WriteError(w, http.StatusBadRequest, "post_id is required and must be a non-zero uint64")
+		return
+	}
+
+	client, err := h.clientPool.GetClient()
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, "Failed to get gRPC client: "+err.Error())
+		return
+	}
+
+	mData := metadata.MD{}
+	ctx := metadata.NewOutgoingContext(context.Background(), mData)
+
+	output, err := client.DeleteBookmark(ctx, &go_feed.DeleteBookmarkRequest{
+		PostId: body.PostID,
+	})
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, "Failed to delete bookmark: "+err.Error())
+		return
+	}
+
+	WriteJSON(w, http.StatusOK, output)
+}
+
+func (h bookmarkHandler) ListBookmarks(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		WriteError(w, http.StatusMethodNotAllowed, "Method not allowed, expected GET")
+		return
+	}
+
+	limit, err := h.parseOptionalQueryParamUint64(r, "limit")
+	if err != nil {
+		WriteError(w, http.StatusBadRequest, "limit is invalid")
+		return
+	}
+
+	client, err := h.clientPool.GetClient()
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, "Failed to get gRPC client: "+err.Error())
+		return
+	}
+
+	mData := metadata.MD{}
+	ctx := metadata.NewOutgoingContext(context.Background(), mData)
+
+	output, err := client.ListBookmarks(ctx, &go_feed.ListBookmarksRequest{
+		Cursor: r.URL.Query().Get("cursor"),
+		Limit:  limit,
+	})
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, "Failed to list bookmarks: "+err.Error())
+		return
+	}
+
+	WriteJSON(w, http.StatusOK, output)
+}
+
+// Helper method to parse a uint64 query parameter that defaults to 0 when absent
+func (h bookmarkHandler) parseOptionalQueryParamUint64(r *http.Request, param string) (uint64, error) {
+	paramValue := r.URL.Query().Get(param)
+	if paramValue == "" {
+		return 0, nil
+	}
+	return strconv.ParseUint(paramValue, 10, 64)
+}
@@ -15,6 +15,20 @@ type commentHandler struct {
 	clientPool *grpcClientPool
 }
 
+var (
+	commentSortByName = map[string]go_feed.CommentSort{
+		"oldest": go_feed.CommentSort_COMMENT_SORT_OLDEST,
+		"newest": go_feed.CommentSort_COMMENT_SORT_NEWEST,
+		"top":    go_feed.CommentSort_COMMENT_SORT_TOP,
+	}
+	commentPolicyByName = map[string]go_feed.CommentPolicy{
+		"everyone":  go_feed.CommentPolicy_COMMENT_POLICY_EVERYONE,
+		"followers": go_feed.CommentPolicy_COMMENT_POLICY_FOLLOWERS,
+		"mentioned": go_feed.CommentPolicy_COMMENT_POLICY_MENTIONED,
+		"closed":    go_feed.CommentPolicy_COMMENT_POLICY_CLOSED,
+	}
+)
+
 func NewCommentHandler(clientPool *grpcClientPool) *commentHandler {
 	return &commentHandler{clientPool: clientPool}
 }
@@ -26,8 +40,9 @@ func (h *commentHandler) CreateComment(w http.ResponseWriter, r *http.Request) {
 	}
 
 	var body struct {
-		PostID  uint64 `json:"post_id"`
-		Content string `json:"content"`
+		PostID          uint64 `json:"post_id"`
+		ParentCommentID uint64 `json:"parent_comment_id"`
+		Content         string `json:"content"`
 	}
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		WriteError(w, http.StatusBadRequest, "invalid JSON body")
@@ -48,8 +63,9 @@ func (h *commentHandler) CreateComment(w http.ResponseWriter, r *http.Request) {
 	ctx := metadata.NewOutgoingContext(context.Background(), mData)
 
 	output, err := client.CreateComment(ctx, &go_feed.CreateCommentRequest{
-		PostId:  body.PostID,
-		Content: body.Content,
+		PostId:          body.PostID,
+		ParentCommentId: body.ParentCommentID,
+		Content:         body.Content,
 	})
 	if err != nil {
 		WriteError(w, http.StatusInternalServerError, err.Error())
@@ -100,6 +116,31 @@ func (h *commentHandler) GetCommentsOfPost(w http.ResponseWriter, r *http.Reques
 		WriteError(w, http.StatusBadRequest, err.Error())
 		return
 	}
+	threaded := false
+	if threadedValue := r.URL.Query().Get("threaded"); threadedValue != "" {
+		threaded, err = strconv.ParseBool(threadedValue)
+		if err != nil {
+			WriteError(w, http.StatusBadRequest, "threaded must be true or false")
+			return
+		}
+	}
+	sort := go_feed.CommentSort_COMMENT_SORT_UNSPECIFIED
+	if sortName := r.URL.Query().Get("sort"); sortName != "" {
+		var ok bool
+		sort, ok = commentSortByName[sortName]
+		if !ok {
+			WriteError(w, http.StatusBadRequest, "sort must be oldest, newest or top")
+			return
+		}
+	}
+	var pageSize uint64
+	if pageSizeValue := r.URL.Query().Get("page_size"); pageSizeValue != "" {
+		pageSize, err = strconv.ParseUint(pageSizeValue, 10, 64)
+		if err != nil {
+			WriteError(w, http.StatusBadRequest, "page_size is invalid")
+			return
+		}
+	}
 
 	client, err := h.clientPool.GetClient()
 	if err != nil {
@@ -111,7 +152,52 @@ func (h *commentHandler) GetCommentsOfPost(w http.ResponseWriter, r *http.Reques
 	ctx := metadata.NewOutgoingContext(context.Background(), mData)
 
 	output, err := client.GetCommentsOfPost(ctx, &go_feed.GetCommentsOfPostRequest{
-		PostId: postID,
+		PostId:   postID,
+		Threaded: threaded,
+		PageSize: pageSize,
+		Cursor:   r.URL.Query().Get("cursor"),
+		Sort:     sort,
+	})
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	WriteJSON(w, http.StatusOK, output)
+}
+
+func (h *commentHandler) GetCommentReplies(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		WriteError(w, http.StatusMethodNotAllowed, "Method not allowed, expected GET")
+		return
+	}
+
+	commentID, err := h.parseQueryParamUint64(r, "comment_id")
+	if err != nil {
+		WriteError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	var limit uint64
+	if limitValue := r.URL.Query().Get("limit"); limitValue != "" {
+		limit, err = strconv.ParseUint(limitValue, 10, 64)
+		if err != nil {
+			WriteError(w, http.StatusBadRequest, "limit is invalid")
+			return
+		}
+	}
+
+	client, err := h.clientPool.GetClient()
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, "Failed to get gRPC client: "+err.Error())
+		return
+	}
+
+	mData := metadata.MD{}
+	ctx := metadata.NewOutgoingContext(context.Background(), mData)
+
+	output, err := client.GetCommentReplies(ctx, &go_feed.GetCommentRepliesRequest{
+		CommentId: commentID,
+		Cursor:    r.URL.Query().Get("cursor"),
+		Limit:     limit,
 	})
 	if err != nil {
 		WriteError(w, http.StatusInternalServerError, err.Error())
@@ -198,6 +284,124 @@ func (h *commentHandler) DeleteComment(w http.ResponseWriter, r *http.Request) {
 	WriteJSON(w, http.StatusOK, output)
 }
 
+func (h *commentHandler) HideComment(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		WriteError(w, http.StatusMethodNotAllowed, "Method not allowed, expected POST")
+		return
+	}
+
+	var body struct {
+		CommentID uint64 `json:"comment_id"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		WriteError(w, http.StatusBadRequest, "invalid JSON body")
+		return
+	}
+	if body.CommentID == 0 {
+		WriteError(w, http.StatusBadRequest, "comment_id is required and must be a uint64")
+		return
+	}
+
+	client, err := h.clientPool.GetClient()
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, "Failed to get gRPC client: "+err.Error())
+		return
+	}
+
+	mData := metadata.MD{}
+	ctx := metadata.NewOutgoingContext(context.Background(), mData)
+
+	output, err := client.HideComment(ctx, &go_feed.HideCommentRequest{
+		CommentId: body.CommentID,
+	})
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	WriteJSON(w, http.StatusOK, output)
+}
+
+func (h *commentHandler) UnhideComment(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		WriteError(w, http.StatusMethodNotAllowed, "Method not allowed, expected POST")
+		return
+	}
+
+	var body struct {
+		CommentID uint64 `json:"comment_id"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		WriteError(w, http.StatusBadRequest, "invalid JSON body")
+		return
+	}
+	if body.CommentID == 0 {
+		WriteError(w, http.StatusBadRequest, "comment_id is required and must be a uint64")
+		return
+	}
+
+	client, err := h.clientPool.GetClient()
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, "Failed to get gRPC client: "+err.Error())
+		return
+	}
+
+	mData := metadata.MD{}
+	ctx := metadata.NewOutgoingContext(context.Background(), mData)
+
+	output, err := client.UnhideComment(ctx, &go_feed.UnhideCommentRequest{
+		CommentId: body.CommentID,
+	})
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	WriteJSON(w, http.StatusOK, output)
+}
+
+func (h *commentHandler) SetCommentPolicy(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		WriteError(w, http.StatusMethodNotAllowed, "Method not allowed, expected POST")
+		return
+	}
+
+	var body struct {
+		PostID        uint64 `json:"post_id"`
+		CommentPolicy string `json:"comment_policy"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		WriteError(w, http.StatusBadRequest, "invalid JSON body")
+		return
+	}
+	if body.PostID == 0 {
+		WriteError(w, http.StatusBadRequest, "post_id is required and must be a uint64")
+		return
+	}
+	commentPolicy, ok := commentPolicyByName[body.CommentPolicy]
+	if !ok {
+		WriteError(w, http.StatusBadRequest, "comment_policy must be everyone, followers, mentioned or closed")
+		return
+	}
+
+	client, err := h.clientPool.GetClient()
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, "Failed to get gRPC client: "+err.Error())
+		return
+	}
+
+	mData := metadata.MD{}
+	ctx := metadata.NewOutgoingContext(context.Background(), mData)
+
+	output, err := client.SetCommentPolicy(ctx, &go_feed.SetCommentPolicyRequest{
+		PostId:        body.PostID,
+		CommentPolicy: commentPolicy,
+	})
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	WriteJSON(w, http.StatusOK, output)
+}
+
 // Helper method to parse a uint64 query parameter
 func (h *commentHandler) parseQueryParamUint64(r *http.Request, param string) (uint64, error) {
 	paramValue := r.URL.Query().Get(param)
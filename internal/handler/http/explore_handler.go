@@ -0,0 +1,119 @@
+package http
+
+import (
+	"GoFeed/internal/generated/api/go_feed"
+	"context"
+	"net/http"
+	"strconv"
+
+	"google.golang.org/grpc/metadata"
+)
+
+type exploreHandler struct {
+	clientPool *grpcClientPool
+}
+
+func NewExploreHandler(clientPool *grpcClientPool) *exploreHandler {
+	return &exploreHandler{clientPool: clientPool}
+}
+
+var trendingWindowByName = map[string]go_feed.TrendingWindow{
+	"1h":  go_feed.TrendingWindow_TRENDING_WINDOW_1H,
+	"24h": go_feed.TrendingWindow_TRENDING_WINDOW_24H,
+	"7d":  go_feed.TrendingWindow_TRENDING_WINDOW_7D,
+}
+
+func (h exploreHandler) GetTrendingHashtags(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		WriteError(w, http.StatusMethodNotAllowed, "Method not allowed, expected GET")
+		return
+	}
+
+	window, ok := h.parseOptionalQueryParamTrendingWindow(r)
+	if !ok {
+		WriteError(w, http.StatusBadRequest, "window must be 1h, 24h or 7d")
+		return
+	}
+	limit, err := h.parseOptionalQueryParamUint64(r, "limit")
+	if err != nil {
+		WriteError(w, http.StatusBadRequest, "limit is invalid")
+		return
+	}
+
+	client, err := h.clientPool.GetClient()
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, "Failed to get gRPC client: "+err.Error())
+		return
+	}
+
+	mData := metadata.MD{}
+	ctx := metadata.NewOutgoingContext(context.Background(), mData)
+
+	output, err := client.GetTrendingHashtags(ctx, &go_feed.GetTrendingHashtagsRequest{
+		Window: window,
+		Limit:  limit,
+	})
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, "Failed to get trending hashtags: "+err.Error())
+		return
+	}
+
+	WriteJSON(w, http.StatusOK, output)
+}
+
+func (h exploreHandler) GetTrendingPosts(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		WriteError(w, http.StatusMethodNotAllowed, "Method not allowed, expected GET")
+		return
+	}
+
+	window, ok := h.parseOptionalQueryParamTrendingWindow(r)
+	if !ok {
+		WriteError(w, http.StatusBadRequest, "window must be 1h, 24h or 7d")
+		return
+	}
+	limit, err := h.parseOptionalQueryParamUint64(r, "limit")
+	if err != nil {
+		WriteError(w, http.StatusBadRequest, "limit is invalid")
+		return
+	}
+
+	client, err := h.clientPool.GetClient()
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, "Failed to get gRPC client: "+err.Error())
+		return
+	}
+
+	mData := metadata.MD{}
+	ctx := metadata.NewOutgoingContext(context.Background(), mData)
+
+	output, err := client.GetTrendingPosts(ctx, &go_feed.GetTrendingPostsRequest{
+		Window: window,
+		Limit:  limit,
+	})
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, "Failed to get trending posts: "+err.Error())
+		return
+	}
+
+	WriteJSON(w, http.StatusOK, output)
+}
+
+// Helper method to parse the window query parameter that defaults to unspecified when absent
+func (h exploreHandler) parseOptionalQueryParamTrendingWindow(r *http.Request) (go_feed.TrendingWindow, bool) {
+	windowName := r.URL.Query().Get("window")
+	if windowName == "" {
+		return go_feed.TrendingWindow_TRENDING_WINDOW_UNSPECIFIED, true
+	}
+	window, ok := trendingWindowByName[windowName]
+	return window, ok
+}
+
+// Helper method to parse a uint64 query parameter that defaults to 0 when absent
+func (h exploreHandler) parseOptionalQueryParamUint64(r *http.Request, param string) (uint64, error) {
+	paramValue := r.URL.Query().Get(param)
+	if paramValue == "" {
+		return 0, nil
+	}
+	return strconv.ParseUint(paramValue, 10, 64)
+}
@@ -98,6 +98,11 @@ func (h followHandler) GetFollowersOfAccount(w http.ResponseWriter, r *http.Requ
 		WriteError(w, http.StatusBadRequest, err.Error())
 		return
 	}
+	pageSize, err := h.parseOptionalQueryParamUint64(r, "page_size")
+	if err != nil {
+		WriteError(w, http.StatusBadRequest, "page_size is invalid")
+		return
+	}
 
 	client, err := h.clientPool.GetClient()
 	if err != nil {
@@ -110,6 +115,10 @@ func (h followHandler) GetFollowersOfAccount(w http.ResponseWriter, r *http.Requ
 
 	output, err := client.GetFollowersOfAccount(ctx, &go_feed.GetFollowersOfAccountRequest{
 		AccountId: accountID,
+		Page: &go_feed.PageRequest{
+			PageSize: pageSize,
+			Cursor:   r.URL.Query().Get("cursor"),
+		},
 	})
 	if err != nil {
 		WriteError(w, http.StatusInternalServerError, err.Error())
@@ -160,6 +169,11 @@ func (h followHandler) GetFollowingsOfAccount(w http.ResponseWriter, r *http.Req
 		WriteError(w, http.StatusBadRequest, err.Error())
 		return
 	}
+	pageSize, err := h.parseOptionalQueryParamUint64(r, "page_size")
+	if err != nil {
+		WriteError(w, http.StatusBadRequest, "page_size is invalid")
+		return
+	}
 
 	client, err := h.clientPool.GetClient()
 	if err != nil {
@@ -172,6 +186,10 @@ func (h followHandler) GetFollowingsOfAccount(w http.ResponseWriter, r *http.Req
 
 	output, err := client.GetFollowingsOfAccount(ctx, &go_feed.GetFollowingsOfAccountRequest{
 		AccountId: accountID,
+		Page: &go_feed.PageRequest{
+			PageSize: pageSize,
+			Cursor:   r.URL.Query().Get("cursor"),
+		},
 	})
 	if err != nil {
 		WriteError(w, http.StatusInternalServerError, err.Error())
@@ -217,6 +235,176 @@ func (h followHandler) DeleteFollow(w http.ResponseWriter, r *http.Request) {
 	WriteJSON(w, http.StatusOK, output)
 }
 
+func (h followHandler) ListFollowRequests(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		WriteError(w, http.StatusMethodNotAllowed, "Method not allowed, expected GET")
+		return
+	}
+
+	limit, err := h.parseOptionalQueryParamUint64(r, "limit")
+	if err != nil {
+		WriteError(w, http.StatusBadRequest, "limit is invalid")
+		return
+	}
+
+	client, err := h.clientPool.GetClient()
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, "Failed to get gRPC client: "+err.Error())
+		return
+	}
+
+	mData := metadata.MD{}
+	ctx := metadata.NewOutgoingContext(context.Background(), mData)
+
+	output, err := client.ListFollowRequests(ctx, &go_feed.ListFollowRequestsRequest{
+		Cursor: r.URL.Query().Get("cursor"),
+		Limit:  limit,
+	})
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	WriteJSON(w, http.StatusOK, output)
+}
+
+func (h followHandler) AcceptFollowRequest(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		WriteError(w, http.StatusMethodNotAllowed, "Method not allowed, expected POST")
+		return
+	}
+
+	var body struct {
+		AccountID uint64 `json:"account_id"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		WriteError(w, http.StatusBadRequest, "invalid JSON body")
+		return
+	}
+	if body.AccountID == 0 {
+		WriteError(w, http.StatusBadRequest, "account_id is required and must be a uint64")
+		return
+	}
+
+	client, err := h.clientPool.GetClient()
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, "Failed to get gRPC client: "+err.Error())
+		return
+	}
+
+	mData := metadata.MD{}
+	ctx := metadata.NewOutgoingContext(context.Background(), mData)
+
+	output, err := client.AcceptFollowRequest(ctx, &go_feed.AcceptFollowRequestRequest{
+		AccountId: body.AccountID,
+	})
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	WriteJSON(w, http.StatusOK, output)
+}
+
+func (h followHandler) RejectFollowRequest(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		WriteError(w, http.StatusMethodNotAllowed, "Method not allowed, expected POST")
+		return
+	}
+
+	var body struct {
+		AccountID uint64 `json:"account_id"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		WriteError(w, http.StatusBadRequest, "invalid JSON body")
+		return
+	}
+	if body.AccountID == 0 {
+		WriteError(w, http.StatusBadRequest, "account_id is required and must be a uint64")
+		return
+	}
+
+	client, err := h.clientPool.GetClient()
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, "Failed to get gRPC client: "+err.Error())
+		return
+	}
+
+	mData := metadata.MD{}
+	ctx := metadata.NewOutgoingContext(context.Background(), mData)
+
+	output, err := client.RejectFollowRequest(ctx, &go_feed.RejectFollowRequestRequest{
+		AccountId: body.AccountID,
+	})
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	WriteJSON(w, http.StatusOK, output)
+}
+
+func (h followHandler) GetFollowSuggestions(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		WriteError(w, http.StatusMethodNotAllowed, "Method not allowed, expected GET")
+		return
+	}
+
+	limit, err := h.parseOptionalQueryParamUint64(r, "limit")
+	if err != nil {
+		WriteError(w, http.StatusBadRequest, "limit is invalid")
+		return
+	}
+
+	client, err := h.clientPool.GetClient()
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, "Failed to get gRPC client: "+err.Error())
+		return
+	}
+
+	mData := metadata.MD{}
+	ctx := metadata.NewOutgoingContext(context.Background(), mData)
+
+	output, err := client.GetFollowSuggestions(ctx, &go_feed.GetFollowSuggestionsRequest{
+		Limit: limit,
+	})
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	WriteJSON(w, http.StatusOK, output)
+}
+
+func (h followHandler) GetRelationships(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		WriteError(w, http.StatusMethodNotAllowed, "Method not allowed, expected POST")
+		return
+	}
+
+	var body struct {
+		AccountIDs []uint64 `json:"account_ids"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		WriteError(w, http.StatusBadRequest, "invalid JSON body")
+		return
+	}
+
+	client, err := h.clientPool.GetClient()
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, "Failed to get gRPC client: "+err.Error())
+		return
+	}
+
+	mData := metadata.MD{}
+	ctx := metadata.NewOutgoingContext(context.Background(), mData)
+
+	output, err := client.GetRelationships(ctx, &go_feed.GetRelationshipsRequest{
+		AccountIds: body.AccountIDs,
+	})
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	WriteJSON(w, http.StatusOK, output)
+}
+
 // Helper method to parse a uint64 query parameter
 func (h followHandler) parseQueryParamUint64(r *http.Request, param string) (uint64, error) {
 	paramValue := r.URL.Query().Get(param)
@@ -225,3 +413,12 @@ func (h followHandler) parseQueryParamUint64(r *http.Request, param string) (uin
 	}
 	return strconv.ParseUint(paramValue, 10, 64)
 }
+
+// Helper method to parse a uint64 query parameter that defaults to 0 when absent
+func (h followHandler) parseOptionalQueryParamUint64(r *http.Request, param string) (uint64, error) {
+	paramValue := r.URL.Query().Get(param)
+	if paramValue == "" {
+		return 0, nil
+	}
+	return strconv.ParseUint(paramValue, 10, 64)
+}
@@ -14,7 +14,11 @@ type httpHandler struct {
 	likeHandler
 	commentHandler
 	followHandler
+	blockHandler
 	newFeedHandler
+	bookmarkHandler
+	exploreHandler
+	moderationHandler
 }
 
 func NewHttpHandler() HttpHandler {
@@ -24,22 +28,59 @@ func NewHttpHandler() HttpHandler {
 func (h *httpHandler) RegisterRoutes(mux *http.ServeMux) {
 	mux.HandleFunc("/api/account", h.CreateAccount)
 	mux.HandleFunc("/api/session", h.CreateSession)
+	mux.HandleFunc("/api/account/search", h.SearchAccounts)
+	mux.HandleFunc("/api/account/likes_hidden", h.SetLikesHidden)
+	mux.HandleFunc("/api/account/private", h.SetAccountPrivate)
 
 	mux.HandleFunc("/api/post", h.CreatePost)
 	mux.HandleFunc("/api/post/{post_id}", h.GetPostByID)
 	mux.HandleFunc("/api/post/of_account/{account_id}", h.GetPostOfAccount)
 	mux.HandleFunc("/api/post", h.UpdatePost)
+	mux.HandleFunc("/api/post/of_hashtag", h.GetPostsByHashtag)
+	mux.HandleFunc("/api/post/mentioning/account", h.GetPostsMentioningAccount)
+	mux.HandleFunc("/api/post/search", h.SearchPosts)
+	mux.HandleFunc("/api/post/delete", h.DeletePost)
+	mux.HandleFunc("/api/post/restore", h.RestorePost)
+	mux.HandleFunc("/api/post/trash", h.ListDeletedPosts)
+	mux.HandleFunc("/api/post/pin", h.PinPost)
+	mux.HandleFunc("/api/post/unpin", h.UnpinPost)
+
+	mux.HandleFunc("/api/bookmark", h.CreateBookmark)
+	mux.HandleFunc("/api/bookmark/delete", h.DeleteBookmark)
+	mux.HandleFunc("/api/bookmark/list", h.ListBookmarks)
+
+	mux.HandleFunc("/api/explore/trending/hashtags", h.GetTrendingHashtags)
+	mux.HandleFunc("/api/explore/trending/posts", h.GetTrendingPosts)
+
+	mux.HandleFunc("/api/poll/vote", h.VotePoll)
+	mux.HandleFunc("/api/poll/results", h.GetPollResults)
+
+	mux.HandleFunc("/api/draft", h.CreateDraft)
+	mux.HandleFunc("/api/draft/list", h.ListDrafts)
+	mux.HandleFunc("/api/draft/update", h.UpdateDraft)
+	mux.HandleFunc("/api/draft/publish", h.PublishDraft)
 
 	mux.HandleFunc("/api/like", h.CreateLike)
 	mux.HandleFunc("/api/like/count/of_post/{post_id}", h.GetLikeCountOfPost)
 	mux.HandleFunc("/api/like/account/of_post/{post_id}", h.GetLikeAccountsOfPost)
 	mux.HandleFunc("/api/like", h.DeleteLike)
+	mux.HandleFunc("/api/reaction", h.SetReaction)
+	mux.HandleFunc("/api/reaction/delete", h.DeleteReaction)
+	mux.HandleFunc("/api/like/has_liked", h.HasLikedPosts)
+	mux.HandleFunc("/api/like/post/of_account", h.GetLikedPostsOfAccount)
+	mux.HandleFunc("/api/like/comment", h.CreateCommentLike)
+	mux.HandleFunc("/api/like/count/of_comment/{comment_id}", h.GetLikeCountOfComment)
+	mux.HandleFunc("/api/like/comment/delete", h.DeleteCommentLike)
 
 	mux.HandleFunc("/api/comment", h.CreateComment)
 	mux.HandleFunc("/api/comment/count/of_post/{post_id}", h.GetCommentCountOfPost)
 	mux.HandleFunc("/api/comment/of_post/{post_id}", h.GetCommentsOfPost)
+	mux.HandleFunc("/api/comment/replies/{comment_id}", h.GetCommentReplies)
 	mux.HandleFunc("/api/comment", h.UpdateComment)
 	mux.HandleFunc("/api/comment", h.DeleteComment)
+	mux.HandleFunc("/api/comment/hide", h.HideComment)
+	mux.HandleFunc("/api/comment/unhide", h.UnhideComment)
+	mux.HandleFunc("/api/comment/policy", h.SetCommentPolicy)
 
 	mux.HandleFunc("/api/follow", h.CreateFollow)
 	mux.HandleFunc("/api/follow/follower_count/account", h.GetFollowerCountOfAccount)
@@ -47,6 +88,23 @@ func (h *httpHandler) RegisterRoutes(mux *http.ServeMux) {
 	mux.HandleFunc("/api/follow/following_count/account", h.GetFollowingCountOfAccount)
 	mux.HandleFunc("/api/follow/following/account", h.GetFollowingsOfAccount)
 	mux.HandleFunc("/api/follow", h.DeleteFollow)
+	mux.HandleFunc("/api/follow/request/list", h.ListFollowRequests)
+	mux.HandleFunc("/api/follow/request/accept", h.AcceptFollowRequest)
+	mux.HandleFunc("/api/follow/request/reject", h.RejectFollowRequest)
+	mux.HandleFunc("/api/follow/suggestions", h.GetFollowSuggestions)
+	mux.HandleFunc("/api/follow/relationships", h.GetRelationships)
+
+	mux.HandleFunc("/api/block", h.BlockAccount)
+	mux.HandleFunc("/api/block/delete", h.UnblockAccount)
+	mux.HandleFunc("/api/block/list", h.ListBlockedAccounts)
+	mux.HandleFunc("/api/mute", h.MuteAccount)
+	mux.HandleFunc("/api/mute/delete", h.UnmuteAccount)
+	mux.HandleFunc("/api/mute/list", h.ListMutedAccounts)
 
 	mux.HandleFunc("/api/new_feed", h.GetNewFeeds)
+
+	mux.HandleFunc("/api/report", h.ReportContent)
+	mux.HandleFunc("/api/admin/report/list", h.ListReports)
+	mux.HandleFunc("/api/admin/report/resolve", h.ResolveReport)
+	mux.HandleFunc("/api/admin/takedown", h.TakedownContent)
 }
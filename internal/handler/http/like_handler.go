@@ -15,6 +15,15 @@ type likeHandler struct {
 	clientPool *grpcClientPool
 }
 
+var reactionTypeByName = map[string]go_feed.ReactionType{
+	"like":  go_feed.ReactionType_REACTION_TYPE_LIKE,
+	"love":  go_feed.ReactionType_REACTION_TYPE_LOVE,
+	"laugh": go_feed.ReactionType_REACTION_TYPE_LAUGH,
+	"wow":   go_feed.ReactionType_REACTION_TYPE_WOW,
+	"sad":   go_feed.ReactionType_REACTION_TYPE_SAD,
+	"angry": go_feed.ReactionType_REACTION_TYPE_ANGRY,
+}
+
 func NewLikeHandler(clientPool *grpcClientPool) *likeHandler {
 	return &likeHandler{clientPool: clientPool}
 }
@@ -56,6 +65,50 @@ func (h *likeHandler) CreateLike(w http.ResponseWriter, r *http.Request) {
 	WriteJSON(w, http.StatusOK, output)
 }
 
+func (h *likeHandler) SetReaction(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		WriteError(w, http.StatusMethodNotAllowed, "Method not allowed, expected POST")
+		return
+	}
+
+	var body struct {
+		PostID       uint64 `json:"post_id"`
+		ReactionType string `json:"reaction_type"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		WriteError(w, http.StatusBadRequest, "invalid JSON body")
+		return
+	}
+	if body.PostID == 0 {
+		WriteError(w, http.StatusBadRequest, "post_id is required and must be a uint64")
+		return
+	}
+	reactionType, ok := reactionTypeByName[body.ReactionType]
+	if !ok {
+		WriteError(w, http.StatusBadRequest, "reaction_type must be like, love, laugh, wow, sad or angry")
+		return
+	}
+
+	client, err := h.clientPool.GetClient()
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, "Failed to get gRPC client: "+err.Error())
+		return
+	}
+
+	mData := metadata.MD{}
+	ctx := metadata.NewOutgoingContext(context.Background(), mData)
+
+	output, err := client.SetReaction(ctx, &go_feed.SetReactionRequest{
+		PostId:       body.PostID,
+		ReactionType: reactionType,
+	})
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	WriteJSON(w, http.StatusOK, output)
+}
+
 func (h *likeHandler) GetLikeCountOfPost(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodGet {
 		WriteError(w, http.StatusMethodNotAllowed, "Method not allowed, expected POST")
@@ -98,6 +151,20 @@ func (h *likeHandler) GetLikeAccountsOfPost(w http.ResponseWriter, r *http.Reque
 		WriteError(w, http.StatusBadRequest, err.Error())
 		return
 	}
+	reactionType := go_feed.ReactionType_REACTION_TYPE_UNSPECIFIED
+	if reactionTypeName := r.URL.Query().Get("reaction_type"); reactionTypeName != "" {
+		var ok bool
+		reactionType, ok = reactionTypeByName[reactionTypeName]
+		if !ok {
+			WriteError(w, http.StatusBadRequest, "reaction_type must be like, love, laugh, wow, sad or angry")
+			return
+		}
+	}
+	pageSize, err := h.parseOptionalQueryParamUint64(r, "page_size")
+	if err != nil {
+		WriteError(w, http.StatusBadRequest, "page_size is invalid")
+		return
+	}
 
 	client, err := h.clientPool.GetClient()
 	if err != nil {
@@ -109,7 +176,12 @@ func (h *likeHandler) GetLikeAccountsOfPost(w http.ResponseWriter, r *http.Reque
 	ctx := metadata.NewOutgoingContext(context.Background(), mData)
 
 	output, err := client.GetLikeAccountsOfPost(ctx, &go_feed.GetLikeAccountsOfPostRequest{
-		PostId: postID,
+		PostId:       postID,
+		ReactionType: reactionType,
+		Page: &go_feed.PageRequest{
+			PageSize: pageSize,
+			Cursor:   r.URL.Query().Get("cursor"),
+		},
 	})
 	if err != nil {
 		WriteError(w, http.StatusInternalServerError, err.Error())
@@ -155,6 +227,229 @@ func (h *likeHandler) DeleteLike(w http.ResponseWriter, r *http.Request) {
 	WriteJSON(w, http.StatusOK, output)
 }
 
+func (h *likeHandler) DeleteReaction(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		WriteError(w, http.StatusMethodNotAllowed, "Method not allowed, expected POST")
+		return
+	}
+
+	var body struct {
+		PostID uint64 `json:"post_id"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		WriteError(w, http.StatusBadRequest, "invalid JSON body")
+		return
+	}
+	if body.PostID == 0 {
+		WriteError(w, http.StatusBadRequest, "post_id is required and must be a uint64")
+		return
+	}
+
+	client, err := h.clientPool.GetClient()
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, "Failed to get gRPC client: "+err.Error())
+		return
+	}
+
+	mData := metadata.MD{}
+	ctx := metadata.NewOutgoingContext(context.Background(), mData)
+
+	output, err := client.DeleteReaction(ctx, &go_feed.DeleteReactionRequest{
+		PostId: body.PostID,
+	})
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	WriteJSON(w, http.StatusOK, output)
+}
+
+func (h *likeHandler) HasLikedPosts(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		WriteError(w, http.StatusMethodNotAllowed, "Method not allowed, expected POST")
+		return
+	}
+
+	var body struct {
+		PostIDs []uint64 `json:"post_ids"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		WriteError(w, http.StatusBadRequest, "invalid JSON body")
+		return
+	}
+
+	client, err := h.clientPool.GetClient()
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, "Failed to get gRPC client: "+err.Error())
+		return
+	}
+
+	mData := metadata.MD{}
+	ctx := metadata.NewOutgoingContext(context.Background(), mData)
+
+	output, err := client.HasLikedPosts(ctx, &go_feed.HasLikedPostsRequest{
+		PostIds: body.PostIDs,
+	})
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	WriteJSON(w, http.StatusOK, output)
+}
+
+func (h *likeHandler) GetLikedPostsOfAccount(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		WriteError(w, http.StatusMethodNotAllowed, "Method not allowed, expected GET")
+		return
+	}
+
+	accountID, err := h.parseQueryParamUint64(r, "account_id")
+	if err != nil {
+		WriteError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	reactionType := go_feed.ReactionType_REACTION_TYPE_UNSPECIFIED
+	if reactionTypeName := r.URL.Query().Get("reaction_type"); reactionTypeName != "" {
+		var ok bool
+		reactionType, ok = reactionTypeByName[reactionTypeName]
+		if !ok {
+			WriteError(w, http.StatusBadRequest, "reaction_type must be like, love, laugh, wow, sad or angry")
+			return
+		}
+	}
+	limit, err := h.parseOptionalQueryParamUint64(r, "limit")
+	if err != nil {
+		WriteError(w, http.StatusBadRequest, "limit is invalid")
+		return
+	}
+
+	client, err := h.clientPool.GetClient()
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, "Failed to get gRPC client: "+err.Error())
+		return
+	}
+
+	mData := metadata.MD{}
+	ctx := metadata.NewOutgoingContext(context.Background(), mData)
+
+	output, err := client.GetLikedPostsOfAccount(ctx, &go_feed.GetLikedPostsOfAccountRequest{
+		AccountId:    accountID,
+		ReactionType: reactionType,
+		Cursor:       r.URL.Query().Get("cursor"),
+		Limit:        limit,
+	})
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	WriteJSON(w, http.StatusOK, output)
+}
+
+func (h *likeHandler) CreateCommentLike(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		WriteError(w, http.StatusMethodNotAllowed, "Method not allowed, expected POST")
+		return
+	}
+
+	var body struct {
+		CommentID uint64 `json:"comment_id"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		WriteError(w, http.StatusBadRequest, "invalid JSON body")
+		return
+	}
+	if body.CommentID == 0 {
+		WriteError(w, http.StatusBadRequest, "comment_id is required and must be a uint64")
+		return
+	}
+
+	client, err := h.clientPool.GetClient()
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, "Failed to get gRPC client: "+err.Error())
+		return
+	}
+
+	mData := metadata.MD{}
+	ctx := metadata.NewOutgoingContext(context.Background(), mData)
+
+	output, err := client.CreateCommentLike(ctx, &go_feed.CreateCommentLikeRequest{
+		CommentId: body.CommentID,
+	})
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	WriteJSON(w, http.StatusOK, output)
+}
+
+func (h *likeHandler) GetLikeCountOfComment(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		WriteError(w, http.StatusMethodNotAllowed, "Method not allowed, expected GET")
+		return
+	}
+
+	commentID, err := h.parseQueryParamUint64(r, "comment_id")
+	if err != nil {
+		WriteError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	client, err := h.clientPool.GetClient()
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, "Failed to get gRPC client: "+err.Error())
+		return
+	}
+
+	mData := metadata.MD{}
+	ctx := metadata.NewOutgoingContext(context.Background(), mData)
+
+	output, err := client.GetLikeCountOfComment(ctx, &go_feed.GetLikeCountOfCommentRequest{
+		CommentId: commentID,
+	})
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	WriteJSON(w, http.StatusOK, output)
+}
+
+func (h *likeHandler) DeleteCommentLike(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodDelete {
+		WriteError(w, http.StatusMethodNotAllowed, "Method not allowed, expected DELETE")
+		return
+	}
+
+	var body struct {
+		CommentID uint64 `json:"comment_id"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		WriteError(w, http.StatusBadRequest, "invalid JSON body")
+		return
+	}
+	if body.CommentID == 0 {
+		WriteError(w, http.StatusBadRequest, "comment_id is required and must be a uint64")
+		return
+	}
+
+	client, err := h.clientPool.GetClient()
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, "Failed to get gRPC client: "+err.Error())
+		return
+	}
+
+	mData := metadata.MD{}
+	ctx := metadata.NewOutgoingContext(context.Background(), mData)
+
+	output, err := client.DeleteCommentLike(ctx, &go_feed.DeleteCommentLikeRequest{
+		CommentId: body.CommentID,
+	})
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	WriteJSON(w, http.StatusOK, output)
+}
+
 // Helper method to parse a uint64 query parameter
 func (h *likeHandler) parseQueryParamUint64(r *http.Request, param string) (uint64, error) {
 	paramValue := r.URL.Query().Get(param)
@@ -163,3 +458,12 @@ func (h *likeHandler) parseQueryParamUint64(r *http.Request, param string) (uint
 	}
 	return strconv.ParseUint(paramValue, 10, 64)
 }
+
+// Helper method to parse a uint64 query parameter that defaults to 0 when absent
+func (h *likeHandler) parseOptionalQueryParamUint64(r *http.Request, param string) (uint64, error) {
+	paramValue := r.URL.Query().Get(param)
+	if paramValue == "" {
+		return 0, nil
+	}
+	return strconv.ParseUint(paramValue, 10, 64)
+}
@@ -0,0 +1,237 @@
+package http
+
+import (
+	"GoFeed/internal/generated/api/go_feed"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"google.golang.org/grpc/metadata"
+)
+
+type moderationHandler struct {
+	clientPool *grpcClientPool
+}
+
+func NewModerationHandler(clientPool *grpcClientPool) *moderationHandler {
+	return &moderationHandler{clientPool: clientPool}
+}
+
+var (
+	contentTypeByName = map[string]go_feed.ContentType{
+		"post":    go_feed.ContentType_CONTENT_TYPE_POST,
+		"comment": go_feed.ContentType_CONTENT_TYPE_COMMENT,
+	}
+	reportStatusByName = map[string]go_feed.ReportStatus{
+		"open":      go_feed.ReportStatus_REPORT_STATUS_OPEN,
+		"resolved":  go_feed.ReportStatus_REPORT_STATUS_RESOLVED,
+		"dismissed": go_feed.ReportStatus_REPORT_STATUS_DISMISSED,
+	}
+)
+
+func (h moderationHandler) ReportContent(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		WriteError(w, http.StatusMethodNotAllowed, "Method not allowed, expected POST")
+		return
+	}
+
+	var body struct {
+		ContentType string `json:"content_type"`
+		ContentID   uint64 `json:"content_id"`
+		Reason      string `json:"reason"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		WriteError(w, http.StatusBadRequest, "Invalid JSON body")
+		return
+	}
+	contentType, ok := contentTypeByName[body.ContentType]
+	if !ok {
+		WriteError(w, http.StatusBadRequest, "content_type must be post or comment")
+		return
+	}
+	if body.ContentID == 0 {
+		WriteError(w, http.StatusBadRequest, "content_id is required and must be a non-zero uint64")
+		return
+	}
+	if body.Reason == "" {
+		WriteError(w, http.StatusBadRequest, "reason is required")
+		return
+	}
+
+	client, err := h.clientPool.GetClient()
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, "Failed to get gRPC client: "+err.Error())
+		return
+	}
+
+	mData := metadata.MD{}
+	ctx := metadata.NewOutgoingContext(context.Background(), mData)
+
+	output, err := client.ReportContent(ctx, &go_feed.ReportContentRequest{
+		ContentType: contentType,
+		ContentId:   body.ContentID,
+		Reason:      body.Reason,
+	})
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, "Failed to report content: "+err.Error())
+		return
+	}
+
+	WriteJSON(w, http.StatusOK, output)
+}
+
+func (h moderationHandler) ListReports(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		WriteError(w, http.StatusMethodNotAllowed, "Method not allowed, expected GET")
+		return
+	}
+
+	reportStatus := go_feed.ReportStatus_REPORT_STATUS_UNSPECIFIED
+	if statusName := r.URL.Query().Get("status"); statusName != "" {
+		var ok bool
+		reportStatus, ok = reportStatusByName[statusName]
+		if !ok {
+			WriteError(w, http.StatusBadRequest, "status must be open, resolved or dismissed")
+			return
+		}
+	}
+	offset, err := h.parseOptionalQueryParamUint64(r, "offset")
+	if err != nil {
+		WriteError(w, http.StatusBadRequest, "offset is invalid")
+		return
+	}
+	limit, err := h.parseOptionalQueryParamUint64(r, "limit")
+	if err != nil {
+		WriteError(w, http.StatusBadRequest, "limit is invalid")
+		return
+	}
+
+	client, err := h.clientPool.GetClient()
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, "Failed to get gRPC client: "+err.Error())
+		return
+	}
+
+	mData := metadata.MD{}
+	ctx := metadata.NewOutgoingContext(context.Background(), mData)
+
+	output, err := client.ListReports(ctx, &go_feed.ListReportsRequest{
+		Status: reportStatus,
+		Offset: offset,
+		Limit:  limit,
+	})
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, "Failed to list reports: "+err.Error())
+		return
+	}
+
+	WriteJSON(w, http.StatusOK, output)
+}
+
+func (h moderationHandler) ResolveReport(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		WriteError(w, http.StatusMethodNotAllowed, "Method not allowed, expected POST")
+		return
+	}
+
+	var body struct {
+		ReportID uint64 `json:"report_id"`
+		Status   string `json:"status"`
+		Note     string `json:"note"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		WriteError(w, http.StatusBadRequest, "Invalid JSON body")
+		return
+	}
+	if body.ReportID == 0 {
+		WriteError(w, http.StatusBadRequest, "report_id is required and must be a non-zero uint64")
+		return
+	}
+	reportStatus, ok := reportStatusByName[body.Status]
+	if !ok || reportStatus == go_feed.ReportStatus_REPORT_STATUS_OPEN {
+		WriteError(w, http.StatusBadRequest, "status must be resolved or dismissed")
+		return
+	}
+
+	client, err := h.clientPool.GetClient()
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, "Failed to get gRPC client: "+err.Error())
+		return
+	}
+
+	mData := metadata.MD{}
+	ctx := metadata.NewOutgoingContext(context.Background(), mData)
+
+	output, err := client.ResolveReport(ctx, &go_feed.ResolveReportRequest{
+		ReportId: body.ReportID,
+		Status:   reportStatus,
+		Note:     body.Note,
+	})
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, "Failed to resolve report: "+err.Error())
+		return
+	}
+
+	WriteJSON(w, http.StatusOK, output)
+}
+
+func (h moderationHandler) TakedownContent(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		WriteError(w, http.StatusMethodNotAllowed, "Method not allowed, expected POST")
+		return
+	}
+
+	var body struct {
+		ContentType string `json:"content_type"`
+		ContentID   uint64 `json:"content_id"`
+		Reason      string `json:"reason"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		WriteError(w, http.StatusBadRequest, "Invalid JSON body")
+		return
+	}
+	contentType, ok := contentTypeByName[body.ContentType]
+	if !ok {
+		WriteError(w, http.StatusBadRequest, "content_type must be post or comment")
+		return
+	}
+	if body.ContentID == 0 {
+		WriteError(w, http.StatusBadRequest, "content_id is required and must be a non-zero uint64")
+		return
+	}
+	if body.Reason == "" {
+		WriteError(w, http.StatusBadRequest, "reason is required")
+		return
+	}
+
+	client, err := h.clientPool.GetClient()
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, "Failed to get gRPC client: "+err.Error())
+		return
+	}
+
+	mData := metadata.MD{}
+	ctx := metadata.NewOutgoingContext(context.Background(), mData)
+
+	output, err := client.TakedownContent(ctx, &go_feed.TakedownContentRequest{
+		ContentType: contentType,
+		ContentId:   body.ContentID,
+		Reason:      body.Reason,
+	})
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, "Failed to take down content: "+err.Error())
+		return
+	}
+
+	WriteJSON(w, http.StatusOK, output)
+}
+
+// Helper method to parse a uint64 query parameter that defaults to 0 when absent
+func (h moderationHandler) parseOptionalQueryParamUint64(r *http.Request, param string) (uint64, error) {
+	paramValue := r.URL.Query().Get(param)
+	if paramValue == "" {
+		return 0, nil
+	}
+	return strconv.ParseUint(paramValue, 10, 64)
+}
@@ -4,10 +4,13 @@ import (
 	"GoFeed/internal/generated/api/go_feed"
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"strconv"
+	"time"
 
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type postHandler struct {
@@ -26,6 +29,11 @@ func (h postHandler) CreatePost(w http.ResponseWriter, r *http.Request) {
 
 	var body struct {
 		Content string `json:"content"`
+		Poll    *struct {
+			Options        []string  `json:"options"`
+			ClosesAt       time.Time `json:"closes_at"`
+			MultipleChoice bool      `json:"multiple_choice"`
+		} `json:"poll"`
 	}
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		WriteError(w, http.StatusBadRequest, "Invalid JSON body")
@@ -35,6 +43,14 @@ func (h postHandler) CreatePost(w http.ResponseWriter, r *http.Request) {
 		WriteError(w, http.StatusBadRequest, "content is required and must be a non-empty string")
 		return
 	}
+	var poll *go_feed.NewPoll
+	if body.Poll != nil {
+		poll = &go_feed.NewPoll{
+			Options:        body.Poll.Options,
+			ClosesAt:       h.timeToTimestamp(body.Poll.ClosesAt),
+			MultipleChoice: body.Poll.MultipleChoice,
+		}
+	}
 
 	client, err := h.clientPool.GetClient()
 	if err != nil {
@@ -47,6 +63,7 @@ func (h postHandler) CreatePost(w http.ResponseWriter, r *http.Request) {
 
 	output, err := client.CreatePost(ctx, &go_feed.CreatePostRequest{
 		Content: body.Content,
+		Poll:    poll,
 	})
 	if err != nil {
 		WriteError(w, http.StatusInternalServerError, "Failed to create post: "+err.Error())
@@ -109,6 +126,11 @@ func (h postHandler) GetPostOfAccount(w http.ResponseWriter, r *http.Request) {
 		WriteError(w, http.StatusBadRequest, "account_id is invalid")
 		return
 	}
+	pageSize, err := h.parseOptionalQueryParamUint64(r, "page_size")
+	if err != nil {
+		WriteError(w, http.StatusBadRequest, "page_size is invalid")
+		return
+	}
 
 	client, err := h.clientPool.GetClient()
 	if err != nil {
@@ -121,6 +143,10 @@ func (h postHandler) GetPostOfAccount(w http.ResponseWriter, r *http.Request) {
 
 	output, err := client.GetPostOfAccount(ctx, &go_feed.GetPostOfAccountRequest{
 		AccountId: accountID,
+		Page: &go_feed.PageRequest{
+			PageSize: pageSize,
+			Cursor:   r.URL.Query().Get("cursor"),
+		},
 	})
 	if err != nil {
 		WriteError(w, http.StatusInternalServerError, "Failed to get posts: "+err.Error())
@@ -175,3 +201,563 @@ func (h postHandler) UpdatePost(w http.ResponseWriter, r *http.Request) {
 
 	WriteJSON(w, http.StatusOK, output)
 }
+
+func (h postHandler) DeletePost(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		WriteError(w, http.StatusMethodNotAllowed, "Method not allowed, expected POST")
+		return
+	}
+
+	var body struct {
+		PostID uint64 `json:"post_id"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		WriteError(w, http.StatusBadRequest, "Invalid JSON body")
+		return
+	}
+	if body.PostID == 0 {
+		WriteError(w, http.StatusBadRequest, "post_id is required and must be a non-zero uint64")
+		return
+	}
+
+	client, err := h.clientPool.GetClient()
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, "Failed to get gRPC client: "+err.Error())
+		return
+	}
+
+	mData := metadata.MD{}
+	ctx := metadata.NewOutgoingContext(context.Background(), mData)
+
+	output, err := client.DeletePost(ctx, &go_feed.DeletePostRequest{
+		PostId: body.PostID,
+	})
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, "Failed to delete post: "+err.Error())
+		return
+	}
+
+	WriteJSON(w, http.StatusOK, output)
+}
+
+func (h postHandler) RestorePost(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		WriteError(w, http.StatusMethodNotAllowed, "Method not allowed, expected POST")
+		return
+	}
+
+	var body struct {
+		PostID uint64 `json:"post_id"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		WriteError(w, http.StatusBadRequest, "Invalid JSON body")
+		return
+	}
+	if body.PostID == 0 {
+		WriteError(w, http.StatusBadRequest, "post_id is required and must be a non-zero uint64")
+		return
+	}
+
+	client, err := h.clientPool.GetClient()
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, "Failed to get gRPC client: "+err.Error())
+		return
+	}
+
+	mData := metadata.MD{}
+	ctx := metadata.NewOutgoingContext(context.Background(), mData)
+
+	output, err := client.RestorePost(ctx, &go_feed.RestorePostRequest{
+		PostId: body.PostID,
+	})
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, "Failed to restore post: "+err.Error())
+		return
+	}
+
+	WriteJSON(w, http.StatusOK, output)
+}
+
+func (h postHandler) ListDeletedPosts(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		WriteError(w, http.StatusMethodNotAllowed, "Method not allowed, expected GET")
+		return
+	}
+
+	client, err := h.clientPool.GetClient()
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, "Failed to get gRPC client: "+err.Error())
+		return
+	}
+
+	mData := metadata.MD{}
+	ctx := metadata.NewOutgoingContext(context.Background(), mData)
+
+	output, err := client.ListDeletedPosts(ctx, &go_feed.ListDeletedPostsRequest{})
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, "Failed to list deleted posts: "+err.Error())
+		return
+	}
+
+	WriteJSON(w, http.StatusOK, output)
+}
+
+func (h postHandler) GetPostsByHashtag(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		WriteError(w, http.StatusMethodNotAllowed, "Method not allowed, expected GET")
+		return
+	}
+
+	hashtag := r.URL.Query().Get("hashtag")
+	if hashtag == "" {
+		WriteError(w, http.StatusBadRequest, "hashtag is required")
+		return
+	}
+	offset, err := h.parseOptionalQueryParamUint64(r, "offset")
+	if err != nil {
+		WriteError(w, http.StatusBadRequest, "offset is invalid")
+		return
+	}
+	limit, err := h.parseOptionalQueryParamUint64(r, "limit")
+	if err != nil {
+		WriteError(w, http.StatusBadRequest, "limit is invalid")
+		return
+	}
+
+	client, err := h.clientPool.GetClient()
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, "Failed to get gRPC client: "+err.Error())
+		return
+	}
+
+	mData := metadata.MD{}
+	ctx := metadata.NewOutgoingContext(context.Background(), mData)
+
+	output, err := client.GetPostsByHashtag(ctx, &go_feed.GetPostsByHashtagRequest{
+		Hashtag: hashtag,
+		Offset:  offset,
+		Limit:   limit,
+	})
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, "Failed to get posts: "+err.Error())
+		return
+	}
+
+	WriteJSON(w, http.StatusOK, output)
+}
+
+func (h postHandler) GetPostsMentioningAccount(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		WriteError(w, http.StatusMethodNotAllowed, "Method not allowed, expected GET")
+		return
+	}
+
+	accountID, err := h.parseQueryParamUint64(r, "account_id")
+	if err != nil {
+		WriteError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	offset, err := h.parseOptionalQueryParamUint64(r, "offset")
+	if err != nil {
+		WriteError(w, http.StatusBadRequest, "offset is invalid")
+		return
+	}
+	limit, err := h.parseOptionalQueryParamUint64(r, "limit")
+	if err != nil {
+		WriteError(w, http.StatusBadRequest, "limit is invalid")
+		return
+	}
+
+	client, err := h.clientPool.GetClient()
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, "Failed to get gRPC client: "+err.Error())
+		return
+	}
+
+	mData := metadata.MD{}
+	ctx := metadata.NewOutgoingContext(context.Background(), mData)
+
+	output, err := client.GetPostsMentioningAccount(ctx, &go_feed.GetPostsMentioningAccountRequest{
+		AccountId: accountID,
+		Offset:    offset,
+		Limit:     limit,
+	})
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, "Failed to get posts: "+err.Error())
+		return
+	}
+
+	WriteJSON(w, http.StatusOK, output)
+}
+
+func (h postHandler) SearchPosts(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		WriteError(w, http.StatusMethodNotAllowed, "Method not allowed, expected GET")
+		return
+	}
+
+	query := r.URL.Query().Get("query")
+	if query == "" {
+		WriteError(w, http.StatusBadRequest, "query is required")
+		return
+	}
+	offset, err := h.parseOptionalQueryParamUint64(r, "offset")
+	if err != nil {
+		WriteError(w, http.StatusBadRequest, "offset is invalid")
+		return
+	}
+	limit, err := h.parseOptionalQueryParamUint64(r, "limit")
+	if err != nil {
+		WriteError(w, http.StatusBadRequest, "limit is invalid")
+		return
+	}
+
+	client, err := h.clientPool.GetClient()
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, "Failed to get gRPC client: "+err.Error())
+		return
+	}
+
+	mData := metadata.MD{}
+	ctx := metadata.NewOutgoingContext(context.Background(), mData)
+
+	output, err := client.SearchPosts(ctx, &go_feed.SearchPostsRequest{
+		Query:  query,
+		Offset: offset,
+		Limit:  limit,
+	})
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, "Failed to search posts: "+err.Error())
+		return
+	}
+
+	WriteJSON(w, http.StatusOK, output)
+}
+
+func (h postHandler) CreateDraft(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		WriteError(w, http.StatusMethodNotAllowed, "Method not allowed, expected POST")
+		return
+	}
+
+	var body struct {
+		Content   string    `json:"content"`
+		PublishAt time.Time `json:"publish_at"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		WriteError(w, http.StatusBadRequest, "Invalid JSON body")
+		return
+	}
+	if body.Content == "" {
+		WriteError(w, http.StatusBadRequest, "content is required and must be a non-empty string")
+		return
+	}
+
+	client, err := h.clientPool.GetClient()
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, "Failed to get gRPC client: "+err.Error())
+		return
+	}
+
+	mData := metadata.MD{}
+	ctx := metadata.NewOutgoingContext(context.Background(), mData)
+
+	output, err := client.CreateDraft(ctx, &go_feed.CreateDraftRequest{
+		Content:   body.Content,
+		PublishAt: h.timeToTimestamp(body.PublishAt),
+	})
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, "Failed to create draft: "+err.Error())
+		return
+	}
+
+	WriteJSON(w, http.StatusOK, output)
+}
+
+func (h postHandler) ListDrafts(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		WriteError(w, http.StatusMethodNotAllowed, "Method not allowed, expected GET")
+		return
+	}
+
+	client, err := h.clientPool.GetClient()
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, "Failed to get gRPC client: "+err.Error())
+		return
+	}
+
+	mData := metadata.MD{}
+	ctx := metadata.NewOutgoingContext(context.Background(), mData)
+
+	output, err := client.ListDrafts(ctx, &go_feed.ListDraftsRequest{})
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, "Failed to list drafts: "+err.Error())
+		return
+	}
+
+	WriteJSON(w, http.StatusOK, output)
+}
+
+func (h postHandler) UpdateDraft(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPut {
+		WriteError(w, http.StatusMethodNotAllowed, "Method not allowed, expected PUT")
+		return
+	}
+
+	var body struct {
+		PostID    uint64    `json:"post_id"`
+		Content   string    `json:"content"`
+		PublishAt time.Time `json:"publish_at"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		WriteError(w, http.StatusBadRequest, "Invalid JSON body")
+		return
+	}
+	if body.PostID == 0 {
+		WriteError(w, http.StatusBadRequest, "post_id is required and must be a non-zero uint64")
+		return
+	}
+	if body.Content == "" {
+		WriteError(w, http.StatusBadRequest, "content is required and must be a non-empty string")
+		return
+	}
+
+	client, err := h.clientPool.GetClient()
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, "Failed to get gRPC client: "+err.Error())
+		return
+	}
+
+	mData := metadata.MD{}
+	ctx := metadata.NewOutgoingContext(context.Background(), mData)
+
+	output, err := client.UpdateDraft(ctx, &go_feed.UpdateDraftRequest{
+		PostId:    body.PostID,
+		Content:   body.Content,
+		PublishAt: h.timeToTimestamp(body.PublishAt),
+	})
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, "Failed to update draft: "+err.Error())
+		return
+	}
+
+	WriteJSON(w, http.StatusOK, output)
+}
+
+func (h postHandler) PublishDraft(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		WriteError(w, http.StatusMethodNotAllowed, "Method not allowed, expected POST")
+		return
+	}
+
+	var body struct {
+		PostID    uint64    `json:"post_id"`
+		PublishAt time.Time `json:"publish_at"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		WriteError(w, http.StatusBadRequest, "Invalid JSON body")
+		return
+	}
+	if body.PostID == 0 {
+		WriteError(w, http.StatusBadRequest, "post_id is required and must be a non-zero uint64")
+		return
+	}
+
+	client, err := h.clientPool.GetClient()
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, "Failed to get gRPC client: "+err.Error())
+		return
+	}
+
+	mData := metadata.MD{}
+	ctx := metadata.NewOutgoingContext(context.Background(), mData)
+
+	output, err := client.PublishDraft(ctx, &go_feed.PublishDraftRequest{
+		PostId:    body.PostID,
+		PublishAt: h.timeToTimestamp(body.PublishAt),
+	})
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, "Failed to publish draft: "+err.Error())
+		return
+	}
+
+	WriteJSON(w, http.StatusOK, output)
+}
+
+func (h postHandler) PinPost(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		WriteError(w, http.StatusMethodNotAllowed, "Method not allowed, expected POST")
+		return
+	}
+
+	var body struct {
+		PostID uint64 `json:"post_id"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		WriteError(w, http.StatusBadRequest, "Invalid JSON body")
+		return
+	}
+	if body.PostID == 0 {
+		WriteError(w, http.StatusBadRequest, "post_id is required and must be a non-zero uint64")
+		return
+	}
+
+	client, err := h.clientPool.GetClient()
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, "Failed to get gRPC client: "+err.Error())
+		return
+	}
+
+	mData := metadata.MD{}
+	ctx := metadata.NewOutgoingContext(context.Background(), mData)
+
+	output, err := client.PinPost(ctx, &go_feed.PinPostRequest{
+		PostId: body.PostID,
+	})
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, "Failed to pin post: "+err.Error())
+		return
+	}
+
+	WriteJSON(w, http.StatusOK, output)
+}
+
+func (h postHandler) UnpinPost(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		WriteError(w, http.StatusMethodNotAllowed, "Method not allowed, expected POST")
+		return
+	}
+
+	var body struct {
+		PostID uint64 `json:"post_id"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		WriteError(w, http.StatusBadRequest, "Invalid JSON body")
+		return
+	}
+	if body.PostID == 0 {
+		WriteError(w, http.StatusBadRequest, "post_id is required and must be a non-zero uint64")
+		return
+	}
+
+	client, err := h.clientPool.GetClient()
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, "Failed to get gRPC client: "+err.Error())
+		return
+	}
+
+	mData := metadata.MD{}
+	ctx := metadata.NewOutgoingContext(context.Background(), mData)
+
+	output, err := client.UnpinPost(ctx, &go_feed.UnpinPostRequest{
+		PostId: body.PostID,
+	})
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, "Failed to unpin post: "+err.Error())
+		return
+	}
+
+	WriteJSON(w, http.StatusOK, output)
+}
+
+func (h postHandler) VotePoll(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		WriteError(w, http.StatusMethodNotAllowed, "Method not allowed, expected POST")
+		return
+	}
+
+	var body struct {
+		PollID    uint64   `json:"poll_id"`
+		OptionIDs []uint64 `json:"option_ids"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		WriteError(w, http.StatusBadRequest, "Invalid JSON body")
+		return
+	}
+	if body.PollID == 0 {
+		WriteError(w, http.StatusBadRequest, "poll_id is required and must be a non-zero uint64")
+		return
+	}
+	if len(body.OptionIDs) == 0 {
+		WriteError(w, http.StatusBadRequest, "option_ids is required and must be a non-empty list")
+		return
+	}
+
+	client, err := h.clientPool.GetClient()
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, "Failed to get gRPC client: "+err.Error())
+		return
+	}
+
+	mData := metadata.MD{}
+	ctx := metadata.NewOutgoingContext(context.Background(), mData)
+
+	output, err := client.VotePoll(ctx, &go_feed.VotePollRequest{
+		PollId:    body.PollID,
+		OptionIds: body.OptionIDs,
+	})
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, "Failed to vote in poll: "+err.Error())
+		return
+	}
+
+	WriteJSON(w, http.StatusOK, output)
+}
+
+func (h postHandler) GetPollResults(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		WriteError(w, http.StatusMethodNotAllowed, "Method not allowed, expected GET")
+		return
+	}
+
+	pollID, err := h.parseQueryParamUint64(r, "poll_id")
+	if err != nil {
+		WriteError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	client, err := h.clientPool.GetClient()
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, "Failed to get gRPC client: "+err.Error())
+		return
+	}
+
+	mData := metadata.MD{}
+	ctx := metadata.NewOutgoingContext(context.Background(), mData)
+
+	output, err := client.GetPollResults(ctx, &go_feed.GetPollResultsRequest{
+		PollId: pollID,
+	})
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, "Failed to get poll results: "+err.Error())
+		return
+	}
+
+	WriteJSON(w, http.StatusOK, output)
+}
+
+// Helper method to convert an optional time from a JSON body into a protobuf timestamp
+func (h postHandler) timeToTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+// Helper method to parse a uint64 query parameter
+func (h postHandler) parseQueryParamUint64(r *http.Request, param string) (uint64, error) {
+	paramValue := r.URL.Query().Get(param)
+	if paramValue == "" {
+		return 0, fmt.Errorf("%s is required", param)
+	}
+	return strconv.ParseUint(paramValue, 10, 64)
+}
+
+// Helper method to parse a uint64 query parameter that defaults to 0 when absent
+func (h postHandler) parseOptionalQueryParamUint64(r *http.Request, param string) (uint64, error) {
+	paramValue := r.URL.Query().Get(param)
+	if paramValue == "" {
+		return 0, nil
+	}
+	return strconv.ParseUint(paramValue, 10, 64)
+}
@@ -2,11 +2,15 @@ package logic
 
 import (
 	"GoFeed/internal/dataaccess/database"
+	"GoFeed/internal/dataaccess/search"
 	"GoFeed/internal/generated/api/go_feed"
+	"GoFeed/internal/utils"
 	"context"
 	"errors"
+	"strings"
 
 	"github.com/doug-martin/goqu/v9"
+	"github.com/samber/lo"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -32,9 +36,38 @@ type CreateSessionOutput struct {
 	Token   string
 }
 
+type SearchAccountsParams struct {
+	Token  string
+	Query  string
+	Offset uint64
+	Limit  uint64
+}
+
+type SearchAccountsOutput struct {
+	AccountList []*go_feed.Account
+}
+
+type SetLikesHiddenParams struct {
+	Token       string
+	LikesHidden bool
+}
+
+type SetAccountPrivateParams struct {
+	Token     string
+	IsPrivate bool
+}
+
+const (
+	defaultAccountPageLimit = 20
+	maxAccountPageLimit     = 100
+)
+
 type AccountLogic interface {
 	CreateAccount(ctx context.Context, params CreateAccountParams) (CreateAccountOutput, error)
 	CreateSession(ctx context.Context, params CreateSessionParams) (CreateSessionOutput, error)
+	SearchAccounts(ctx context.Context, params SearchAccountsParams) (SearchAccountsOutput, error)
+	SetLikesHidden(ctx context.Context, params SetLikesHiddenParams) error
+	SetAccountPrivate(ctx context.Context, params SetAccountPrivateParams) error
 }
 
 type accountLogic struct {
@@ -42,6 +75,7 @@ type accountLogic struct {
 	accountDataAccessor database.AccountDataAccessor
 	hashLogic           HashLogic
 	tokenLogic          TokenLogic
+	searcher            search.Search
 	idGenerator         *snowNode
 	logger              *zap.Logger
 }
@@ -51,6 +85,7 @@ func NewAccountLogic(
 	accountDataAccessor database.AccountDataAccessor,
 	hashLogic HashLogic,
 	tokenLogic TokenLogic,
+	searcher search.Search,
 	idGenerator *snowNode,
 	logger *zap.Logger,
 ) AccountLogic {
@@ -59,6 +94,7 @@ func NewAccountLogic(
 		accountDataAccessor: accountDataAccessor,
 		hashLogic:           hashLogic,
 		tokenLogic:          tokenLogic,
+		searcher:            searcher,
 		idGenerator:         idGenerator,
 		logger:              logger,
 	}
@@ -114,6 +150,14 @@ func (a accountLogic) CreateAccount(ctx context.Context, params CreateAccountPar
 		return CreateAccountOutput{}, txErr
 	}
 
+	err = a.searcher.IndexAccount(ctx, search.AccountDocument{
+		ID:          accountID,
+		AccountName: params.AccountName,
+	})
+	if err != nil {
+		utils.LoggerWithContext(ctx, a.logger).With(zap.Error(err)).Warn("failed to index account for search")
+	}
+
 	return CreateAccountOutput{
 		ID:          accountID,
 		AccountName: params.AccountName,
@@ -143,3 +187,62 @@ func (a accountLogic) CreateSession(ctx context.Context, params CreateSessionPar
 		Token:   token,
 	}, nil
 }
+
+func (a accountLogic) SearchAccounts(ctx context.Context, params SearchAccountsParams) (SearchAccountsOutput, error) {
+	_, _, err := a.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
+	if err != nil {
+		return SearchAccountsOutput{}, err
+	}
+	if strings.TrimSpace(params.Query) == "" {
+		return SearchAccountsOutput{}, status.Error(codes.InvalidArgument, "query is required")
+	}
+
+	limit := params.Limit
+	if limit == 0 {
+		limit = defaultAccountPageLimit
+	}
+	accountIDList, err := a.searcher.SearchAccounts(ctx, params.Query, params.Offset, min(limit, maxAccountPageLimit))
+	if err != nil {
+		return SearchAccountsOutput{}, err
+	}
+
+	accountList, err := a.accountDataAccessor.GetAccountByIDs(ctx, accountIDList)
+	if err != nil {
+		return SearchAccountsOutput{}, err
+	}
+	accountMap := lo.SliceToMap(accountList, func(item database.Account) (uint64, database.Account) {
+		return item.ID, item
+	})
+
+	// Keep the ranking returned by the search engine.
+	protoAccountList := make([]*go_feed.Account, 0, len(accountList))
+	for _, accountID := range accountIDList {
+		if account, ok := accountMap[accountID]; ok {
+			protoAccountList = append(protoAccountList, a.databaseAccountToProtoAccount(account))
+		}
+	}
+	return SearchAccountsOutput{
+		AccountList: protoAccountList,
+	}, nil
+}
+
+// SetLikesHidden hides the posts the account liked from other accounts, or shows them again. The account itself
+// always sees them.
+func (a accountLogic) SetLikesHidden(ctx context.Context, params SetLikesHiddenParams) error {
+	accountID, _, err := a.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
+	if err != nil {
+		return err
+	}
+	return a.accountDataAccessor.UpdateLikesHidden(ctx, accountID, params.LikesHidden)
+}
+
+// SetAccountPrivate makes new followers of the account need its approval and hides its posts from accounts that do not
+// follow it, or makes it public again. Existing followers are kept either way, and requests that are still pending
+// when the account becomes public can still be accepted.
+func (a accountLogic) SetAccountPrivate(ctx context.Context, params SetAccountPrivateParams) error {
+	accountID, _, err := a.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
+	if err != nil {
+		return err
+	}
+	return a.accountDataAccessor.UpdateIsPrivate(ctx, accountID, params.IsPrivate)
+}
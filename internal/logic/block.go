@@ -0,0 +1,330 @@
+package logic
+
+import (
+	"GoFeed/internal/dataaccess/database"
+	"GoFeed/internal/generated/api/go_feed"
+	"context"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type BlockAccountParams struct {
+	Token     string
+	AccountID uint64
+}
+type UnblockAccountParams struct {
+	Token     string
+	AccountID uint64
+}
+type ListBlockedAccountsParams struct {
+	Token  string
+	Cursor string
+	Limit  uint64
+}
+type ListBlockedAccountsOutput struct {
+	AccountList []*go_feed.Account
+	NextCursor  string
+}
+type MuteAccountParams struct {
+	Token     string
+	AccountID uint64
+}
+type UnmuteAccountParams struct {
+	Token     string
+	AccountID uint64
+}
+type ListMutedAccountsParams struct {
+	Token  string
+	Cursor string
+	Limit  uint64
+}
+type ListMutedAccountsOutput struct {
+	AccountList []*go_feed.Account
+	NextCursor  string
+}
+
+// BlockLogic manages blocks and mutes between accounts. A block works both ways: neither account can follow the other
+// or comment on, react to or see the posts of the other. A mute is one way and silent, the posts of the muted account
+// are left out of the lists of posts the muter browses, but the muted account can still interact with the muter.
+// The other logics check blocks and mutes through IsBlockedEitherWay, GetBlockedAccountIDs and GetMutedAccountIDs,
+// and whether an account can see a post, which also depends on the privacy of its author, through
+// GetHiddenAccountIDs, CheckCanViewPost and CheckCanViewComment.
+type BlockLogic interface {
+	BlockAccount(ctx context.Context, params BlockAccountParams) error
+	UnblockAccount(ctx context.Context, params UnblockAccountParams) error
+	ListBlockedAccounts(ctx context.Context, params ListBlockedAccountsParams) (ListBlockedAccountsOutput, error)
+	MuteAccount(ctx context.Context, params MuteAccountParams) error
+	UnmuteAccount(ctx context.Context, params UnmuteAccountParams) error
+	ListMutedAccounts(ctx context.Context, params ListMutedAccountsParams) (ListMutedAccountsOutput, error)
+	IsBlockedEitherWay(ctx context.Context, accountID uint64, otherID uint64) (bool, error)
+	GetBlockedAccountIDs(ctx context.Context, accountID uint64, otherIDs []uint64) ([]uint64, error)
+	GetAccountIDsBlockedBy(ctx context.Context, accountID uint64, otherIDs []uint64) ([]uint64, error)
+	GetMutedAccountIDs(ctx context.Context, accountID uint64, otherIDs []uint64) ([]uint64, error)
+	GetHiddenAccountIDs(ctx context.Context, accountID uint64, otherIDs []uint64) ([]uint64, error)
+	CheckCanViewPost(ctx context.Context, viewerID uint64, post database.Post) error
+	CheckCanViewComment(ctx context.Context, viewerID uint64, post database.Post, comment database.Comment) error
+}
+
+type blockLogic struct {
+	goquDatabase              *goqu.Database
+	blockDataAccessor         database.BlockDataAccessor
+	muteDataAccessor          database.MuteDataAccessor
+	followDataAccessor        database.FollowDataAccessor
+	followRequestDataAccessor database.FollowRequestDataAccessor
+	accountDataAccessor       database.AccountDataAccessor
+	tokenLogic                TokenLogic
+	counterLogic              CounterLogic
+	logger                    *zap.Logger
+}
+
+func NewBlockLogic(
+	goquDatabase *goqu.Database,
+	blockDataAccessor database.BlockDataAccessor,
+	muteDataAccessor database.MuteDataAccessor,
+	followDataAccessor database.FollowDataAccessor,
+	followRequestDataAccessor database.FollowRequestDataAccessor,
+	accountDataAccessor database.AccountDataAccessor,
+	tokenLogic TokenLogic,
+	counterLogic CounterLogic,
+	logger *zap.Logger,
+) BlockLogic {
+	return &blockLogic{
+		goquDatabase:              goquDatabase,
+		blockDataAccessor:         blockDataAccessor,
+		muteDataAccessor:          muteDataAccessor,
+		followDataAccessor:        followDataAccessor,
+		followRequestDataAccessor: followRequestDataAccessor,
+		accountDataAccessor:       accountDataAccessor,
+		tokenLogic:                tokenLogic,
+		counterLogic:              counterLogic,
+		logger:                    logger,
+	}
+}
+
+func getAccountPageLimit(limit uint64) uint64 {
+	if limit == 0 {
+		return defaultAccountPageLimit
+	}
+	return min(limit, maxAccountPageLimit)
+}
+
+// getAccountsInOrder loads accounts and returns them in the order of accountIDList, skipping the ones that no longer
+// exist.
+func (b blockLogic) getAccountsInOrder(ctx context.Context, accountIDList []uint64) ([]*go_feed.Account, error) {
+	accountList, err := b.accountDataAccessor.GetAccountByIDs(ctx, accountIDList)
+	if err != nil {
+		return nil, err
+	}
+	accountMap := lo.SliceToMap(accountList, func(item database.Account) (uint64, database.Account) {
+		return item.ID, item
+	})
+	return lo.FilterMap(accountIDList, func(item uint64, _ int) (*go_feed.Account, bool) {
+		account, ok := accountMap[item]
+		return &go_feed.Account{
+			Id:          account.ID,
+			AccountName: account.Account_name,
+		}, ok
+	}), nil
+}
+
+// getTargetAccount returns the account that accountID is blocking or muting, refusing accountID itself.
+func (b blockLogic) getTargetAccount(ctx context.Context, accountID uint64, targetID uint64) (database.Account, error) {
+	if accountID == targetID {
+		return database.Account{}, status.Error(codes.InvalidArgument, "an account cannot block or mute itself")
+	}
+	return b.accountDataAccessor.GetAccountByID(ctx, targetID)
+}
+
+// BlockAccount blocks an account. The follows and pending follow requests between the two accounts are removed in
+// both directions.
+func (b blockLogic) BlockAccount(ctx context.Context, params BlockAccountParams) error {
+	accountID, _, err := b.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
+	if err != nil {
+		return err
+	}
+	blocked, err := b.getTargetAccount(ctx, accountID, params.AccountID)
+	if err != nil {
+		return err
+	}
+	var unfollowed, unfollowedBy bool
+	txErr := b.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		err := b.blockDataAccessor.WithDatabase(td).CreateBlock(ctx, database.Block{
+			AccountID: accountID,
+			BlockedID: blocked.ID,
+			CreatedAt: time.Now(),
+		})
+		if err != nil {
+			return err
+		}
+		unfollowed, err = b.followDataAccessor.WithDatabase(td).DeleteFollow(ctx, database.Follow{
+			AccountID:   accountID,
+			FollowingID: blocked.ID,
+		})
+		if err != nil {
+			return err
+		}
+		unfollowedBy, err = b.followDataAccessor.WithDatabase(td).DeleteFollow(ctx, database.Follow{
+			AccountID:   blocked.ID,
+			FollowingID: accountID,
+		})
+		if err != nil {
+			return err
+		}
+		if _, err = b.followRequestDataAccessor.WithDatabase(td).DeleteFollowRequest(ctx, accountID, blocked.ID); err != nil {
+			return err
+		}
+		_, err = b.followRequestDataAccessor.WithDatabase(td).DeleteFollowRequest(ctx, blocked.ID, accountID)
+		return err
+	})
+	if txErr != nil {
+		return txErr
+	}
+	if unfollowed {
+		b.counterLogic.IncrementFollowCounts(ctx, accountID, blocked.ID, -1)
+	}
+	if unfollowedBy {
+		b.counterLogic.IncrementFollowCounts(ctx, blocked.ID, accountID, -1)
+	}
+	return nil
+}
+
+// UnblockAccount lifts a block. The follows it removed are not restored.
+func (b blockLogic) UnblockAccount(ctx context.Context, params UnblockAccountParams) error {
+	accountID, _, err := b.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
+	if err != nil {
+		return err
+	}
+	return b.blockDataAccessor.DeleteBlock(ctx, accountID, params.AccountID)
+}
+
+// ListBlockedAccounts returns the accounts blocked by the caller, the most recently blocked first.
+func (b blockLogic) ListBlockedAccounts(ctx context.Context, params ListBlockedAccountsParams) (ListBlockedAccountsOutput, error) {
+	accountID, _, err := b.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
+	if err != nil {
+		return ListBlockedAccountsOutput{}, err
+	}
+	beforeMicro, beforeBlockedID, err := decodeScoredCursor(params.Cursor)
+	if err != nil {
+		return ListBlockedAccountsOutput{}, err
+	}
+	limit := getAccountPageLimit(params.Limit)
+	blockList, err := b.blockDataAccessor.GetBlocksOfAccount(ctx, accountID, database.BlockCursor{
+		CreatedAt: time.UnixMicro(int64(beforeMicro)),
+		BlockedID: beforeBlockedID,
+	}, limit+1)
+	if err != nil {
+		return ListBlockedAccountsOutput{}, err
+	}
+
+	nextCursor := ""
+	if uint64(len(blockList)) > limit {
+		blockList = blockList[:limit]
+		lastBlock := blockList[len(blockList)-1]
+		nextCursor = encodeScoredCursor(uint64(lastBlock.CreatedAt.UnixMicro()), lastBlock.BlockedID)
+	}
+	accountList, err := b.getAccountsInOrder(ctx, lo.Map(blockList, func(item database.Block, _ int) uint64 {
+		return item.BlockedID
+	}))
+	if err != nil {
+		return ListBlockedAccountsOutput{}, err
+	}
+	return ListBlockedAccountsOutput{
+		AccountList: accountList,
+		NextCursor:  nextCursor,
+	}, nil
+}
+
+// MuteAccount mutes an account. The muted account is not told and the follows between the accounts are kept.
+func (b blockLogic) MuteAccount(ctx context.Context, params MuteAccountParams) error {
+	accountID, _, err := b.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
+	if err != nil {
+		return err
+	}
+	muted, err := b.getTargetAccount(ctx, accountID, params.AccountID)
+	if err != nil {
+		return err
+	}
+	return b.muteDataAccessor.CreateMute(ctx, database.Mute{
+		AccountID: accountID,
+		MutedID:   muted.ID,
+		CreatedAt: time.Now(),
+	})
+}
+
+func (b blockLogic) UnmuteAccount(ctx context.Context, params UnmuteAccountParams) error {
+	accountID, _, err := b.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
+	if err != nil {
+		return err
+	}
+	return b.muteDataAccessor.DeleteMute(ctx, accountID, params.AccountID)
+}
+
+// ListMutedAccounts returns the accounts muted by the caller, the most recently muted first.
+func (b blockLogic) ListMutedAccounts(ctx context.Context, params ListMutedAccountsParams) (ListMutedAccountsOutput, error) {
+	accountID, _, err := b.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
+	if err != nil {
+		return ListMutedAccountsOutput{}, err
+	}
+	beforeMicro, beforeMutedID, err := decodeScoredCursor(params.Cursor)
+	if err != nil {
+		return ListMutedAccountsOutput{}, err
+	}
+	limit := getAccountPageLimit(params.Limit)
+	muteList, err := b.muteDataAccessor.GetMutesOfAccount(ctx, accountID, database.MuteCursor{
+		CreatedAt: time.UnixMicro(int64(beforeMicro)),
+		MutedID:   beforeMutedID,
+	}, limit+1)
+	if err != nil {
+		return ListMutedAccountsOutput{}, err
+	}
+
+	nextCursor := ""
+	if uint64(len(muteList)) > limit {
+		muteList = muteList[:limit]
+		lastMute := muteList[len(muteList)-1]
+		nextCursor = encodeScoredCursor(uint64(lastMute.CreatedAt.UnixMicro()), lastMute.MutedID)
+	}
+	accountList, err := b.getAccountsInOrder(ctx, lo.Map(muteList, func(item database.Mute, _ int) uint64 {
+		return item.MutedID
+	}))
+	if err != nil {
+		return ListMutedAccountsOutput{}, err
+	}
+	return ListMutedAccountsOutput{
+		AccountList: accountList,
+		NextCursor:  nextCursor,
+	}, nil
+}
+
+// IsBlockedEitherWay reports whether accountID blocked otherID or otherID blocked accountID.
+func (b blockLogic) IsBlockedEitherWay(ctx context.Context, accountID uint64, otherID uint64) (bool, error) {
+	if accountID == otherID {
+		return false, nil
+	}
+	blockedIDList, err := b.blockDataAccessor.GetAccountIDsBlockedEitherWay(ctx, accountID, []uint64{otherID})
+	if err != nil {
+		return false, err
+	}
+	return len(blockedIDList) > 0, nil
+}
+
+// GetBlockedAccountIDs returns the accounts of otherIDs that accountID blocked or that blocked accountID.
+func (b blockLogic) GetBlockedAccountIDs(ctx context.Context, accountID uint64, otherIDs []uint64) ([]uint64, error) {
+	return b.blockDataAccessor.GetAccountIDsBlockedEitherWay(ctx, accountID, lo.Without(lo.Uniq(otherIDs), accountID))
+}
+
+// GetAccountIDsBlockedBy returns the accounts of otherIDs that accountID blocked. Unlike GetBlockedAccountIDs it
+// does not tell the accounts that blocked accountID, which accountID is not told about.
+func (b blockLogic) GetAccountIDsBlockedBy(ctx context.Context, accountID uint64, otherIDs []uint64) ([]uint64, error) {
+	return b.blockDataAccessor.GetBlockedIDsAmong(ctx, accountID, lo.Without(lo.Uniq(otherIDs), accountID))
+}
+
+// GetMutedAccountIDs returns the accounts of otherIDs that accountID muted.
+func (b blockLogic) GetMutedAccountIDs(ctx context.Context, accountID uint64, otherIDs []uint64) ([]uint64, error) {
+	return b.muteDataAccessor.GetMutedAccountIDs(ctx, accountID, lo.Without(lo.Uniq(otherIDs), accountID))
+}
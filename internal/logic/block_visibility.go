@@ -0,0 +1,76 @@
+package logic
+
+import (
+	"GoFeed/internal/dataaccess/database"
+	"context"
+
+	"github.com/samber/lo"
+)
+
+// GetHiddenAccountIDs returns the accounts of otherIDs whose posts accountID cannot see: the accounts blocked either
+// way and the private accounts accountID does not follow.
+func (b blockLogic) GetHiddenAccountIDs(ctx context.Context, accountID uint64, otherIDs []uint64) ([]uint64, error) {
+	otherIDs = lo.Without(lo.Uniq(otherIDs), accountID)
+	if len(otherIDs) == 0 {
+		return []uint64{}, nil
+	}
+	blockedIDList, err := b.blockDataAccessor.GetAccountIDsBlockedEitherWay(ctx, accountID, otherIDs)
+	if err != nil {
+		return nil, err
+	}
+	accountList, err := b.accountDataAccessor.GetAccountByIDs(ctx, otherIDs)
+	if err != nil {
+		return nil, err
+	}
+	privateIDList := lo.FilterMap(accountList, func(item database.Account, _ int) (uint64, bool) {
+		return item.ID, item.IsPrivate
+	})
+	followingIDList, err := b.followDataAccessor.GetFollowingIDsAmong(ctx, accountID, privateIDList)
+	if err != nil {
+		return nil, err
+	}
+	return lo.Union(blockedIDList, lo.Without(privateIDList, followingIDList...)), nil
+}
+
+// CheckCanViewPost returns database.ErrPostNotFound when viewerID cannot see post: a post that is not published yet
+// and belongs to another account, or a post whose author is hidden from viewerID as told by GetHiddenAccountIDs.
+// Hidden posts are reported as missing so that they cannot be told apart from posts that do not exist.
+func (b blockLogic) CheckCanViewPost(ctx context.Context, viewerID uint64, post database.Post) error {
+	if post.AccountID == viewerID {
+		return nil
+	}
+	if post.Status != database.PostStatusPublished {
+		return database.ErrPostNotFound
+	}
+	hiddenIDList, err := b.GetHiddenAccountIDs(ctx, viewerID, []uint64{post.AccountID})
+	if err != nil {
+		return err
+	}
+	if len(hiddenIDList) > 0 {
+		return database.ErrPostNotFound
+	}
+	return nil
+}
+
+// CheckCanViewComment returns the error of CheckCanViewPost when viewerID cannot see post, the post comment is on,
+// and database.ErrCommentNotFound when the author of comment is blocked either way or comment is hidden and viewerID
+// is neither the author of the post nor the author of comment.
+func (b blockLogic) CheckCanViewComment(ctx context.Context, viewerID uint64, post database.Post, comment database.Comment) error {
+	if err := b.CheckCanViewPost(ctx, viewerID, post); err != nil {
+		return err
+	}
+	if comment.HiddenAt.Valid && viewerID != post.AccountID && viewerID != comment.AccountID {
+		return database.ErrCommentNotFound
+	}
+	if comment.AccountID == viewerID {
+		return nil
+	}
+	isBlocked, err := b.IsBlockedEitherWay(ctx, viewerID, comment.AccountID)
+	if err != nil {
+		return err
+	}
+	if isBlocked {
+		return database.ErrCommentNotFound
+	}
+	return nil
+}
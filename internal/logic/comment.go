@@ -1,9 +1,13 @@
 package logic
 
 import (
+	"GoFeed/internal/configs"
 	"GoFeed/internal/dataaccess/database"
 	"GoFeed/internal/generated/api/go_feed"
 	"context"
+	"database/sql"
+	"errors"
+	"time"
 
 	"github.com/doug-martin/goqu/v9"
 	"github.com/samber/lo"
@@ -14,9 +18,10 @@ import (
 )
 
 type CreateCommentParams struct {
-	Token   string
-	PostID  uint64
-	Content string
+	Token           string
+	PostID          uint64
+	ParentCommentID uint64
+	Content         string
 }
 type CreateCommentOutput struct {
 	ID uint64
@@ -29,11 +34,26 @@ type GetCommentCountOfPostOutput struct {
 	CommentCount int
 }
 type GetCommentsOfPostParams struct {
-	Token  string
-	PostID uint64
+	Token    string
+	PostID   uint64
+	Threaded bool
+	Sort     go_feed.CommentSort
+	Cursor   string
+	PageSize uint64
 }
 type GetCommentsOfPostOutput struct {
 	CommentList []*go_feed.Comment
+	NextCursor  string
+}
+type GetCommentRepliesParams struct {
+	Token     string
+	CommentID uint64
+	Cursor    string
+	Limit     uint64
+}
+type GetCommentRepliesOutput struct {
+	CommentList []*go_feed.Comment
+	NextCursor  string
 }
 type UpdateCommentParams struct {
 	Token   string
@@ -48,47 +68,191 @@ type DeleteCommentParams struct {
 }
 type DeleteCommentOutput struct {
 }
+type HideCommentParams struct {
+	Token string
+	ID    uint64
+}
+type UnhideCommentParams struct {
+	Token string
+	ID    uint64
+}
+type SetCommentPolicyParams struct {
+	Token         string
+	PostID        uint64
+	CommentPolicy go_feed.CommentPolicy
+}
+
+const (
+	defaultMaxReplyDepth    = 3
+	defaultInlineReplyCount = 3
+	defaultCommentPageLimit = 20
+	maxCommentPageLimit     = 100
+
+	deletedCommentContent = "[deleted]"
+)
 
 type CommentLogic interface {
 	CreateComment(ctx context.Context, params CreateCommentParams) (CreateCommentOutput, error)
 	GetCommentCountOfPost(ctx context.Context, params GetCommentCountOfPostParams) (GetCommentCountOfPostOutput, error)
 	GetCommentsOfPost(ctx context.Context, params GetCommentsOfPostParams) (GetCommentsOfPostOutput, error)
+	GetCommentReplies(ctx context.Context, params GetCommentRepliesParams) (GetCommentRepliesOutput, error)
 	UpdateComment(ctx context.Context, params UpdateCommentParams) error
 	DeleteComment(ctx context.Context, params DeleteCommentParams) error
+	HideComment(ctx context.Context, params HideCommentParams) error
+	UnhideComment(ctx context.Context, params UnhideCommentParams) error
+	SetCommentPolicy(ctx context.Context, params SetCommentPolicyParams) error
 }
 
 type commentLogic struct {
 	goquDatabase        *goqu.Database
 	commentDataAccessor database.CommentDataAccessor
+	postDataAccessor    database.PostDataAccessor
+	likeDataAccessor    database.LikeDataAccessor
+	followDataAccessor  database.FollowDataAccessor
+	mentionDataAccessor database.MentionDataAccessor
 	tokenLogic          TokenLogic
+	counterLogic        CounterLogic
+	blockLogic          BlockLogic
+	contentPolicy       ContentPolicy
+	moderator           Moderator
 	idGenerator         *snowNode
+	commentConfig       configs.Comment
 	logger              *zap.Logger
 }
 
 func NewCommentLogic(
 	goquDatabase *goqu.Database,
 	commentDataAccessor database.CommentDataAccessor,
+	postDataAccessor database.PostDataAccessor,
+	likeDataAccessor database.LikeDataAccessor,
+	followDataAccessor database.FollowDataAccessor,
+	mentionDataAccessor database.MentionDataAccessor,
 	tokenLogic TokenLogic,
+	counterLogic CounterLogic,
+	blockLogic BlockLogic,
+	contentPolicy ContentPolicy,
+	moderator Moderator,
 	idGenerator *snowNode,
+	commentConfig configs.Comment,
 	logger *zap.Logger,
 ) CommentLogic {
 	return &commentLogic{
 		goquDatabase:        goquDatabase,
 		commentDataAccessor: commentDataAccessor,
+		postDataAccessor:    postDataAccessor,
+		likeDataAccessor:    likeDataAccessor,
+		followDataAccessor:  followDataAccessor,
+		mentionDataAccessor: mentionDataAccessor,
 		tokenLogic:          tokenLogic,
+		counterLogic:        counterLogic,
+		blockLogic:          blockLogic,
+		contentPolicy:       contentPolicy,
+		moderator:           moderator,
 		idGenerator:         idGenerator,
+		commentConfig:       commentConfig,
 		logger:              logger,
 	}
 }
 
-func (c commentLogic) databaseCommentToProtoComment(comment database.Comment) *go_feed.Comment {
-	return &go_feed.Comment{
-		CommentId: comment.ID,
-		AccountId: comment.AccountID,
-		PostId:    comment.PostID,
-		Content:   comment.Content,
-		CreatedAt: timestamppb.New(comment.CreatedAt),
+// databaseCommentToProtoComment converts comment, IsHidden is only told to the author of the post since the author of
+// the comment is not told when it gets hidden.
+func (c commentLogic) databaseCommentToProtoComment(comment database.Comment, viewerIsPostAuthor bool) *go_feed.Comment {
+	protoComment := &go_feed.Comment{
+		CommentId:   comment.ID,
+		AccountId:   comment.AccountID,
+		PostId:      comment.PostID,
+		Content:     comment.Content,
+		ContentHtml: comment.ContentHTML,
+		CreatedAt:   timestamppb.New(comment.CreatedAt),
+		LikeCount:   comment.LikeCount,
+	}
+	if comment.ParentCommentID.Valid {
+		protoComment.ParentCommentId = uint64(comment.ParentCommentID.Int64)
+	}
+	if comment.TombstonedAt.Valid {
+		protoComment.AccountId = 0
+		protoComment.Content = deletedCommentContent
+		protoComment.ContentHtml = ""
+		protoComment.IsDeleted = true
+	}
+	protoComment.IsHidden = viewerIsPostAuthor && comment.HiddenAt.Valid
+	return protoComment
+}
+
+// databaseCommentsToProtoComments converts comments and fills in how many replies each of them has and whether viewerID
+// likes it.
+func (c commentLogic) databaseCommentsToProtoComments(ctx context.Context, viewerID uint64, viewerIsPostAuthor bool, commentList []database.Comment) ([]*go_feed.Comment, error) {
+	commentIDList := lo.Map(commentList, func(item database.Comment, _ int) uint64 {
+		return item.ID
+	})
+	replyCounts, err := c.commentDataAccessor.GetReplyCountsOfComments(ctx, commentIDList)
+	if err != nil {
+		return nil, err
+	}
+	likedCommentIDList, err := c.likeDataAccessor.GetLikedCommentIDs(ctx, viewerID, commentIDList)
+	if err != nil {
+		return nil, err
+	}
+	likedCommentIDSet := lo.SliceToMap(likedCommentIDList, func(item uint64) (uint64, struct{}) {
+		return item, struct{}{}
+	})
+	return lo.Map(commentList, func(item database.Comment, _ int) *go_feed.Comment {
+		protoComment := c.databaseCommentToProtoComment(item, viewerIsPostAuthor)
+		protoComment.ReplyCount = replyCounts[item.ID]
+		_, protoComment.IsLiked = likedCommentIDSet[item.ID]
+		return protoComment
+	}), nil
+}
+
+func (c commentLogic) getPageLimit(limit uint64) uint64 {
+	if limit == 0 {
+		return defaultCommentPageLimit
 	}
+	return min(limit, maxCommentPageLimit)
+}
+
+// getReplyDepth returns the depth of a new reply to parentCommentID, which must be a comment of postID that was not
+// deleted.
+func (c commentLogic) getReplyDepth(ctx context.Context, postID uint64, parentCommentID uint64) (uint64, error) {
+	parentComment, err := c.commentDataAccessor.GetCommentByID(ctx, parentCommentID)
+	if err != nil {
+		return 0, err
+	}
+	if parentComment.PostID != postID {
+		return 0, status.Error(codes.InvalidArgument, "the parent comment belongs to another post")
+	}
+	if parentComment.TombstonedAt.Valid {
+		return 0, status.Error(codes.FailedPrecondition, "cannot reply to a deleted comment")
+	}
+	maxReplyDepth := c.commentConfig.MaxReplyDepth
+	if maxReplyDepth == 0 {
+		maxReplyDepth = defaultMaxReplyDepth
+	}
+	if parentComment.Depth+1 > maxReplyDepth {
+		return 0, status.Errorf(codes.FailedPrecondition, "replies can be nested at most %d levels deep", maxReplyDepth)
+	}
+	return parentComment.Depth + 1, nil
+}
+
+// getCommentablePost makes sure the post exists, is published and can be seen by viewerID, comments of deleted or
+// removed posts are hidden along with the post, and so are the comments of posts hidden from viewerID.
+func (c commentLogic) getCommentablePost(ctx context.Context, viewerID uint64, postID uint64) (database.Post, error) {
+	post, err := c.postDataAccessor.GetPostByID(ctx, postID)
+	if err != nil {
+		return database.Post{}, err
+	}
+	if post.Status != database.PostStatusPublished {
+		return database.Post{}, database.ErrPostNotFound
+	}
+	if err = c.blockLogic.CheckCanViewPost(ctx, viewerID, post); err != nil {
+		return database.Post{}, err
+	}
+	return post, nil
+}
+
+// isCommentCounted tells whether comment counts towards the comment count of its post.
+func isCommentCounted(comment database.Comment) bool {
+	return !comment.RemovedAt.Valid && !comment.DeletedAt.Valid && !comment.TombstonedAt.Valid && !comment.HiddenAt.Valid
 }
 
 func (c commentLogic) CreateComment(ctx context.Context, params CreateCommentParams) (CreateCommentOutput, error) {
@@ -96,13 +260,44 @@ func (c commentLogic) CreateComment(ctx context.Context, params CreateCommentPar
 	if err != nil {
 		return CreateCommentOutput{}, err
 	}
+	content, err := c.contentPolicy.ProcessCommentContent(params.Content)
+	if err != nil {
+		return CreateCommentOutput{}, err
+	}
+	err = moderateContent(ctx, c.moderator, ModerationInput{
+		ContentType: ModeratedContentTypeComment,
+		AccountID:   accountID,
+		Text:        content.Text,
+	})
+	if err != nil {
+		return CreateCommentOutput{}, err
+	}
+	post, err := c.getCommentablePost(ctx, accountID, params.PostID)
+	if err != nil {
+		return CreateCommentOutput{}, err
+	}
+	if err = c.checkCommentPolicy(ctx, post, accountID); err != nil {
+		return CreateCommentOutput{}, err
+	}
+	var parentCommentID sql.NullInt64
+	var depth uint64
+	if params.ParentCommentID != 0 {
+		depth, err = c.getReplyDepth(ctx, params.PostID, params.ParentCommentID)
+		if err != nil {
+			return CreateCommentOutput{}, err
+		}
+		parentCommentID = sql.NullInt64{Int64: int64(params.ParentCommentID), Valid: true}
+	}
 	commentID := c.idGenerator.GenID()
 	txErr := c.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
 		commentID, err = c.commentDataAccessor.WithDatabase(td).CreateComment(ctx, database.Comment{
-			ID:        commentID,
-			AccountID: accountID,
-			PostID:    params.PostID,
-			Content:   params.Content,
+			ID:              commentID,
+			AccountID:       accountID,
+			PostID:          params.PostID,
+			Content:         content.Text,
+			ContentHTML:     content.HTML,
+			ParentCommentID: parentCommentID,
+			Depth:           depth,
 		})
 		if err != nil {
 			return err
@@ -112,36 +307,163 @@ func (c commentLogic) CreateComment(ctx context.Context, params CreateCommentPar
 	if txErr != nil {
 		return CreateCommentOutput{}, txErr
 	}
+	c.counterLogic.IncrementCommentCount(ctx, params.PostID, 1)
 	return CreateCommentOutput{
 		ID: commentID,
 	}, nil
 }
 func (c commentLogic) GetCommentCountOfPost(ctx context.Context, params GetCommentCountOfPostParams) (GetCommentCountOfPostOutput, error) {
-	_, _, err := c.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
+	accountID, _, err := c.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
 	if err != nil {
 		return GetCommentCountOfPostOutput{}, err
 	}
-	commentCount, err := c.commentDataAccessor.GetCommentCountOfPost(ctx, params.PostID)
+	if _, err = c.getCommentablePost(ctx, accountID, params.PostID); err != nil {
+		return GetCommentCountOfPostOutput{}, err
+	}
+	postCounts, err := c.counterLogic.GetPostCounts(ctx, params.PostID)
 	if err != nil {
 		return GetCommentCountOfPostOutput{}, err
 	}
 	return GetCommentCountOfPostOutput{
-		CommentCount: commentCount,
+		CommentCount: int(postCounts.CommentCount),
 	}, nil
 }
+func protoCommentSortToDatabase(sort go_feed.CommentSort) (database.CommentSort, error) {
+	switch sort {
+	case go_feed.CommentSort_COMMENT_SORT_UNSPECIFIED, go_feed.CommentSort_COMMENT_SORT_OLDEST:
+		return database.CommentSortOldest, nil
+	case go_feed.CommentSort_COMMENT_SORT_NEWEST:
+		return database.CommentSortNewest, nil
+	case go_feed.CommentSort_COMMENT_SORT_TOP:
+		return database.CommentSortTop, nil
+	default:
+		return "", status.Error(codes.InvalidArgument, "sort must be oldest, newest or top")
+	}
+}
+
+func decodeCommentCursor(sort database.CommentSort, cursor string) (database.CommentCursor, error) {
+	if sort == database.CommentSortTop {
+		likeCount, id, err := decodeScoredCursor(cursor)
+		return database.CommentCursor{ID: id, LikeCount: likeCount}, err
+	}
+	id, err := decodeCursor(cursor)
+	return database.CommentCursor{ID: id}, err
+}
+
+func encodeCommentCursor(sort database.CommentSort, comment database.Comment) string {
+	if sort == database.CommentSortTop {
+		return encodeScoredCursor(comment.LikeCount, comment.ID)
+	}
+	return encodeCursor(comment.ID)
+}
+
+// GetCommentsOfPost returns a page of the comments of a post. Threaded lists only page through the top level comments,
+// each with its first replies inlined, the rest of a thread is loaded with GetCommentReplies.
 func (c commentLogic) GetCommentsOfPost(ctx context.Context, params GetCommentsOfPostParams) (GetCommentsOfPostOutput, error) {
-	_, _, err := c.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
+	accountID, _, err := c.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
 	if err != nil {
 		return GetCommentsOfPostOutput{}, err
 	}
-	commentList, err := c.commentDataAccessor.GetCommentsOfPost(ctx, params.PostID)
+	sort, err := protoCommentSortToDatabase(params.Sort)
 	if err != nil {
 		return GetCommentsOfPostOutput{}, err
 	}
+	after, err := decodeCommentCursor(sort, params.Cursor)
+	if err != nil {
+		return GetCommentsOfPostOutput{}, err
+	}
+	post, err := c.getCommentablePost(ctx, accountID, params.PostID)
+	if err != nil {
+		return GetCommentsOfPostOutput{}, err
+	}
+	includeHidden := post.AccountID == accountID
+
+	limit := c.getPageLimit(params.PageSize)
+	commentList, err := c.commentDataAccessor.GetCommentsOfPost(ctx, params.PostID, accountID, includeHidden, params.Threaded, sort, after, limit+1)
+	if err != nil {
+		return GetCommentsOfPostOutput{}, err
+	}
+	nextCursor := ""
+	if uint64(len(commentList)) > limit {
+		commentList = commentList[:limit]
+		nextCursor = encodeCommentCursor(sort, commentList[len(commentList)-1])
+	}
+	protoCommentList, err := c.databaseCommentsToProtoComments(ctx, accountID, includeHidden, commentList)
+	if err != nil {
+		return GetCommentsOfPostOutput{}, err
+	}
+	if params.Threaded {
+		err = c.inlineReplies(ctx, accountID, includeHidden, protoCommentList)
+		if err != nil {
+			return GetCommentsOfPostOutput{}, err
+		}
+	}
 	return GetCommentsOfPostOutput{
-		CommentList: lo.Map(commentList, func(item database.Comment, _ int) *go_feed.Comment {
-			return c.databaseCommentToProtoComment(item)
-		}),
+		CommentList: protoCommentList,
+		NextCursor:  nextCursor,
+	}, nil
+}
+
+// inlineReplies adds the first replies of each comment to it.
+func (c commentLogic) inlineReplies(ctx context.Context, viewerID uint64, includeHidden bool, protoCommentList []*go_feed.Comment) error {
+	inlineReplyCount := c.commentConfig.InlineReplyCount
+	if inlineReplyCount == 0 {
+		inlineReplyCount = defaultInlineReplyCount
+	}
+	for _, protoComment := range protoCommentList {
+		if protoComment.ReplyCount == 0 {
+			continue
+		}
+		replyList, err := c.commentDataAccessor.GetRepliesOfComment(ctx, protoComment.CommentId, viewerID, includeHidden, 0, inlineReplyCount)
+		if err != nil {
+			return err
+		}
+		protoComment.Replies, err = c.databaseCommentsToProtoComments(ctx, viewerID, includeHidden, replyList)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetCommentReplies pages through the direct replies of a comment from the oldest.
+func (c commentLogic) GetCommentReplies(ctx context.Context, params GetCommentRepliesParams) (GetCommentRepliesOutput, error) {
+	accountID, _, err := c.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
+	if err != nil {
+		return GetCommentRepliesOutput{}, err
+	}
+	afterID, err := decodeCursor(params.Cursor)
+	if err != nil {
+		return GetCommentRepliesOutput{}, err
+	}
+	comment, err := c.commentDataAccessor.GetCommentByID(ctx, params.CommentID)
+	if err != nil {
+		return GetCommentRepliesOutput{}, err
+	}
+	post, err := c.getCommentablePost(ctx, accountID, comment.PostID)
+	if err != nil {
+		return GetCommentRepliesOutput{}, err
+	}
+
+	includeHidden := post.AccountID == accountID
+
+	limit := c.getPageLimit(params.Limit)
+	replyList, err := c.commentDataAccessor.GetRepliesOfComment(ctx, comment.ID, accountID, includeHidden, afterID, limit+1)
+	if err != nil {
+		return GetCommentRepliesOutput{}, err
+	}
+	nextCursor := ""
+	if uint64(len(replyList)) > limit {
+		replyList = replyList[:limit]
+		nextCursor = encodeCursor(replyList[len(replyList)-1].ID)
+	}
+	protoReplyList, err := c.databaseCommentsToProtoComments(ctx, accountID, includeHidden, replyList)
+	if err != nil {
+		return GetCommentRepliesOutput{}, err
+	}
+	return GetCommentRepliesOutput{
+		CommentList: protoReplyList,
+		NextCursor:  nextCursor,
 	}, nil
 }
 func (c commentLogic) UpdateComment(ctx context.Context, params UpdateCommentParams) error {
@@ -149,15 +471,31 @@ func (c commentLogic) UpdateComment(ctx context.Context, params UpdateCommentPar
 	if err != nil {
 		return err
 	}
+	content, err := c.contentPolicy.ProcessCommentContent(params.Content)
+	if err != nil {
+		return err
+	}
+	err = moderateContent(ctx, c.moderator, ModerationInput{
+		ContentType: ModeratedContentTypeComment,
+		AccountID:   accountID,
+		Text:        content.Text,
+	})
+	if err != nil {
+		return err
+	}
 	txErr := c.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
 		comment, err := c.commentDataAccessor.WithDatabase(td).GetCommentByIdWithXLock(ctx, params.ID)
 		if err != nil {
 			return err
 		}
+		if comment.TombstonedAt.Valid {
+			return database.ErrCommentNotFound
+		}
 		if comment.AccountID != accountID {
 			return status.Error(codes.PermissionDenied, "trying to update a comment the account does not own")
 		}
-		comment.Content = params.Content
+		comment.Content = content.Text
+		comment.ContentHTML = content.HTML
 		err = c.commentDataAccessor.WithDatabase(td).UpdateComment(ctx, comment)
 		if err != nil {
 			return err
@@ -169,27 +507,76 @@ func (c commentLogic) UpdateComment(ctx context.Context, params UpdateCommentPar
 	}
 	return nil
 }
+
+// DeleteComment soft deletes a comment, the purge worker hard deletes it after the retention period. A comment that
+// still has replies is tombstoned instead, it keeps its place in the thread but shows as deleted. Both the author of
+// the comment and the author of the post can delete it.
 func (c commentLogic) DeleteComment(ctx context.Context, params DeleteCommentParams) error {
 	accountID, _, err := c.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
 	if err != nil {
 		return err
 	}
+	var comment database.Comment
 	txErr := c.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
-		comment, err := c.commentDataAccessor.WithDatabase(td).GetCommentByIdWithXLock(ctx, params.ID)
+		comment, err = c.commentDataAccessor.WithDatabase(td).GetCommentByIdWithXLock(ctx, params.ID)
 		if err != nil {
 			return err
 		}
-		if comment.AccountID != accountID {
-			return status.Error(codes.PermissionDenied, "trying to delete a comment the account does not own")
+		if comment.TombstonedAt.Valid {
+			return database.ErrCommentNotFound
 		}
-		err = c.commentDataAccessor.WithDatabase(td).DeleteComment(ctx, params.ID)
-		if err != nil {
-			return err
+		if comment.AccountID != accountID {
+			post, err := c.postDataAccessor.WithDatabase(td).GetPostByID(ctx, comment.PostID)
+			if err != nil {
+				return err
+			}
+			if post.AccountID != accountID {
+				return status.Error(codes.PermissionDenied, "trying to delete a comment the account does not own")
+			}
 		}
-		return nil
+		return c.deleteComment(ctx, td, comment, time.Now())
 	})
 	if txErr != nil {
 		return txErr
 	}
+	if isCommentCounted(comment) {
+		c.counterLogic.IncrementCommentCount(ctx, comment.PostID, -1)
+	}
 	return nil
 }
+
+// deleteComment soft deletes or tombstones comment. Once a comment is gone, its tombstoned ancestors that were only
+// kept for its sake are soft deleted as well.
+func (c commentLogic) deleteComment(ctx context.Context, td *goqu.TxDatabase, comment database.Comment, now time.Time) error {
+	replyCounts, err := c.commentDataAccessor.WithDatabase(td).GetReplyCountsOfComments(ctx, []uint64{comment.ID})
+	if err != nil {
+		return err
+	}
+	if replyCounts[comment.ID] > 0 {
+		if comment.TombstonedAt.Valid {
+			return nil
+		}
+		comment.TombstonedAt = toNullTime(now)
+		return c.commentDataAccessor.WithDatabase(td).UpdateComment(ctx, comment)
+	}
+
+	comment.DeletedAt = toNullTime(now)
+	err = c.commentDataAccessor.WithDatabase(td).UpdateComment(ctx, comment)
+	if err != nil {
+		return err
+	}
+	if !comment.ParentCommentID.Valid {
+		return nil
+	}
+	parentComment, err := c.commentDataAccessor.WithDatabase(td).GetCommentByIdWithXLock(ctx, uint64(comment.ParentCommentID.Int64))
+	if err != nil {
+		if errors.Is(err, database.ErrCommentNotFound) {
+			return nil
+		}
+		return err
+	}
+	if !parentComment.TombstonedAt.Valid {
+		return nil
+	}
+	return c.deleteComment(ctx, td, parentComment, now)
+}
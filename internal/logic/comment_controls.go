@@ -0,0 +1,156 @@
+package logic
+
+import (
+	"GoFeed/internal/dataaccess/database"
+	"GoFeed/internal/generated/api/go_feed"
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/samber/lo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func protoCommentPolicyToDatabase(commentPolicy go_feed.CommentPolicy) (database.CommentPolicy, error) {
+	switch commentPolicy {
+	case go_feed.CommentPolicy_COMMENT_POLICY_EVERYONE:
+		return database.CommentPolicyEveryone, nil
+	case go_feed.CommentPolicy_COMMENT_POLICY_FOLLOWERS:
+		return database.CommentPolicyFollowers, nil
+	case go_feed.CommentPolicy_COMMENT_POLICY_MENTIONED:
+		return database.CommentPolicyMentioned, nil
+	case go_feed.CommentPolicy_COMMENT_POLICY_CLOSED:
+		return database.CommentPolicyClosed, nil
+	default:
+		return "", status.Error(codes.InvalidArgument, "comment policy must be everyone, followers, mentioned or closed")
+	}
+}
+
+func databaseCommentPolicyToProto(commentPolicy database.CommentPolicy) go_feed.CommentPolicy {
+	switch commentPolicy {
+	case database.CommentPolicyFollowers:
+		return go_feed.CommentPolicy_COMMENT_POLICY_FOLLOWERS
+	case database.CommentPolicyMentioned:
+		return go_feed.CommentPolicy_COMMENT_POLICY_MENTIONED
+	case database.CommentPolicyClosed:
+		return go_feed.CommentPolicy_COMMENT_POLICY_CLOSED
+	default:
+		return go_feed.CommentPolicy_COMMENT_POLICY_EVERYONE
+	}
+}
+
+// checkCommentPolicy returns an error when the comment policy of post does not let accountID comment on it. Whether
+// accountID can see the post at all, blocks included, is checked by getCommentablePost.
+func (c commentLogic) checkCommentPolicy(ctx context.Context, post database.Post, accountID uint64) error {
+	if post.CommentPolicy == database.CommentPolicyClosed {
+		return status.Error(codes.FailedPrecondition, "comments on this post are closed")
+	}
+	if post.AccountID == accountID {
+		return nil
+	}
+
+	switch post.CommentPolicy {
+	case database.CommentPolicyFollowers:
+		isFollowing, err := c.followDataAccessor.IsFollowing(ctx, accountID, post.AccountID)
+		if err != nil {
+			return err
+		}
+		if !isFollowing {
+			return status.Error(codes.PermissionDenied, "only followers of the author can comment on this post")
+		}
+	case database.CommentPolicyMentioned:
+		mentionList, err := c.mentionDataAccessor.GetMentionsOfPosts(ctx, []uint64{post.ID})
+		if err != nil {
+			return err
+		}
+		isMentioned := lo.ContainsBy(mentionList, func(item database.PostMention) bool {
+			return item.AccountID == accountID
+		})
+		if !isMentioned {
+			return status.Error(codes.PermissionDenied, "only accounts mentioned in this post can comment on it")
+		}
+	}
+	return nil
+}
+
+// setCommentHidden hides or unhides a comment. Only the author of the post the comment belongs to can do so.
+func (c commentLogic) setCommentHidden(ctx context.Context, token string, commentID uint64, hidden bool) error {
+	accountID, _, err := c.tokenLogic.GetAccountIDAndExpireTime(ctx, token)
+	if err != nil {
+		return err
+	}
+	var comment database.Comment
+	var changed bool
+	txErr := c.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		comment, err = c.commentDataAccessor.WithDatabase(td).GetCommentByIdWithXLock(ctx, commentID)
+		if err != nil {
+			return err
+		}
+		if comment.TombstonedAt.Valid {
+			return database.ErrCommentNotFound
+		}
+		post, err := c.postDataAccessor.WithDatabase(td).GetPostByID(ctx, comment.PostID)
+		if err != nil {
+			return err
+		}
+		if post.AccountID != accountID {
+			return status.Error(codes.PermissionDenied, "only the author of the post can hide its comments")
+		}
+		if comment.HiddenAt.Valid == hidden {
+			return nil
+		}
+		comment.HiddenAt = sql.NullTime{}
+		if hidden {
+			comment.HiddenAt = toNullTime(time.Now())
+		}
+		changed = true
+		return c.commentDataAccessor.WithDatabase(td).UpdateComment(ctx, comment)
+	})
+	if txErr != nil {
+		return txErr
+	}
+	if !changed || comment.RemovedAt.Valid || comment.DeletedAt.Valid {
+		return nil
+	}
+	if hidden {
+		c.counterLogic.IncrementCommentCount(ctx, comment.PostID, -1)
+	} else {
+		c.counterLogic.IncrementCommentCount(ctx, comment.PostID, 1)
+	}
+	return nil
+}
+
+// HideComment hides a comment from everyone but the author of the post and the author of the comment, who does not
+// get told about it.
+func (c commentLogic) HideComment(ctx context.Context, params HideCommentParams) error {
+	return c.setCommentHidden(ctx, params.Token, params.ID, true)
+}
+
+func (c commentLogic) UnhideComment(ctx context.Context, params UnhideCommentParams) error {
+	return c.setCommentHidden(ctx, params.Token, params.ID, false)
+}
+
+// SetCommentPolicy changes who can comment on a post. Existing comments are kept whatever the new policy is.
+func (c commentLogic) SetCommentPolicy(ctx context.Context, params SetCommentPolicyParams) error {
+	accountID, _, err := c.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
+	if err != nil {
+		return err
+	}
+	commentPolicy, err := protoCommentPolicyToDatabase(params.CommentPolicy)
+	if err != nil {
+		return err
+	}
+	return c.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		post, err := c.postDataAccessor.WithDatabase(td).GetPostByIDWithXLock(ctx, params.PostID)
+		if err != nil {
+			return err
+		}
+		if post.AccountID != accountID {
+			return status.Error(codes.PermissionDenied, "trying to change the comment policy of a post the account does not own")
+		}
+		post.CommentPolicy = commentPolicy
+		return c.postDataAccessor.WithDatabase(td).UpdatePost(ctx, post)
+	})
+}
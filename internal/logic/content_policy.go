@@ -0,0 +1,197 @@
+package logic
+
+import (
+	"GoFeed/internal/configs"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultPostMaxLength    = 2000
+	defaultCommentMaxLength = 500
+
+	contentFieldName = "content"
+
+	zeroWidthJoiner = '\u200d'
+)
+
+type ProcessedContent struct {
+	Text string
+	// HTML is the sanitized rendering of Text, empty when markdown is disabled.
+	HTML string
+}
+
+// ContentPolicy normalizes and validates user written text before it is stored.
+type ContentPolicy interface {
+	ProcessPostContent(content string) (ProcessedContent, error)
+	ProcessCommentContent(content string) (ProcessedContent, error)
+}
+
+type contentPolicy struct {
+	contentConfig configs.Content
+}
+
+func NewContentPolicy(contentConfig configs.Content) ContentPolicy {
+	return &contentPolicy{
+		contentConfig: contentConfig,
+	}
+}
+
+func (c contentPolicy) ProcessPostContent(content string) (ProcessedContent, error) {
+	maxLength := c.contentConfig.PostMaxLength
+	if maxLength == 0 {
+		maxLength = defaultPostMaxLength
+	}
+	return c.process(content, int(maxLength))
+}
+
+func (c contentPolicy) ProcessCommentContent(content string) (ProcessedContent, error) {
+	maxLength := c.contentConfig.CommentMaxLength
+	if maxLength == 0 {
+		maxLength = defaultCommentMaxLength
+	}
+	return c.process(content, int(maxLength))
+}
+
+// process normalizes line endings and the text to NFC, then collects every rule the text breaks so the client can
+// report them all at once.
+func (c contentPolicy) process(content string, maxLength int) (ProcessedContent, error) {
+	text := strings.ReplaceAll(content, "\r\n", "\n")
+	text = norm.NFC.String(text)
+
+	violations := make([]*errdetails.BadRequest_FieldViolation, 0)
+	if strings.TrimSpace(text) == "" {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       contentFieldName,
+			Description: "content must not be empty",
+		})
+	}
+	if length := countGraphemeClusters(text); length > maxLength {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       contentFieldName,
+			Description: fmt.Sprintf("content must be at most %d characters, got %d", maxLength, length),
+		})
+	}
+	if strings.ContainsFunc(text, isDisallowedControlRune) {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       contentFieldName,
+			Description: "content must not contain control characters",
+		})
+	}
+	if len(violations) > 0 {
+		return ProcessedContent{}, newInvalidArgumentError("content violates the content policy", violations)
+	}
+
+	processedContent := ProcessedContent{
+		Text: text,
+	}
+	if c.contentConfig.MarkdownEnabled {
+		processedContent.HTML = renderMarkdown(text)
+	}
+	return processedContent, nil
+}
+
+func newInvalidArgumentError(message string, violations []*errdetails.BadRequest_FieldViolation) error {
+	st := status.New(codes.InvalidArgument, message)
+	stWithDetails, err := st.WithDetails(&errdetails.BadRequest{FieldViolations: violations})
+	if err != nil {
+		return st.Err()
+	}
+	return stWithDetails.Err()
+}
+
+// isDisallowedControlRune rejects C0/C1 control characters and bidi overrides, newlines and tabs are allowed.
+func isDisallowedControlRune(r rune) bool {
+	if r == '\n' || r == '\t' {
+		return false
+	}
+	if unicode.IsControl(r) {
+		return true
+	}
+	return (r >= '\u202a' && r <= '\u202e') || (r >= '\u2066' && r <= '\u2069')
+}
+
+func isGraphemeExtend(r rune) bool {
+	return unicode.In(r, unicode.Mn, unicode.Me, unicode.Mc) ||
+		r == zeroWidthJoiner ||
+		(r >= '\ufe00' && r <= '\ufe0f') ||
+		(r >= '\U000e0100' && r <= '\U000e01ef') ||
+		(r >= '\U0001f3fb' && r <= '\U0001f3ff') ||
+		(r >= '\U000e0020' && r <= '\U000e007f')
+}
+
+// isExtendedPictographic approximates the Extended_Pictographic property with the blocks emoji are drawn from.
+func isExtendedPictographic(r rune) bool {
+	switch {
+	case r == '\u00a9' || r == '\u00ae' || r == '\u203c' || r == '\u2049' || r == '\u2122' || r == '\u2139':
+		return true
+	case r >= '\u2190' && r <= '\u21ff', r >= '\u2300' && r <= '\u23ff', r >= '\u25a0' && r <= '\u27bf':
+		return true
+	case r >= '\u2900' && r <= '\u297f', r >= '\u2b00' && r <= '\u2bff':
+		return true
+	case r == '\u3030' || r == '\u303d' || r == '\u3297' || r == '\u3299':
+		return true
+	case isRegionalIndicator(r) || (r >= '\U0001f3fb' && r <= '\U0001f3ff'):
+		return false
+	case r >= '\U0001f000' && r <= '\U0001faff', r >= '\U0001fc00' && r <= '\U0001fffd':
+		return true
+	}
+	return false
+}
+
+func isRegionalIndicator(r rune) bool {
+	return r >= '\U0001f1e6' && r <= '\U0001f1ff'
+}
+
+func isHangulJamoContinuation(r rune) bool {
+	return (r >= '\u1160' && r <= '\u11ff') || (r >= '\ud7b0' && r <= '\ud7ff')
+}
+
+func isHangul(r rune) bool {
+	return unicode.Is(unicode.Hangul, r)
+}
+
+// countGraphemeClusters counts user perceived characters. It follows the UAX #29 rules that matter for posts:
+// CRLF, combining marks, emoji modifiers and ZWJ sequences, flag pairs and Hangul jamo. A ZWJ only joins two
+// pictographs, so that it cannot be used to squeeze plain text into a single character.
+func countGraphemeClusters(text string) int {
+	count := 0
+	var previous rune
+	regionalIndicatorRun := 0
+	afterPictographic := false
+	for i, r := range text {
+		joined := false
+		switch {
+		case i == 0:
+		case previous == '\r' && r == '\n':
+			joined = true
+		case isGraphemeExtend(r):
+			joined = true
+		case previous == zeroWidthJoiner && afterPictographic && isExtendedPictographic(r):
+			joined = true
+		case isRegionalIndicator(r) && isRegionalIndicator(previous) && regionalIndicatorRun%2 == 1:
+			joined = true
+		case isHangulJamoContinuation(r) && isHangul(previous):
+			joined = true
+		}
+		if !joined {
+			count++
+		}
+		if isRegionalIndicator(r) {
+			regionalIndicatorRun++
+		} else {
+			regionalIndicatorRun = 0
+		}
+		if !isGraphemeExtend(r) {
+			afterPictographic = isExtendedPictographic(r)
+		}
+		previous = r
+	}
+	return count
+}
@@ -0,0 +1,78 @@
+package logic
+
+import (
+	"strings"
+	"testing"
+
+	"GoFeed/internal/configs"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCountGraphemeClusters(t *testing.T) {
+	testCases := []struct {
+		name     string
+		text     string
+		expected int
+	}{
+		{name: "empty", text: "", expected: 0},
+		{name: "ascii", text: "hello", expected: 5},
+		{name: "crlf", text: "a\r\nb", expected: 3},
+		{name: "combining mark", text: "e\u0301", expected: 1},
+		{name: "hangul jamo", text: "\u1112\u1161\u11ab", expected: 1},
+		{name: "flags", text: "\U0001f1fa\U0001f1f8\U0001f1eb\U0001f1f7", expected: 2},
+		{name: "unpaired regional indicator", text: "\U0001f1fa\U0001f1f8\U0001f1eb", expected: 2},
+		{name: "emoji modifier", text: "\U0001f44d\U0001f3fd", expected: 1},
+		{name: "variation selector", text: "\u2764\ufe0f", expected: 1},
+		{name: "zwj family", text: "\U0001f468\u200d\U0001f469\u200d\U0001f467", expected: 1},
+		{name: "zwj flag", text: "\U0001f3f3\ufe0f\u200d\U0001f308", expected: 1},
+		{name: "zwj after letter", text: "a\u200d\U0001f44d", expected: 2},
+		{name: "zwj before letter", text: "\U0001f44d\u200da", expected: 2},
+		{name: "zwj between letters", text: strings.Repeat("a\u200d", 100), expected: 100},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if count := countGraphemeClusters(testCase.text); count != testCase.expected {
+				t.Errorf("countGraphemeClusters(%q) = %d, expected %d", testCase.text, count, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestProcessPostContent(t *testing.T) {
+	policy := NewContentPolicy(configs.Content{PostMaxLength: 10})
+
+	testCases := []struct {
+		name     string
+		content  string
+		expected string
+		code     codes.Code
+	}{
+		{name: "crlf is normalized", content: "a\r\nb", expected: "a\nb"},
+		{name: "text is normalized to nfc", content: "e\u0301", expected: "\u00e9"},
+		{name: "at the limit", content: strings.Repeat("\U0001f468\u200d\U0001f469", 10), expected: strings.Repeat("\U0001f468\u200d\U0001f469", 10)},
+		{name: "over the limit", content: strings.Repeat("a", 11), code: codes.InvalidArgument},
+		{name: "zwj does not hide length", content: strings.Repeat("a\u200d", 11), code: codes.InvalidArgument},
+		{name: "blank", content: " \n ", code: codes.InvalidArgument},
+		{name: "control character", content: "a\u0000b", code: codes.InvalidArgument},
+		{name: "bidi override", content: "a\u202eb", code: codes.InvalidArgument},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			content, err := policy.ProcessPostContent(testCase.content)
+			if testCase.code != codes.OK {
+				if status.Code(err) != testCase.code {
+					t.Fatalf("expected code %s, got %v", testCase.code, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if content.Text != testCase.expected {
+				t.Errorf("text = %q, expected %q", content.Text, testCase.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,312 @@
+package logic
+
+import (
+	"GoFeed/internal/configs"
+	"GoFeed/internal/dataaccess/cache"
+	"GoFeed/internal/dataaccess/database"
+	"GoFeed/internal/utils"
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+)
+
+const (
+	defaultCounterFlushBatchSize = 500
+
+	counterFieldComments   = "comments"
+	counterFieldFollowers  = "followers"
+	counterFieldFollowings = "followings"
+)
+
+var counterKinds = []cache.CounterKind{cache.CounterKindPost, cache.CounterKindAccount}
+
+func getReactionCounterField(reactionType database.ReactionType) string {
+	return "reaction:" + string(reactionType)
+}
+
+type PostCounts struct {
+	ReactionCounts map[database.ReactionType]uint64
+	CommentCount   uint64
+}
+
+type AccountCounts struct {
+	FollowerCount  uint64
+	FollowingCount uint64
+}
+
+// CounterLogic keeps the reaction and comment counts of posts and the follow counts of accounts. The counts are
+// updated in the cache as reactions, comments and follows are created and deleted, the counter flusher worker then
+// copies them to the count columns of the database. Counts that are missing from the cache are served from the
+// database and rebuilt from the likes, comments and follows tables by the worker, so reads never count rows.
+type CounterLogic interface {
+	GetPostCounts(ctx context.Context, postID uint64) (PostCounts, error)
+	GetAccountCounts(ctx context.Context, accountID uint64) (AccountCounts, error)
+	IncrementReactionCount(ctx context.Context, postID uint64, reactionType database.ReactionType, delta int64)
+	IncrementCommentCount(ctx context.Context, postID uint64, delta int64)
+	IncrementFollowCounts(ctx context.Context, accountID uint64, followingID uint64, delta int64)
+	FlushCounters(ctx context.Context) error
+}
+
+type counterLogic struct {
+	postDataAccessor    database.PostDataAccessor
+	accountDataAccessor database.AccountDataAccessor
+	likeDataAccessor    database.LikeDataAccessor
+	commentDataAccessor database.CommentDataAccessor
+	followDataAccessor  database.FollowDataAccessor
+	counter             cache.Counter
+	counterConfig       configs.Counter
+	logger              *zap.Logger
+}
+
+func NewCounterLogic(
+	postDataAccessor database.PostDataAccessor,
+	accountDataAccessor database.AccountDataAccessor,
+	likeDataAccessor database.LikeDataAccessor,
+	commentDataAccessor database.CommentDataAccessor,
+	followDataAccessor database.FollowDataAccessor,
+	counter cache.Counter,
+	counterConfig configs.Counter,
+	logger *zap.Logger,
+) CounterLogic {
+	return &counterLogic{
+		postDataAccessor:    postDataAccessor,
+		accountDataAccessor: accountDataAccessor,
+		likeDataAccessor:    likeDataAccessor,
+		commentDataAccessor: commentDataAccessor,
+		followDataAccessor:  followDataAccessor,
+		counter:             counter,
+		counterConfig:       counterConfig,
+		logger:              logger,
+	}
+}
+
+// getCounts returns the counters of an entity from the cache. When they are missing the entity is marked as stale so
+// that the worker rebuilds them, and false is returned for the caller to fall back to the database.
+func (c counterLogic) getCounts(ctx context.Context, kind cache.CounterKind, id uint64) (map[string]int64, bool) {
+	counts, err := c.counter.Get(ctx, kind, id)
+	if err == nil {
+		return counts, true
+	}
+	if errors.Is(err, cache.ErrCacheMiss) {
+		if err = c.counter.AddStale(ctx, kind, id); err != nil {
+			utils.LoggerWithContext(ctx, c.logger).With(zap.Error(err)).Warn("failed to mark counters as stale")
+		}
+	}
+	return nil, false
+}
+
+func (c counterLogic) GetPostCounts(ctx context.Context, postID uint64) (PostCounts, error) {
+	if counts, ok := c.getCounts(ctx, cache.CounterKindPost, postID); ok {
+		reactionCounts := make(map[database.ReactionType]uint64, len(reactionTypes))
+		for _, reactionType := range reactionTypes {
+			reactionCounts[reactionType] = uint64(max(counts[getReactionCounterField(reactionType)], 0))
+		}
+		return PostCounts{
+			ReactionCounts: reactionCounts,
+			CommentCount:   uint64(max(counts[counterFieldComments], 0)),
+		}, nil
+	}
+
+	post, err := c.postDataAccessor.GetPostByID(ctx, postID)
+	if err != nil {
+		return PostCounts{}, err
+	}
+	reactionCounts, err := c.likeDataAccessor.GetStoredReactionCountsOfPost(ctx, postID)
+	if err != nil {
+		return PostCounts{}, err
+	}
+	return PostCounts{
+		ReactionCounts: reactionCounts,
+		CommentCount:   post.CommentCount,
+	}, nil
+}
+
+func (c counterLogic) GetAccountCounts(ctx context.Context, accountID uint64) (AccountCounts, error) {
+	if counts, ok := c.getCounts(ctx, cache.CounterKindAccount, accountID); ok {
+		return AccountCounts{
+			FollowerCount:  uint64(max(counts[counterFieldFollowers], 0)),
+			FollowingCount: uint64(max(counts[counterFieldFollowings], 0)),
+		}, nil
+	}
+
+	account, err := c.accountDataAccessor.GetAccountByID(ctx, accountID)
+	if err != nil {
+		return AccountCounts{}, err
+	}
+	return AccountCounts{
+		FollowerCount:  account.FollowerCount,
+		FollowingCount: account.FollowingCount,
+	}, nil
+}
+
+// increment applies a change that has already been committed to the database. It never fails the request, when the
+// counters are not in the cache or the cache is unavailable they are rebuilt from the source tables instead.
+func (c counterLogic) increment(ctx context.Context, kind cache.CounterKind, id uint64, field string, delta int64) {
+	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.String("kind", string(kind))).With(zap.Uint64("id", id))
+
+	found, err := c.counter.Increment(ctx, kind, id, field, delta)
+	if err == nil && found {
+		return
+	}
+	if err != nil {
+		logger.With(zap.Error(err)).Warn("failed to increment counter")
+	}
+	if err = c.counter.AddStale(ctx, kind, id); err != nil {
+		logger.With(zap.Error(err)).Warn("failed to mark counters as stale")
+	}
+}
+
+func (c counterLogic) IncrementReactionCount(ctx context.Context, postID uint64, reactionType database.ReactionType, delta int64) {
+	c.increment(ctx, cache.CounterKindPost, postID, getReactionCounterField(reactionType), delta)
+}
+
+func (c counterLogic) IncrementCommentCount(ctx context.Context, postID uint64, delta int64) {
+	c.increment(ctx, cache.CounterKindPost, postID, counterFieldComments, delta)
+}
+
+// IncrementFollowCounts applies accountID following followingID, or unfollowing it when delta is negative.
+func (c counterLogic) IncrementFollowCounts(ctx context.Context, accountID uint64, followingID uint64, delta int64) {
+	c.increment(ctx, cache.CounterKindAccount, accountID, counterFieldFollowings, delta)
+	c.increment(ctx, cache.CounterKindAccount, followingID, counterFieldFollowers, delta)
+}
+
+func (c counterLogic) getFlushBatchSize() uint64 {
+	if c.counterConfig.FlushBatchSize == 0 {
+		return defaultCounterFlushBatchSize
+	}
+	return c.counterConfig.FlushBatchSize
+}
+
+// FlushCounters copies the counters changed since the last run from the cache to the database, then rebuilds the
+// counters that went missing from the cache from the source tables. Entities that fail are put back so the next run
+// retries them. It is run by the counter flusher worker.
+func (c counterLogic) FlushCounters(ctx context.Context) error {
+	for _, kind := range counterKinds {
+		if err := c.processCounterSet(ctx, kind, c.counter.PopPending, c.counter.AddPending, c.persistCounts); err != nil {
+			return err
+		}
+		if err := c.processCounterSet(ctx, kind, c.counter.PopStale, c.counter.AddStale, c.rebuildCounts); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c counterLogic) processCounterSet(
+	ctx context.Context,
+	kind cache.CounterKind,
+	pop func(ctx context.Context, kind cache.CounterKind, count int64) ([]uint64, error),
+	add func(ctx context.Context, kind cache.CounterKind, ids ...uint64) error,
+	process func(ctx context.Context, kind cache.CounterKind, id uint64) error,
+) error {
+	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.String("kind", string(kind)))
+
+	batchSize := c.getFlushBatchSize()
+	for {
+		idList, err := pop(ctx, kind, int64(batchSize))
+		if err != nil {
+			return err
+		}
+
+		var processErr error
+		failedIDList := make([]uint64, 0)
+		for _, id := range idList {
+			if err := process(ctx, kind, id); err != nil {
+				logger.With(zap.Uint64("id", id)).With(zap.Error(err)).Error("failed to flush counters")
+				processErr = errors.Join(processErr, err)
+				failedIDList = append(failedIDList, id)
+			}
+		}
+		if len(failedIDList) > 0 {
+			return errors.Join(processErr, add(ctx, kind, failedIDList...))
+		}
+		if uint64(len(idList)) < batchSize {
+			return nil
+		}
+	}
+}
+
+// persistCounts writes the counters of an entity from the cache to its count columns. Counters that expired since
+// they were changed are rebuilt instead.
+func (c counterLogic) persistCounts(ctx context.Context, kind cache.CounterKind, id uint64) error {
+	counts, err := c.counter.Get(ctx, kind, id)
+	if err != nil {
+		if errors.Is(err, cache.ErrCacheMiss) {
+			return c.counter.AddStale(ctx, kind, id)
+		}
+		return err
+	}
+
+	switch kind {
+	case cache.CounterKindPost:
+		reactionCounts := make(map[database.ReactionType]uint64, len(reactionTypes))
+		for _, reactionType := range reactionTypes {
+			reactionCounts[reactionType] = uint64(max(counts[getReactionCounterField(reactionType)], 0))
+		}
+		if err = c.likeDataAccessor.UpdateStoredReactionCountsOfPost(ctx, id, reactionCounts); err != nil {
+			return err
+		}
+		return c.postDataAccessor.UpdateCommentCount(ctx, id, uint64(max(counts[counterFieldComments], 0)))
+	case cache.CounterKindAccount:
+		return c.accountDataAccessor.UpdateFollowCounts(
+			ctx,
+			id,
+			uint64(max(counts[counterFieldFollowers], 0)),
+			uint64(max(counts[counterFieldFollowings], 0)),
+		)
+	default:
+		return nil
+	}
+}
+
+// rebuildCounts counts the rows of an entity in the source tables, then writes the result to both its count columns
+// and the cache. The counters are dropped from the cache before counting, so that an increment made while the rows
+// are counted misses them and marks the entity as stale again instead of being overwritten by the result, the entity
+// is then rebuilt once more by the next run.
+func (c counterLogic) rebuildCounts(ctx context.Context, kind cache.CounterKind, id uint64) error {
+	if err := c.counter.Delete(ctx, kind, id); err != nil {
+		return err
+	}
+	counts := make(map[string]int64)
+	switch kind {
+	case cache.CounterKindPost:
+		sourceReactionCounts, err := c.likeDataAccessor.GetReactionCountsOfPost(ctx, id)
+		if err != nil {
+			return err
+		}
+		commentCount, err := c.commentDataAccessor.GetCommentCountOfPost(ctx, id)
+		if err != nil {
+			return err
+		}
+		reactionCounts := make(map[database.ReactionType]uint64, len(reactionTypes))
+		for _, reactionType := range reactionTypes {
+			reactionCounts[reactionType] = sourceReactionCounts[reactionType]
+			counts[getReactionCounterField(reactionType)] = int64(sourceReactionCounts[reactionType])
+		}
+		counts[counterFieldComments] = int64(commentCount)
+		if err = c.likeDataAccessor.UpdateStoredReactionCountsOfPost(ctx, id, reactionCounts); err != nil {
+			return err
+		}
+		if err = c.postDataAccessor.UpdateCommentCount(ctx, id, uint64(commentCount)); err != nil {
+			return err
+		}
+	case cache.CounterKindAccount:
+		followerCount, err := c.followDataAccessor.GetFollowerCountOfAccount(ctx, id)
+		if err != nil {
+			return err
+		}
+		followingCount, err := c.followDataAccessor.GetFollowingCountOfAccount(ctx, id)
+		if err != nil {
+			return err
+		}
+		counts[counterFieldFollowers] = int64(followerCount)
+		counts[counterFieldFollowings] = int64(followingCount)
+		if err = c.accountDataAccessor.UpdateFollowCounts(ctx, id, uint64(followerCount), uint64(followingCount)); err != nil {
+			return err
+		}
+	default:
+		return nil
+	}
+	return c.counter.Set(ctx, kind, id, counts)
+}
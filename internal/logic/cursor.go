@@ -0,0 +1,65 @@
+package logic
+
+import (
+	"encoding/base64"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	errInvalidCursor = status.Error(codes.InvalidArgument, "cursor is invalid")
+)
+
+// encodeCursor wraps the id of the last item of a page into an opaque cursor, the next page starts right after it.
+func encodeCursor(id uint64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatUint(id, 10)))
+}
+
+// decodeCursor returns the id a cursor points at, 0 for the empty cursor of the first page.
+func decodeCursor(cursor string) (uint64, error) {
+	if cursor == "" {
+		return 0, nil
+	}
+	decodedCursor, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, errInvalidCursor
+	}
+	id, err := strconv.ParseUint(string(decodedCursor), 10, 64)
+	if err != nil || id == 0 {
+		return 0, errInvalidCursor
+	}
+	return id, nil
+}
+
+// encodeScoredCursor is encodeCursor for lists sorted by a score first, ties broken by id.
+func encodeScoredCursor(score uint64, id uint64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatUint(score, 10) + ":" + strconv.FormatUint(id, 10)))
+}
+
+// decodeScoredCursor returns the score and id a cursor made by encodeScoredCursor points at, 0 and 0 for the empty
+// cursor of the first page.
+func decodeScoredCursor(cursor string) (uint64, uint64, error) {
+	if cursor == "" {
+		return 0, 0, nil
+	}
+	decodedCursor, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, 0, errInvalidCursor
+	}
+	scoreString, idString, found := strings.Cut(string(decodedCursor), ":")
+	if !found {
+		return 0, 0, errInvalidCursor
+	}
+	score, err := strconv.ParseUint(scoreString, 10, 64)
+	if err != nil {
+		return 0, 0, errInvalidCursor
+	}
+	id, err := strconv.ParseUint(idString, 10, 64)
+	if err != nil || id == 0 {
+		return 0, 0, errInvalidCursor
+	}
+	return score, id, nil
+}
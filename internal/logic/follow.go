@@ -1,20 +1,28 @@
 package logic
 
 import (
+	"GoFeed/internal/configs"
+	"GoFeed/internal/dataaccess/cache"
 	"GoFeed/internal/dataaccess/database"
 	"GoFeed/internal/generated/api/go_feed"
 	"context"
+	"time"
 
 	"github.com/doug-martin/goqu/v9"
 	"github.com/samber/lo"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type CreateFollowParams struct {
 	Token       string
 	FollowingID uint64
 }
-type CreateFollowOutput struct{}
+type CreateFollowOutput struct {
+	// Pending is set when the account is private, the follow then waits for its approval.
+	Pending bool
+}
 type GetFollowerCountOfAccountParams struct {
 	Token     string
 	AccountID uint64
@@ -25,9 +33,12 @@ type GetFollowerCountOfAccountOutput struct {
 type GetFollowersOfAccountParams struct {
 	Token     string
 	AccountID uint64
+	Cursor    string
+	Limit     uint64
 }
 type GetFollowersOfAccountOutput struct {
 	FollowerList []*go_feed.Account
+	NextCursor   string
 }
 type GetFollowingCountOfAccountParams struct {
 	Token     string
@@ -39,58 +50,146 @@ type GetFollowingCountOfAccountOutput struct {
 type GetFollowingsOfAccountParams struct {
 	Token     string
 	AccountID uint64
+	Cursor    string
+	Limit     uint64
 }
 type GetFollowingsOfAccountOutput struct {
 	FollowingList []*go_feed.Account
+	NextCursor    string
 }
 type DeleteFollowParams struct {
 	Token       string
 	FollowingID uint64
 }
 type DeleteFollowOutput struct{}
+type ListFollowRequestsParams struct {
+	Token  string
+	Cursor string
+	Limit  uint64
+}
+type ListFollowRequestsOutput struct {
+	AccountList []*go_feed.Account
+	NextCursor  string
+}
+type AcceptFollowRequestParams struct {
+	Token     string
+	AccountID uint64
+}
+type RejectFollowRequestParams struct {
+	Token     string
+	AccountID uint64
+}
+
+type GetRelationshipsParams struct {
+	Token      string
+	AccountIDs []uint64
+}
+type GetRelationshipsOutput struct {
+	RelationshipList []*go_feed.Relationship
+}
+type GetFollowSuggestionsParams struct {
+	Token string
+	Limit uint64
+}
+type GetFollowSuggestionsOutput struct {
+	SuggestionList []*go_feed.FollowSuggestion
+}
 
 type FollowLogic interface {
-	CreateFollow(ctx context.Context, params CreateFollowParams) error
+	CreateFollow(ctx context.Context, params CreateFollowParams) (CreateFollowOutput, error)
 	GetFollowerCountOfAccount(ctx context.Context, params GetFollowerCountOfAccountParams) (GetFollowerCountOfAccountOutput, error)
 	GetFollowersOfAccount(ctx context.Context, params GetFollowersOfAccountParams) (GetFollowersOfAccountOutput, error)
 	GetFollowingCountOfAccount(ctx context.Context, params GetFollowingCountOfAccountParams) (GetFollowingCountOfAccountOutput, error)
 	GetFollowingsOfAccount(ctx context.Context, params GetFollowingsOfAccountParams) (GetFollowingsOfAccountOutput, error)
 	DeleteFollow(ctx context.Context, params DeleteFollowParams) error
+	ListFollowRequests(ctx context.Context, params ListFollowRequestsParams) (ListFollowRequestsOutput, error)
+	AcceptFollowRequest(ctx context.Context, params AcceptFollowRequestParams) error
+	RejectFollowRequest(ctx context.Context, params RejectFollowRequestParams) error
+	GetFollowSuggestions(ctx context.Context, params GetFollowSuggestionsParams) (GetFollowSuggestionsOutput, error)
+	GetRelationships(ctx context.Context, params GetRelationshipsParams) (GetRelationshipsOutput, error)
+	RefreshFollowSuggestions(ctx context.Context) error
 }
 
 type followLogic struct {
-	goquDatabase        *goqu.Database
-	followDataAccessor  database.FollowDataAccessor
-	accountDataAccessor database.AccountDataAccessor
-	tokenLogic          TokenLogic
-	logger              *zap.Logger
+	goquDatabase              *goqu.Database
+	followDataAccessor        database.FollowDataAccessor
+	followRequestDataAccessor database.FollowRequestDataAccessor
+	accountDataAccessor       database.AccountDataAccessor
+	followSuggestion          cache.FollowSuggestion
+	tokenLogic                TokenLogic
+	counterLogic              CounterLogic
+	blockLogic                BlockLogic
+	followConfig              configs.Follow
+	logger                    *zap.Logger
 }
 
 func NewFollowLogic(
 	goquDatabase *goqu.Database,
 	followDataAccessor database.FollowDataAccessor,
+	followRequestDataAccessor database.FollowRequestDataAccessor,
 	accountDataAccessor database.AccountDataAccessor,
+	followSuggestion cache.FollowSuggestion,
 	tokenLogic TokenLogic,
+	counterLogic CounterLogic,
+	blockLogic BlockLogic,
+	followConfig configs.Follow,
 	logger *zap.Logger,
 ) FollowLogic {
 	return &followLogic{
-		goquDatabase:        goquDatabase,
-		followDataAccessor:  followDataAccessor,
-		accountDataAccessor: accountDataAccessor,
-		tokenLogic:          tokenLogic,
-		logger:              logger,
+		goquDatabase:              goquDatabase,
+		followDataAccessor:        followDataAccessor,
+		followRequestDataAccessor: followRequestDataAccessor,
+		accountDataAccessor:       accountDataAccessor,
+		followSuggestion:          followSuggestion,
+		tokenLogic:                tokenLogic,
+		counterLogic:              counterLogic,
+		blockLogic:                blockLogic,
+		followConfig:              followConfig,
+		logger:                    logger,
 	}
 }
 
-func (f followLogic) CreateFollow(ctx context.Context, params CreateFollowParams) error {
+// CreateFollow follows an account. Following a private account only requests to follow it, the follow is created
+// once the account accepts the request. Accounts cannot follow each other while either one blocks the other.
+func (f followLogic) CreateFollow(ctx context.Context, params CreateFollowParams) (CreateFollowOutput, error) {
 	accountID, _, err := f.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
 	if err != nil {
-		return err
+		return CreateFollowOutput{}, err
+	}
+	following, err := f.accountDataAccessor.GetAccountByID(ctx, params.FollowingID)
+	if err != nil {
+		return CreateFollowOutput{}, err
 	}
+	isBlocked, err := f.blockLogic.IsBlockedEitherWay(ctx, accountID, following.ID)
+	if err != nil {
+		return CreateFollowOutput{}, err
+	}
+	if isBlocked {
+		return CreateFollowOutput{}, status.Error(codes.PermissionDenied, "cannot follow an account that is blocked either way")
+	}
+	if following.IsPrivate && following.ID != accountID {
+		isFollowing, err := f.followDataAccessor.IsFollowing(ctx, accountID, following.ID)
+		if err != nil {
+			return CreateFollowOutput{}, err
+		}
+		if !isFollowing {
+			err = f.followRequestDataAccessor.CreateFollowRequest(ctx, database.FollowRequest{
+				AccountID:   accountID,
+				FollowingID: following.ID,
+				CreatedAt:   time.Now(),
+			})
+			if err != nil {
+				return CreateFollowOutput{}, err
+			}
+			return CreateFollowOutput{Pending: true}, nil
+		}
+	}
+	var created bool
 	txErr := f.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
-		err := f.followDataAccessor.WithDatabase(td).CreateFollow(ctx, database.Follow{
+		created, err = f.followDataAccessor.WithDatabase(td).CreateFollow(ctx, database.Follow{
 			AccountID:   accountID,
 			FollowingID: params.FollowingID,
+			CreatedAt:   time.Now(),
 		})
 		if err != nil {
 			return err
@@ -98,45 +197,87 @@ func (f followLogic) CreateFollow(ctx context.Context, params CreateFollowParams
 		return nil
 	})
 	if txErr != nil {
-		return txErr
+		return CreateFollowOutput{}, txErr
 	}
-	return nil
+	if created {
+		f.counterLogic.IncrementFollowCounts(ctx, accountID, params.FollowingID, 1)
+		f.markFollowSuggestionsActive(ctx, accountID)
+	}
+	return CreateFollowOutput{}, nil
 }
 func (f followLogic) GetFollowerCountOfAccount(ctx context.Context, params GetFollowerCountOfAccountParams) (GetFollowerCountOfAccountOutput, error) {
 	_, _, err := f.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
 	if err != nil {
 		return GetFollowerCountOfAccountOutput{}, err
 	}
-	followerCount, err := f.followDataAccessor.GetFollowerCountOfAccount(ctx, params.AccountID)
+	accountCounts, err := f.counterLogic.GetAccountCounts(ctx, params.AccountID)
 	if err != nil {
 		return GetFollowerCountOfAccountOutput{}, err
 	}
 	return GetFollowerCountOfAccountOutput{
-		FollowerCount: followerCount,
+		FollowerCount: int(accountCounts.FollowerCount),
 	}, nil
 }
+
+// getFollowAccountList loads the accounts of a page of follows in the order of accountIDList, with the relationship
+// of the viewer to each of them. Accounts that no longer exist are skipped.
+func (f followLogic) getFollowAccountList(ctx context.Context, viewerID uint64, accountIDList []uint64) ([]*go_feed.Account, error) {
+	accountList, err := f.accountDataAccessor.GetAccountByIDs(ctx, accountIDList)
+	if err != nil {
+		return nil, err
+	}
+	relationshipMap, err := f.getRelationshipMap(ctx, viewerID, accountIDList)
+	if err != nil {
+		return nil, err
+	}
+	accountMap := lo.SliceToMap(accountList, func(item database.Account) (uint64, database.Account) {
+		return item.ID, item
+	})
+	return lo.FilterMap(accountIDList, func(item uint64, _ int) (*go_feed.Account, bool) {
+		account, ok := accountMap[item]
+		return &go_feed.Account{
+			Id:           account.ID,
+			AccountName:  account.Account_name,
+			Relationship: relationshipMap[item],
+		}, ok
+	}), nil
+}
+
+// GetFollowersOfAccount returns a page of the followers of an account, the most recent first.
 func (f followLogic) GetFollowersOfAccount(ctx context.Context, params GetFollowersOfAccountParams) (GetFollowersOfAccountOutput, error) {
-	_, _, err := f.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
+	accountID, _, err := f.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
+	if err != nil {
+		return GetFollowersOfAccountOutput{}, err
+	}
+	beforeMicro, beforeAccountID, err := decodeScoredCursor(params.Cursor)
 	if err != nil {
 		return GetFollowersOfAccountOutput{}, err
 	}
+	limit := getAccountPageLimit(params.Limit)
 
-	followerIdList, err := f.followDataAccessor.GetFollowersOfAccount(ctx, params.AccountID)
+	followList, err := f.followDataAccessor.GetFollowersOfAccount(ctx, params.AccountID, database.FollowCursor{
+		CreatedAt: time.UnixMicro(int64(beforeMicro)),
+		AccountID: beforeAccountID,
+	}, limit+1)
 	if err != nil {
 		return GetFollowersOfAccountOutput{}, err
 	}
 
-	accountList, err := f.accountDataAccessor.GetAccountByIDs(ctx, followerIdList)
+	nextCursor := ""
+	if uint64(len(followList)) > limit {
+		followList = followList[:limit]
+		lastFollow := followList[len(followList)-1]
+		nextCursor = encodeScoredCursor(uint64(lastFollow.CreatedAt.UnixMicro()), lastFollow.AccountID)
+	}
+	followerList, err := f.getFollowAccountList(ctx, accountID, lo.Map(followList, func(item database.Follow, _ int) uint64 {
+		return item.AccountID
+	}))
 	if err != nil {
 		return GetFollowersOfAccountOutput{}, err
 	}
 	return GetFollowersOfAccountOutput{
-		FollowerList: lo.Map(accountList, func(item database.Account, _ int) *go_feed.Account {
-			return &go_feed.Account{
-				Id:          item.ID,
-				AccountName: item.Account_name,
-			}
-		}),
+		FollowerList: followerList,
+		NextCursor:   nextCursor,
 	}, nil
 }
 func (f followLogic) GetFollowingCountOfAccount(ctx context.Context, params GetFollowingCountOfAccountParams) (GetFollowingCountOfAccountOutput, error) {
@@ -144,55 +285,170 @@ func (f followLogic) GetFollowingCountOfAccount(ctx context.Context, params GetF
 	if err != nil {
 		return GetFollowingCountOfAccountOutput{}, err
 	}
-	followingCount, err := f.followDataAccessor.GetFollowingCountOfAccount(ctx, params.AccountID)
+	accountCounts, err := f.counterLogic.GetAccountCounts(ctx, params.AccountID)
 	if err != nil {
 		return GetFollowingCountOfAccountOutput{}, err
 	}
 	return GetFollowingCountOfAccountOutput{
-		FollowingCount: followingCount,
+		FollowingCount: int(accountCounts.FollowingCount),
 	}, nil
 }
+
+// GetFollowingsOfAccount returns a page of the accounts an account follows, the most recently followed first.
 func (f followLogic) GetFollowingsOfAccount(ctx context.Context, params GetFollowingsOfAccountParams) (GetFollowingsOfAccountOutput, error) {
-	_, _, err := f.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
+	accountID, _, err := f.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
 	if err != nil {
 		return GetFollowingsOfAccountOutput{}, err
 	}
-	followingIdList, err := f.followDataAccessor.GetFollowingsOfAccount(ctx, params.AccountID)
+	beforeMicro, beforeAccountID, err := decodeScoredCursor(params.Cursor)
 	if err != nil {
 		return GetFollowingsOfAccountOutput{}, err
 	}
+	limit := getAccountPageLimit(params.Limit)
 
-	accountList, err := f.accountDataAccessor.GetAccountByIDs(ctx, followingIdList)
+	followList, err := f.followDataAccessor.GetFollowingsOfAccount(ctx, params.AccountID, database.FollowCursor{
+		CreatedAt: time.UnixMicro(int64(beforeMicro)),
+		AccountID: beforeAccountID,
+	}, limit+1)
 	if err != nil {
 		return GetFollowingsOfAccountOutput{}, err
 	}
 
+	nextCursor := ""
+	if uint64(len(followList)) > limit {
+		followList = followList[:limit]
+		lastFollow := followList[len(followList)-1]
+		nextCursor = encodeScoredCursor(uint64(lastFollow.CreatedAt.UnixMicro()), lastFollow.FollowingID)
+	}
+	followingList, err := f.getFollowAccountList(ctx, accountID, lo.Map(followList, func(item database.Follow, _ int) uint64 {
+		return item.FollowingID
+	}))
+	if err != nil {
+		return GetFollowingsOfAccountOutput{}, err
+	}
 	return GetFollowingsOfAccountOutput{
-		FollowingList: lo.Map(accountList, func(item database.Account, _ int) *go_feed.Account {
-			return &go_feed.Account{
-				Id:          item.ID,
-				AccountName: item.Account_name,
-			}
-		}),
+		FollowingList: followingList,
+		NextCursor:    nextCursor,
 	}, nil
 }
+
+// DeleteFollow unfollows an account, or withdraws the pending request to follow it.
 func (f followLogic) DeleteFollow(ctx context.Context, params DeleteFollowParams) error {
 	accountID, _, err := f.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
 	if err != nil {
 		return err
 	}
+	var deleted bool
 	txErr := f.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
-		err := f.followDataAccessor.WithDatabase(td).DeleteFollow(ctx, database.Follow{
+		deleted, err = f.followDataAccessor.WithDatabase(td).DeleteFollow(ctx, database.Follow{
 			AccountID:   accountID,
 			FollowingID: params.FollowingID,
 		})
 		if err != nil {
 			return err
 		}
-		return nil
+		_, err = f.followRequestDataAccessor.WithDatabase(td).DeleteFollowRequest(ctx, accountID, params.FollowingID)
+		return err
 	})
 	if txErr != nil {
 		return txErr
 	}
+	if deleted {
+		f.counterLogic.IncrementFollowCounts(ctx, accountID, params.FollowingID, -1)
+	}
+	return nil
+}
+
+// ListFollowRequests returns the pending requests to follow the account, the most recent first.
+func (f followLogic) ListFollowRequests(ctx context.Context, params ListFollowRequestsParams) (ListFollowRequestsOutput, error) {
+	accountID, _, err := f.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
+	if err != nil {
+		return ListFollowRequestsOutput{}, err
+	}
+	beforeMicro, beforeAccountID, err := decodeScoredCursor(params.Cursor)
+	if err != nil {
+		return ListFollowRequestsOutput{}, err
+	}
+	limit := getAccountPageLimit(params.Limit)
+	followRequestList, err := f.followRequestDataAccessor.GetFollowRequestsOfAccount(ctx, accountID, database.FollowRequestCursor{
+		CreatedAt: time.UnixMicro(int64(beforeMicro)),
+		AccountID: beforeAccountID,
+	}, limit+1)
+	if err != nil {
+		return ListFollowRequestsOutput{}, err
+	}
+
+	nextCursor := ""
+	if uint64(len(followRequestList)) > limit {
+		followRequestList = followRequestList[:limit]
+		lastFollowRequest := followRequestList[len(followRequestList)-1]
+		nextCursor = encodeScoredCursor(uint64(lastFollowRequest.CreatedAt.UnixMicro()), lastFollowRequest.AccountID)
+	}
+	accountList, err := f.accountDataAccessor.GetAccountByIDs(ctx, lo.Map(followRequestList, func(item database.FollowRequest, _ int) uint64 {
+		return item.AccountID
+	}))
+	if err != nil {
+		return ListFollowRequestsOutput{}, err
+	}
+	accountMap := lo.SliceToMap(accountList, func(item database.Account) (uint64, database.Account) {
+		return item.ID, item
+	})
+	return ListFollowRequestsOutput{
+		AccountList: lo.FilterMap(followRequestList, func(item database.FollowRequest, _ int) (*go_feed.Account, bool) {
+			account, ok := accountMap[item.AccountID]
+			return &go_feed.Account{
+				Id:          account.ID,
+				AccountName: account.Account_name,
+			}, ok
+		}),
+		NextCursor: nextCursor,
+	}, nil
+}
+
+// AcceptFollowRequest turns the pending request of an account to follow the caller into a follow.
+func (f followLogic) AcceptFollowRequest(ctx context.Context, params AcceptFollowRequestParams) error {
+	accountID, _, err := f.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
+	if err != nil {
+		return err
+	}
+	var created bool
+	txErr := f.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		deleted, err := f.followRequestDataAccessor.WithDatabase(td).DeleteFollowRequest(ctx, params.AccountID, accountID)
+		if err != nil {
+			return err
+		}
+		if !deleted {
+			return database.ErrFollowRequestNotFound
+		}
+		created, err = f.followDataAccessor.WithDatabase(td).CreateFollow(ctx, database.Follow{
+			AccountID:   params.AccountID,
+			FollowingID: accountID,
+			CreatedAt:   time.Now(),
+		})
+		return err
+	})
+	if txErr != nil {
+		return txErr
+	}
+	if created {
+		f.counterLogic.IncrementFollowCounts(ctx, params.AccountID, accountID, 1)
+		f.markFollowSuggestionsActive(ctx, params.AccountID)
+	}
+	return nil
+}
+
+// RejectFollowRequest drops the pending request of an account to follow the caller. The account can request again.
+func (f followLogic) RejectFollowRequest(ctx context.Context, params RejectFollowRequestParams) error {
+	accountID, _, err := f.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
+	if err != nil {
+		return err
+	}
+	deleted, err := f.followRequestDataAccessor.DeleteFollowRequest(ctx, params.AccountID, accountID)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return database.ErrFollowRequestNotFound
+	}
 	return nil
 }
@@ -0,0 +1,89 @@
+package logic
+
+import (
+	"GoFeed/internal/generated/api/go_feed"
+	"context"
+
+	"github.com/samber/lo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	maxRelationshipAccountIDs = 100
+)
+
+func toIDSet(idList []uint64) map[uint64]struct{} {
+	return lo.SliceToMap(idList, func(item uint64) (uint64, struct{}) {
+		return item, struct{}{}
+	})
+}
+
+// getRelationshipMap returns how viewerID relates to each account of accountIDList, with a single query per kind of
+// relationship whatever the number of accounts. Whether an account blocked viewerID is not told.
+func (f followLogic) getRelationshipMap(ctx context.Context, viewerID uint64, accountIDList []uint64) (map[uint64]*go_feed.Relationship, error) {
+	accountIDList = lo.Uniq(accountIDList)
+	followingIDList, err := f.followDataAccessor.GetFollowingIDsAmong(ctx, viewerID, accountIDList)
+	if err != nil {
+		return nil, err
+	}
+	followerIDList, err := f.followDataAccessor.GetFollowerIDsAmong(ctx, viewerID, accountIDList)
+	if err != nil {
+		return nil, err
+	}
+	blockedIDList, err := f.blockLogic.GetAccountIDsBlockedBy(ctx, viewerID, accountIDList)
+	if err != nil {
+		return nil, err
+	}
+	mutedIDList, err := f.blockLogic.GetMutedAccountIDs(ctx, viewerID, accountIDList)
+	if err != nil {
+		return nil, err
+	}
+	requestedIDList, err := f.followRequestDataAccessor.GetRequestedFollowingIDsAmong(ctx, viewerID, accountIDList)
+	if err != nil {
+		return nil, err
+	}
+	requestingIDList, err := f.followRequestDataAccessor.GetRequestingAccountIDsAmong(ctx, viewerID, accountIDList)
+	if err != nil {
+		return nil, err
+	}
+
+	followingIDSet := toIDSet(followingIDList)
+	followerIDSet := toIDSet(followerIDList)
+	blockedIDSet := toIDSet(blockedIDList)
+	mutedIDSet := toIDSet(mutedIDList)
+	requestedIDSet := toIDSet(requestedIDList)
+	requestingIDSet := toIDSet(requestingIDList)
+	return lo.SliceToMap(accountIDList, func(item uint64) (uint64, *go_feed.Relationship) {
+		relationship := &go_feed.Relationship{AccountId: item}
+		_, relationship.Following = followingIDSet[item]
+		_, relationship.FollowedBy = followerIDSet[item]
+		_, relationship.Blocking = blockedIDSet[item]
+		_, relationship.Muting = mutedIDSet[item]
+		_, relationship.Requested = requestedIDSet[item]
+		_, relationship.RequestedBy = requestingIDSet[item]
+		return item, relationship
+	}), nil
+}
+
+// GetRelationships tells how the caller relates to each of a batch of accounts, for the screens that show accounts
+// with follow, block and mute buttons.
+func (f followLogic) GetRelationships(ctx context.Context, params GetRelationshipsParams) (GetRelationshipsOutput, error) {
+	accountID, _, err := f.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
+	if err != nil {
+		return GetRelationshipsOutput{}, err
+	}
+	accountIDList := lo.Uniq(params.AccountIDs)
+	if len(accountIDList) > maxRelationshipAccountIDs {
+		return GetRelationshipsOutput{}, status.Errorf(codes.InvalidArgument, "at most %d accounts can be looked up at once", maxRelationshipAccountIDs)
+	}
+	relationshipMap, err := f.getRelationshipMap(ctx, accountID, accountIDList)
+	if err != nil {
+		return GetRelationshipsOutput{}, err
+	}
+	return GetRelationshipsOutput{
+		RelationshipList: lo.Map(accountIDList, func(item uint64, _ int) *go_feed.Relationship {
+			return relationshipMap[item]
+		}),
+	}, nil
+}
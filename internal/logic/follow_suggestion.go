@@ -0,0 +1,198 @@
+package logic
+
+import (
+	"GoFeed/internal/dataaccess/cache"
+	"GoFeed/internal/dataaccess/database"
+	"GoFeed/internal/generated/api/go_feed"
+	"GoFeed/internal/utils"
+	"context"
+	"errors"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+)
+
+const (
+	// maxFollowSuggestions is how many suggestions are computed and cached for each account.
+	maxFollowSuggestions = 100
+
+	defaultFollowSuggestionRefreshBatchSize = 500
+)
+
+func (f followLogic) getSuggestionRefreshBatchSize() uint64 {
+	if f.followConfig.SuggestionRefreshBatchSize == 0 {
+		return defaultFollowSuggestionRefreshBatchSize
+	}
+	return f.followConfig.SuggestionRefreshBatchSize
+}
+
+// markFollowSuggestionsActive has the follow suggestion worker recompute the suggestions of an account on its next
+// run. It never fails the request, the cached suggestions are only refreshed later.
+func (f followLogic) markFollowSuggestionsActive(ctx context.Context, accountID uint64) {
+	if err := f.followSuggestion.AddActive(ctx, accountID); err != nil {
+		utils.LoggerWithContext(ctx, f.logger).With(zap.Error(err)).Warn("failed to mark follow suggestions as active")
+	}
+}
+
+// filterFollowSuggestions drops the suggested accounts that are blocked either way with accountID and, when
+// skipFollowed is set, the ones accountID follows.
+func (f followLogic) filterFollowSuggestions(
+	ctx context.Context,
+	accountID uint64,
+	entryList []cache.FollowSuggestionEntry,
+	skipFollowed bool,
+) ([]cache.FollowSuggestionEntry, error) {
+	suggestedIDList := lo.Map(entryList, func(item cache.FollowSuggestionEntry, _ int) uint64 {
+		return item.AccountID
+	})
+	excludedIDList, err := f.blockLogic.GetBlockedAccountIDs(ctx, accountID, suggestedIDList)
+	if err != nil {
+		return nil, err
+	}
+	if skipFollowed {
+		followingIDList, err := f.followDataAccessor.GetFollowingIDsAmong(ctx, accountID, suggestedIDList)
+		if err != nil {
+			return nil, err
+		}
+		excludedIDList = append(excludedIDList, followingIDList...)
+	}
+	excludedIDSet := lo.SliceToMap(excludedIDList, func(item uint64) (uint64, struct{}) {
+		return item, struct{}{}
+	})
+	return lo.Filter(entryList, func(item cache.FollowSuggestionEntry, _ int) bool {
+		_, excluded := excludedIDSet[item.AccountID]
+		return !excluded
+	}), nil
+}
+
+// computeFollowSuggestions ranks the accounts followed by the accounts accountID follows by how many of them follow
+// each one. When there are not enough of them, as for new accounts that follow no one yet, the list is filled with
+// the most followed accounts.
+func (f followLogic) computeFollowSuggestions(ctx context.Context, accountID uint64) ([]cache.FollowSuggestionEntry, error) {
+	suggestionList, err := f.followDataAccessor.GetFollowSuggestions(ctx, accountID, maxFollowSuggestions)
+	if err != nil {
+		return nil, err
+	}
+	entryList := lo.Map(suggestionList, func(item database.FollowSuggestion, _ int) cache.FollowSuggestionEntry {
+		return cache.FollowSuggestionEntry{
+			AccountID:   item.AccountID,
+			MutualCount: item.MutualCount,
+		}
+	})
+
+	if len(entryList) < maxFollowSuggestions {
+		popularIDList, err := f.accountDataAccessor.GetMostFollowedAccountIDs(ctx, maxFollowSuggestions)
+		if err != nil {
+			return nil, err
+		}
+		followingIDList, err := f.followDataAccessor.GetFollowingIDsAmong(ctx, accountID, popularIDList)
+		if err != nil {
+			return nil, err
+		}
+		excludedIDSet := lo.SliceToMap(followingIDList, func(item uint64) (uint64, struct{}) {
+			return item, struct{}{}
+		})
+		excludedIDSet[accountID] = struct{}{}
+		for _, entry := range entryList {
+			excludedIDSet[entry.AccountID] = struct{}{}
+		}
+		for _, popularID := range popularIDList {
+			if len(entryList) >= maxFollowSuggestions {
+				break
+			}
+			if _, excluded := excludedIDSet[popularID]; excluded {
+				continue
+			}
+			entryList = append(entryList, cache.FollowSuggestionEntry{AccountID: popularID})
+		}
+	}
+
+	return f.filterFollowSuggestions(ctx, accountID, entryList, false)
+}
+
+// GetFollowSuggestions returns the accounts the caller is most likely to want to follow. The suggestions are served
+// from the cache, where the follow suggestion worker keeps them up to date for active accounts, and only computed on
+// the spot the first time an account asks for them.
+func (f followLogic) GetFollowSuggestions(ctx context.Context, params GetFollowSuggestionsParams) (GetFollowSuggestionsOutput, error) {
+	accountID, _, err := f.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
+	if err != nil {
+		return GetFollowSuggestionsOutput{}, err
+	}
+	f.markFollowSuggestionsActive(ctx, accountID)
+
+	entryList, err := f.followSuggestion.Get(ctx, accountID)
+	if err != nil {
+		if !errors.Is(err, cache.ErrCacheMiss) {
+			utils.LoggerWithContext(ctx, f.logger).With(zap.Error(err)).Warn("failed to get follow suggestions from cache")
+		}
+		entryList, err = f.computeFollowSuggestions(ctx, accountID)
+		if err != nil {
+			return GetFollowSuggestionsOutput{}, err
+		}
+		if err = f.followSuggestion.Set(ctx, accountID, entryList); err != nil {
+			utils.LoggerWithContext(ctx, f.logger).With(zap.Error(err)).Warn("failed to set follow suggestions into cache")
+		}
+	}
+	// The accounts followed or blocked since the suggestions were computed must not be suggested.
+	entryList, err = f.filterFollowSuggestions(ctx, accountID, entryList, true)
+	if err != nil {
+		return GetFollowSuggestionsOutput{}, err
+	}
+	entryList = entryList[:min(uint64(len(entryList)), getAccountPageLimit(params.Limit))]
+
+	accountList, err := f.accountDataAccessor.GetAccountByIDs(ctx, lo.Map(entryList, func(item cache.FollowSuggestionEntry, _ int) uint64 {
+		return item.AccountID
+	}))
+	if err != nil {
+		return GetFollowSuggestionsOutput{}, err
+	}
+	accountMap := lo.SliceToMap(accountList, func(item database.Account) (uint64, database.Account) {
+		return item.ID, item
+	})
+	return GetFollowSuggestionsOutput{
+		SuggestionList: lo.FilterMap(entryList, func(item cache.FollowSuggestionEntry, _ int) (*go_feed.FollowSuggestion, bool) {
+			account, ok := accountMap[item.AccountID]
+			return &go_feed.FollowSuggestion{
+				Account: &go_feed.Account{
+					Id:          account.ID,
+					AccountName: account.Account_name,
+				},
+				MutualCount: item.MutualCount,
+			}, ok
+		}),
+	}, nil
+}
+
+// RefreshFollowSuggestions recomputes into the cache the suggestions of the accounts that were active since the last
+// run. Accounts that fail are put back so the next run retries them. It is run by the follow suggestion worker.
+func (f followLogic) RefreshFollowSuggestions(ctx context.Context) error {
+	logger := utils.LoggerWithContext(ctx, f.logger)
+
+	batchSize := f.getSuggestionRefreshBatchSize()
+	for {
+		accountIDList, err := f.followSuggestion.PopActive(ctx, int64(batchSize))
+		if err != nil {
+			return err
+		}
+
+		var refreshErr error
+		failedAccountIDList := make([]uint64, 0)
+		for _, accountID := range accountIDList {
+			entryList, err := f.computeFollowSuggestions(ctx, accountID)
+			if err == nil {
+				err = f.followSuggestion.Set(ctx, accountID, entryList)
+			}
+			if err != nil {
+				logger.With(zap.Uint64("account_id", accountID)).With(zap.Error(err)).Error("failed to refresh follow suggestions")
+				refreshErr = errors.Join(refreshErr, err)
+				failedAccountIDList = append(failedAccountIDList, accountID)
+			}
+		}
+		if len(failedAccountIDList) > 0 {
+			return errors.Join(refreshErr, f.followSuggestion.AddActive(ctx, failedAccountIDList...))
+		}
+		if uint64(len(accountIDList)) < batchSize {
+			return nil
+		}
+	}
+}
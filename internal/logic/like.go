@@ -1,13 +1,19 @@
 package logic
 
 import (
+	"GoFeed/internal/configs"
 	"GoFeed/internal/dataaccess/database"
+	"GoFeed/internal/dataaccess/mq/producer"
 	"GoFeed/internal/generated/api/go_feed"
+	"GoFeed/internal/utils"
 	"context"
+	"time"
 
 	"github.com/doug-martin/goqu/v9"
 	"github.com/samber/lo"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type CreateLikeParams struct {
@@ -15,126 +21,479 @@ type CreateLikeParams struct {
 	PostID uint64
 }
 type CreateLikeOutput struct{}
+type SetReactionParams struct {
+	Token        string
+	PostID       uint64
+	ReactionType go_feed.ReactionType
+}
 type GetLikeCountOfPostParams struct {
 	Token  string
 	PostID uint64
 }
 type GetLikeCountOfPostOutput struct {
-	LikeCount int
+	LikeCount      int
+	ReactionCounts []*go_feed.ReactionCount
 }
 type GetLikeAccountsOfPostParams struct {
-	Token  string
-	PostID uint64
+	Token        string
+	PostID       uint64
+	ReactionType go_feed.ReactionType
+	Cursor       string
+	Limit        uint64
 }
 type GetLikeAccountsOfPostOutput struct {
 	AccountList []*go_feed.Account
+	NextCursor  string
 }
 type DeleteLikeParams struct {
 	Token  string
 	PostID uint64
 }
 type DeleteLikeOutput struct{}
+type DeleteReactionParams struct {
+	Token  string
+	PostID uint64
+}
+type HasLikedPostsParams struct {
+	Token   string
+	PostIDs []uint64
+}
+type HasLikedPostsOutput struct {
+	LikedPostIDs  []uint64
+	ReactionTypes map[uint64]go_feed.ReactionType
+}
+type CreateCommentLikeParams struct {
+	Token     string
+	CommentID uint64
+}
+type GetLikeCountOfCommentParams struct {
+	Token     string
+	CommentID uint64
+}
+type GetLikeCountOfCommentOutput struct {
+	LikeCount uint64
+}
+type DeleteCommentLikeParams struct {
+	Token     string
+	CommentID uint64
+}
 
 type LikeLogic interface {
 	CreateLike(ctx context.Context, params CreateLikeParams) error
+	SetReaction(ctx context.Context, params SetReactionParams) error
 	GetLikeCountOfPost(ctx context.Context, params GetLikeCountOfPostParams) (GetLikeCountOfPostOutput, error)
 	GetLikeAccountsOfPost(ctx context.Context, params GetLikeAccountsOfPostParams) (GetLikeAccountsOfPostOutput, error)
 	DeleteLike(ctx context.Context, params DeleteLikeParams) error
+	DeleteReaction(ctx context.Context, params DeleteReactionParams) error
+	HasLikedPosts(ctx context.Context, params HasLikedPostsParams) (HasLikedPostsOutput, error)
+	CreateCommentLike(ctx context.Context, params CreateCommentLikeParams) error
+	GetLikeCountOfComment(ctx context.Context, params GetLikeCountOfCommentParams) (GetLikeCountOfCommentOutput, error)
+	DeleteCommentLike(ctx context.Context, params DeleteCommentLikeParams) error
 }
 
 type likeLogic struct {
-	goquDatabase        *goqu.Database
-	likeDataAccessor    database.LikeDataAccessor
-	accountDataAccessor database.AccountDataAccessor
-	tokenLogic          TokenLogic
-	logger              *zap.Logger
+	goquDatabase             *goqu.Database
+	likeDataAccessor         database.LikeDataAccessor
+	accountDataAccessor      database.AccountDataAccessor
+	commentDataAccessor      database.CommentDataAccessor
+	postDataAccessor         database.PostDataAccessor
+	tokenLogic               TokenLogic
+	counterLogic             CounterLogic
+	blockLogic               BlockLogic
+	trendingActivityProducer producer.TrendingActivityProducer
+	likeConfig               configs.Like
+	logger                   *zap.Logger
 }
 
 func NewLikeLogic(
 	goquDatabase *goqu.Database,
 	likeDataAccessor database.LikeDataAccessor,
 	accountDataAccessor database.AccountDataAccessor,
+	commentDataAccessor database.CommentDataAccessor,
+	postDataAccessor database.PostDataAccessor,
 	tokenLogic TokenLogic,
+	counterLogic CounterLogic,
+	blockLogic BlockLogic,
+	trendingActivityProducer producer.TrendingActivityProducer,
+	likeConfig configs.Like,
 	logger *zap.Logger,
 ) LikeLogic {
 	return &likeLogic{
-		goquDatabase:        goquDatabase,
-		likeDataAccessor:    likeDataAccessor,
-		accountDataAccessor: accountDataAccessor,
-		tokenLogic:          tokenLogic,
-		logger:              logger,
+		goquDatabase:             goquDatabase,
+		likeDataAccessor:         likeDataAccessor,
+		accountDataAccessor:      accountDataAccessor,
+		commentDataAccessor:      commentDataAccessor,
+		postDataAccessor:         postDataAccessor,
+		tokenLogic:               tokenLogic,
+		counterLogic:             counterLogic,
+		blockLogic:               blockLogic,
+		trendingActivityProducer: trendingActivityProducer,
+		likeConfig:               likeConfig,
+		logger:                   logger,
+	}
+}
+
+const (
+	maxHasLikedPostIDs = 100
+)
+
+var reactionTypes = []database.ReactionType{
+	database.ReactionTypeLike,
+	database.ReactionTypeLove,
+	database.ReactionTypeLaugh,
+	database.ReactionTypeWow,
+	database.ReactionTypeSad,
+	database.ReactionTypeAngry,
+}
+
+func protoReactionTypeToDatabase(reactionType go_feed.ReactionType) (database.ReactionType, error) {
+	switch reactionType {
+	case go_feed.ReactionType_REACTION_TYPE_LIKE:
+		return database.ReactionTypeLike, nil
+	case go_feed.ReactionType_REACTION_TYPE_LOVE:
+		return database.ReactionTypeLove, nil
+	case go_feed.ReactionType_REACTION_TYPE_LAUGH:
+		return database.ReactionTypeLaugh, nil
+	case go_feed.ReactionType_REACTION_TYPE_WOW:
+		return database.ReactionTypeWow, nil
+	case go_feed.ReactionType_REACTION_TYPE_SAD:
+		return database.ReactionTypeSad, nil
+	case go_feed.ReactionType_REACTION_TYPE_ANGRY:
+		return database.ReactionTypeAngry, nil
+	default:
+		return "", status.Error(codes.InvalidArgument, "reaction type must be like, love, laugh, wow, sad or angry")
 	}
 }
 
+func databaseReactionTypeToProto(reactionType database.ReactionType) go_feed.ReactionType {
+	switch reactionType {
+	case database.ReactionTypeLike:
+		return go_feed.ReactionType_REACTION_TYPE_LIKE
+	case database.ReactionTypeLove:
+		return go_feed.ReactionType_REACTION_TYPE_LOVE
+	case database.ReactionTypeLaugh:
+		return go_feed.ReactionType_REACTION_TYPE_LAUGH
+	case database.ReactionTypeWow:
+		return go_feed.ReactionType_REACTION_TYPE_WOW
+	case database.ReactionTypeSad:
+		return go_feed.ReactionType_REACTION_TYPE_SAD
+	case database.ReactionTypeAngry:
+		return go_feed.ReactionType_REACTION_TYPE_ANGRY
+	default:
+		return go_feed.ReactionType_REACTION_TYPE_UNSPECIFIED
+	}
+}
+
+// getEnabledReactionTypes returns the reaction types allowed by the config, in the order they are shown.
+func (l likeLogic) getEnabledReactionTypes() []database.ReactionType {
+	if len(l.likeConfig.ReactionTypes) == 0 {
+		return reactionTypes
+	}
+	return lo.Filter(reactionTypes, func(item database.ReactionType, _ int) bool {
+		return item == database.ReactionTypeLike || lo.Contains(l.likeConfig.ReactionTypes, string(item))
+	})
+}
+
 func (l likeLogic) CreateLike(ctx context.Context, params CreateLikeParams) error {
+	return l.SetReaction(ctx, SetReactionParams{
+		Token:        params.Token,
+		PostID:       params.PostID,
+		ReactionType: go_feed.ReactionType_REACTION_TYPE_LIKE,
+	})
+}
+
+// checkCanViewPost returns database.ErrPostNotFound when accountID cannot see the post, so that it can neither react
+// to it nor see its reactions. Drafts of other accounts, posts of accounts blocked either way and posts of private
+// accounts accountID does not follow cannot be seen.
+func (l likeLogic) checkCanViewPost(ctx context.Context, accountID uint64, postID uint64) error {
+	post, err := l.postDataAccessor.GetPostByID(ctx, postID)
+	if err != nil {
+		return err
+	}
+	return l.blockLogic.CheckCanViewPost(ctx, accountID, post)
+}
+
+// SetReaction reacts to a post. An account has a single reaction per post, reacting again switches its type and
+// reacting twice with the same type has no effect.
+func (l likeLogic) SetReaction(ctx context.Context, params SetReactionParams) error {
 	accountID, _, err := l.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
 	if err != nil {
 		return err
 	}
+	reactionType, err := protoReactionTypeToDatabase(params.ReactionType)
+	if err != nil {
+		return err
+	}
+	if !lo.Contains(l.getEnabledReactionTypes(), reactionType) {
+		return status.Errorf(codes.InvalidArgument, "reaction type %s is not enabled", reactionType)
+	}
+	if err = l.checkCanViewPost(ctx, accountID, params.PostID); err != nil {
+		return err
+	}
+	var previousReactionType database.ReactionType
 	txErr := l.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
-		err := l.likeDataAccessor.WithDatabase(td).CreateLike(ctx, database.Like{
-			AccountID: accountID,
-			PostID:    params.PostID,
+		previousReactionType, err = l.likeDataAccessor.WithDatabase(td).SetReaction(ctx, database.Like{
+			AccountID:    accountID,
+			PostID:       params.PostID,
+			ReactionType: reactionType,
+			CreatedAt:    time.Now(),
 		})
-		if err != nil {
-			return err
-		}
-		return nil
+		return err
 	})
 	if txErr != nil {
 		return txErr
 	}
+	if previousReactionType != reactionType {
+		if previousReactionType != "" {
+			l.counterLogic.IncrementReactionCount(ctx, params.PostID, previousReactionType, -1)
+		}
+		l.counterLogic.IncrementReactionCount(ctx, params.PostID, reactionType, 1)
+	}
+	err = l.trendingActivityProducer.Produce(ctx, producer.TrendingActivity{
+		Type:      producer.TrendingActivityTypeLike,
+		PostID:    params.PostID,
+		AccountID: accountID,
+		CreatedAt: time.Now(),
+	})
+	if err != nil {
+		utils.LoggerWithContext(ctx, l.logger).With(zap.Error(err)).Warn("failed to enqueue trending activity")
+	}
 	return nil
 }
+
+// GetLikeCountOfPost counts the reactions to a post by type. LikeCount only counts likes, for clients that predate
+// reactions.
 func (l likeLogic) GetLikeCountOfPost(ctx context.Context, params GetLikeCountOfPostParams) (GetLikeCountOfPostOutput, error) {
-	_, _, err := l.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
+	accountID, _, err := l.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
 	if err != nil {
 		return GetLikeCountOfPostOutput{}, err
 	}
-	likeCount, err := l.likeDataAccessor.GetLikeCountOfPost(ctx, params.PostID)
+	if err = l.checkCanViewPost(ctx, accountID, params.PostID); err != nil {
+		return GetLikeCountOfPostOutput{}, err
+	}
+	postCounts, err := l.counterLogic.GetPostCounts(ctx, params.PostID)
 	if err != nil {
 		return GetLikeCountOfPostOutput{}, err
 	}
+	reactionCounts := postCounts.ReactionCounts
 	return GetLikeCountOfPostOutput{
-		LikeCount: likeCount,
+		LikeCount: int(reactionCounts[database.ReactionTypeLike]),
+		ReactionCounts: lo.Map(l.getEnabledReactionTypes(), func(item database.ReactionType, _ int) *go_feed.ReactionCount {
+			return &go_feed.ReactionCount{
+				ReactionType: databaseReactionTypeToProto(item),
+				Count:        reactionCounts[item],
+			}
+		}),
 	}, nil
 }
+
+// GetLikeAccountsOfPost returns a page of the accounts that reacted to a post with a type, likes when it is
+// unspecified, the most recent reaction first.
 func (l likeLogic) GetLikeAccountsOfPost(ctx context.Context, params GetLikeAccountsOfPostParams) (GetLikeAccountsOfPostOutput, error) {
-	_, _, err := l.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
+	accountID, _, err := l.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
+	if err != nil {
+		return GetLikeAccountsOfPostOutput{}, err
+	}
+	if err = l.checkCanViewPost(ctx, accountID, params.PostID); err != nil {
+		return GetLikeAccountsOfPostOutput{}, err
+	}
+	reactionType := database.ReactionTypeLike
+	if params.ReactionType != go_feed.ReactionType_REACTION_TYPE_UNSPECIFIED {
+		reactionType, err = protoReactionTypeToDatabase(params.ReactionType)
+		if err != nil {
+			return GetLikeAccountsOfPostOutput{}, err
+		}
+	}
+	beforeMicro, beforeAccountID, err := decodeScoredCursor(params.Cursor)
 	if err != nil {
 		return GetLikeAccountsOfPostOutput{}, err
 	}
-	accountIdList, err := l.likeDataAccessor.GetLikeAccountsOfPost(ctx, params.PostID)
+	limit := getAccountPageLimit(params.Limit)
+
+	likeList, err := l.likeDataAccessor.GetLikeAccountsOfPost(ctx, params.PostID, reactionType, database.LikeAccountCursor{
+		CreatedAt: time.UnixMicro(int64(beforeMicro)),
+		AccountID: beforeAccountID,
+	}, limit+1)
 	if err != nil {
 		return GetLikeAccountsOfPostOutput{}, err
 	}
-	accountList, err := l.accountDataAccessor.GetAccountByIDs(ctx, accountIdList)
+
+	nextCursor := ""
+	if uint64(len(likeList)) > limit {
+		likeList = likeList[:limit]
+		lastLike := likeList[len(likeList)-1]
+		nextCursor = encodeScoredCursor(uint64(lastLike.CreatedAt.UnixMicro()), lastLike.AccountID)
+	}
+	accountList, err := l.accountDataAccessor.GetAccountByIDs(ctx, lo.Map(likeList, func(item database.Like, _ int) uint64 {
+		return item.AccountID
+	}))
 	if err != nil {
 		return GetLikeAccountsOfPostOutput{}, err
 	}
+	accountMap := lo.SliceToMap(accountList, func(item database.Account) (uint64, database.Account) {
+		return item.ID, item
+	})
 	return GetLikeAccountsOfPostOutput{
-		AccountList: lo.Map(accountList, func(item database.Account, _ int) *go_feed.Account {
+		AccountList: lo.FilterMap(likeList, func(item database.Like, _ int) (*go_feed.Account, bool) {
+			account, ok := accountMap[item.AccountID]
 			return &go_feed.Account{
-				Id:          item.ID,
-				AccountName: item.Account_name,
-			}
+				Id:          account.ID,
+				AccountName: account.Account_name,
+			}, ok
 		}),
+		NextCursor: nextCursor,
 	}, nil
 }
+
+// DeleteLike removes the reaction of the account to a post only when it is a like, so that a client that predates
+// reactions cannot remove a reaction it does not show. Deleting a like that does not exist has no effect.
 func (l likeLogic) DeleteLike(ctx context.Context, params DeleteLikeParams) error {
+	return l.deleteReaction(ctx, params.Token, params.PostID, database.ReactionTypeLike)
+}
+
+func (l likeLogic) DeleteReaction(ctx context.Context, params DeleteReactionParams) error {
+	return l.deleteReaction(ctx, params.Token, params.PostID, "")
+}
+
+// HasLikedPosts tells which of the posts the account liked, along with the type of every reaction it has to them, in a
+// single query so that a feed can be rendered without a lookup per post.
+func (l likeLogic) HasLikedPosts(ctx context.Context, params HasLikedPostsParams) (HasLikedPostsOutput, error) {
 	accountID, _, err := l.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
+	if err != nil {
+		return HasLikedPostsOutput{}, err
+	}
+	postIDList := lo.Uniq(params.PostIDs)
+	if len(postIDList) > maxHasLikedPostIDs {
+		return HasLikedPostsOutput{}, status.Errorf(codes.InvalidArgument, "at most %d posts can be looked up at once", maxHasLikedPostIDs)
+	}
+	likeList, err := l.likeDataAccessor.GetReactionsOfAccount(ctx, accountID, postIDList)
+	if err != nil {
+		return HasLikedPostsOutput{}, err
+	}
+	return HasLikedPostsOutput{
+		LikedPostIDs: lo.FilterMap(likeList, func(item database.Like, _ int) (uint64, bool) {
+			return item.PostID, item.ReactionType == database.ReactionTypeLike
+		}),
+		ReactionTypes: lo.SliceToMap(likeList, func(item database.Like) (uint64, go_feed.ReactionType) {
+			return item.PostID, databaseReactionTypeToProto(item.ReactionType)
+		}),
+	}, nil
+}
+
+func (l likeLogic) deleteReaction(ctx context.Context, token string, postID uint64, reactionType database.ReactionType) error {
+	accountID, _, err := l.tokenLogic.GetAccountIDAndExpireTime(ctx, token)
 	if err != nil {
 		return err
 	}
+	var deletedReactionType database.ReactionType
 	txErr := l.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
-		err := l.likeDataAccessor.WithDatabase(td).DeleteLike(ctx, accountID, params.PostID)
-		if err != nil {
-			return err
-		}
-		return nil
+		deletedReactionType, err = l.likeDataAccessor.WithDatabase(td).DeleteReaction(ctx, accountID, postID, reactionType)
+		return err
 	})
 	if txErr != nil {
 		return txErr
 	}
+	if deletedReactionType != "" {
+		l.counterLogic.IncrementReactionCount(ctx, postID, deletedReactionType, -1)
+	}
 	return nil
 }
+
+// getLikeableCommentWithXLock locks a comment that is still shown with its content on a published post accountID can
+// see. Comments of accounts blocked either way with accountID cannot be liked.
+func (l likeLogic) getLikeableCommentWithXLock(ctx context.Context, td *goqu.TxDatabase, accountID uint64, commentID uint64) (database.Comment, error) {
+	comment, err := l.commentDataAccessor.WithDatabase(td).GetCommentByIdWithXLock(ctx, commentID)
+	if err != nil {
+		return database.Comment{}, err
+	}
+	if comment.TombstonedAt.Valid {
+		return database.Comment{}, database.ErrCommentNotFound
+	}
+	if err = l.checkCanViewComment(ctx, l.postDataAccessor.WithDatabase(td), accountID, comment); err != nil {
+		return database.Comment{}, err
+	}
+	return comment, nil
+}
+
+// checkCanViewComment loads the post comment is on and checks that accountID can see both, the way
+// GetCommentsOfPost lists them.
+func (l likeLogic) checkCanViewComment(ctx context.Context, postDataAccessor database.PostDataAccessor, accountID uint64, comment database.Comment) error {
+	post, err := postDataAccessor.GetPostByID(ctx, comment.PostID)
+	if err != nil {
+		return err
+	}
+	if post.Status != database.PostStatusPublished {
+		return database.ErrPostNotFound
+	}
+	return l.blockLogic.CheckCanViewComment(ctx, accountID, post, comment)
+}
+
+// CreateCommentLike likes a comment, liking a comment twice has no effect.
+func (l likeLogic) CreateCommentLike(ctx context.Context, params CreateCommentLikeParams) error {
+	accountID, _, err := l.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
+	if err != nil {
+		return err
+	}
+	return l.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		comment, err := l.getLikeableCommentWithXLock(ctx, td, accountID, params.CommentID)
+		if err != nil {
+			return err
+		}
+		liked, err := l.likeDataAccessor.WithDatabase(td).HasLikedComment(ctx, accountID, comment.ID)
+		if err != nil {
+			return err
+		}
+		if liked {
+			return nil
+		}
+		err = l.likeDataAccessor.WithDatabase(td).CreateCommentLike(ctx, database.CommentLike{
+			AccountID: accountID,
+			CommentID: comment.ID,
+			PostID:    comment.PostID,
+			CreatedAt: time.Now(),
+		})
+		if err != nil {
+			return err
+		}
+		return l.commentDataAccessor.WithDatabase(td).IncrementLikeCount(ctx, comment.ID, 1)
+	})
+}
+
+func (l likeLogic) GetLikeCountOfComment(ctx context.Context, params GetLikeCountOfCommentParams) (GetLikeCountOfCommentOutput, error) {
+	accountID, _, err := l.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
+	if err != nil {
+		return GetLikeCountOfCommentOutput{}, err
+	}
+	comment, err := l.commentDataAccessor.GetCommentByID(ctx, params.CommentID)
+	if err != nil {
+		return GetLikeCountOfCommentOutput{}, err
+	}
+	if err = l.checkCanViewComment(ctx, l.postDataAccessor, accountID, comment); err != nil {
+		return GetLikeCountOfCommentOutput{}, err
+	}
+	return GetLikeCountOfCommentOutput{
+		LikeCount: comment.LikeCount,
+	}, nil
+}
+
+// DeleteCommentLike unlikes a comment, unliking a comment that is not liked has no effect.
+func (l likeLogic) DeleteCommentLike(ctx context.Context, params DeleteCommentLikeParams) error {
+	accountID, _, err := l.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
+	if err != nil {
+		return err
+	}
+	return l.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		comment, err := l.commentDataAccessor.WithDatabase(td).GetCommentByIdWithXLock(ctx, params.CommentID)
+		if err != nil {
+			return err
+		}
+		deleted, err := l.likeDataAccessor.WithDatabase(td).DeleteCommentLike(ctx, accountID, comment.ID)
+		if err != nil {
+			return err
+		}
+		if !deleted {
+			return nil
+		}
+		return l.commentDataAccessor.WithDatabase(td).IncrementLikeCount(ctx, comment.ID, -1)
+	})
+}
@@ -0,0 +1,117 @@
+package logic
+
+import (
+	"html"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+var (
+	markdownLinkRegexp   = regexp.MustCompile(`\[([^\[\]]+)\]\(([^()\s]+)\)`)
+	markdownBoldRegexp   = regexp.MustCompile(`\*\*([^*]+)\*\*`)
+	markdownItalicRegexp = regexp.MustCompile(`\*([^*]+)\*|\b_([^_]+)_\b`)
+)
+
+// renderMarkdown renders the markdown subset supported in posts and comments: paragraphs, line breaks, "-" or "*"
+// bullet lists, **bold**, *italic* or _italic_, `code` and [links](https://example.com).
+//
+// The text is HTML escaped before any markup is added, so the only tags in the output are the ones emitted here and
+// links are limited to http and https.
+func renderMarkdown(text string) string {
+	var builder strings.Builder
+	for _, block := range strings.Split(text, "\n\n") {
+		lines := strings.Split(strings.Trim(block, "\n"), "\n")
+		if strings.TrimSpace(block) == "" {
+			continue
+		}
+
+		if isMarkdownList(lines) {
+			builder.WriteString("<ul>")
+			for _, line := range lines {
+				builder.WriteString("<li>")
+				builder.WriteString(renderMarkdownInline(strings.TrimSpace(line)[2:]))
+				builder.WriteString("</li>")
+			}
+			builder.WriteString("</ul>")
+			continue
+		}
+
+		builder.WriteString("<p>")
+		for i, line := range lines {
+			if i > 0 {
+				builder.WriteString("<br>")
+			}
+			builder.WriteString(renderMarkdownInline(line))
+		}
+		builder.WriteString("</p>")
+	}
+	return builder.String()
+}
+
+func isMarkdownList(lines []string) bool {
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		if !strings.HasPrefix(line, "- ") && !strings.HasPrefix(line, "* ") {
+			return false
+		}
+	}
+	return true
+}
+
+// renderMarkdownInline handles code spans first so that nothing inside them is formatted.
+func renderMarkdownInline(line string) string {
+	parts := strings.Split(line, "`")
+	var builder strings.Builder
+	for i, part := range parts {
+		switch {
+		case i%2 == 0:
+			builder.WriteString(renderMarkdownLinks(part))
+		case i == len(parts)-1:
+			// Unterminated code span.
+			builder.WriteString("`")
+			builder.WriteString(renderMarkdownLinks(part))
+		default:
+			builder.WriteString("<code>")
+			builder.WriteString(html.EscapeString(part))
+			builder.WriteString("</code>")
+		}
+	}
+	return builder.String()
+}
+
+func renderMarkdownLinks(text string) string {
+	var builder strings.Builder
+	last := 0
+	for _, match := range markdownLinkRegexp.FindAllStringSubmatchIndex(text, -1) {
+		builder.WriteString(renderMarkdownEmphasis(text[last:match[0]]))
+		label := renderMarkdownEmphasis(text[match[2]:match[3]])
+		href := text[match[4]:match[5]]
+		if isSafeMarkdownLink(href) {
+			builder.WriteString(`<a href="`)
+			builder.WriteString(html.EscapeString(href))
+			builder.WriteString(`" rel="nofollow noopener noreferrer">`)
+			builder.WriteString(label)
+			builder.WriteString("</a>")
+		} else {
+			builder.WriteString(label)
+		}
+		last = match[1]
+	}
+	builder.WriteString(renderMarkdownEmphasis(text[last:]))
+	return builder.String()
+}
+
+func isSafeMarkdownLink(href string) bool {
+	parsedURL, err := url.Parse(href)
+	if err != nil {
+		return false
+	}
+	return (parsedURL.Scheme == "http" || parsedURL.Scheme == "https") && parsedURL.Host != ""
+}
+
+func renderMarkdownEmphasis(text string) string {
+	text = html.EscapeString(text)
+	text = markdownBoldRegexp.ReplaceAllString(text, "<strong>$1</strong>")
+	return markdownItalicRegexp.ReplaceAllString(text, "<em>$1$2</em>")
+}
@@ -0,0 +1,47 @@
+package logic
+
+import "testing"
+
+func TestRenderMarkdown(t *testing.T) {
+	testCases := []struct {
+		name     string
+		text     string
+		expected string
+	}{
+		{name: "paragraphs and line breaks", text: "a\nb\n\nc", expected: "<p>a<br>b</p><p>c</p>"},
+		{name: "list", text: "- one\n* two", expected: "<ul><li>one</li><li>two</li></ul>"},
+		{name: "emphasis", text: "**bold**, *italic* and _italic_", expected: "<p><strong>bold</strong>, <em>italic</em> and <em>italic</em></p>"},
+		{name: "code span is not formatted", text: "`**x** <b>`", expected: "<p><code>**x** &lt;b&gt;</code></p>"},
+		{name: "unterminated code span", text: "a `b", expected: "<p>a `b</p>"},
+		{name: "script is escaped", text: "<script>alert(1)</script>", expected: "<p>&lt;script&gt;alert(1)&lt;/script&gt;</p>"},
+		{name: "raw html is escaped", text: `<img src=x onerror="alert(1)">`, expected: "<p>&lt;img src=x onerror=&#34;alert(1)&#34;&gt;</p>"},
+		{name: "html in list is escaped", text: "- <i>x</i>", expected: "<ul><li>&lt;i&gt;x&lt;/i&gt;</li></ul>"},
+		{name: "ampersand is escaped", text: "a & b", expected: "<p>a &amp; b</p>"},
+		{
+			name:     "link",
+			text:     "[site](https://example.com/?a=1&b=2)",
+			expected: `<p><a href="https://example.com/?a=1&amp;b=2" rel="nofollow noopener noreferrer">site</a></p>`,
+		},
+		{
+			name:     "link label is escaped",
+			text:     "[<b>x</b>](https://example.com)",
+			expected: `<p><a href="https://example.com" rel="nofollow noopener noreferrer">&lt;b&gt;x&lt;/b&gt;</a></p>`,
+		},
+		{
+			name:     "quote in link cannot break out of the attribute",
+			text:     `[x](https://example.com/"onmouseover="alert)`,
+			expected: `<p><a href="https://example.com/&#34;onmouseover=&#34;alert" rel="nofollow noopener noreferrer">x</a></p>`,
+		},
+		{name: "javascript link", text: "[click](javascript:alert)", expected: "<p>click</p>"},
+		{name: "javascript link with host", text: "[click](javascript://example.com/%0aalert)", expected: "<p>click</p>"},
+		{name: "data link", text: "[click](data:text/html;base64,PHNjcmlwdD4=)", expected: "<p>click</p>"},
+		{name: "scheme relative link", text: "[click](//example.com)", expected: "<p>click</p>"},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if rendered := renderMarkdown(testCase.text); rendered != testCase.expected {
+				t.Errorf("renderMarkdown(%q) = %q, expected %q", testCase.text, rendered, testCase.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,356 @@
+package logic
+
+import (
+	"GoFeed/internal/configs"
+	"GoFeed/internal/dataaccess/database"
+	"GoFeed/internal/dataaccess/search"
+	"GoFeed/internal/generated/api/go_feed"
+	"GoFeed/internal/utils"
+	"context"
+	"strings"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type ReportContentParams struct {
+	Token       string
+	ContentType go_feed.ContentType
+	ContentID   uint64
+	Reason      string
+}
+type ReportContentOutput struct {
+	ReportID uint64
+}
+type ListReportsParams struct {
+	Token  string
+	Status go_feed.ReportStatus
+	Offset uint64
+	Limit  uint64
+}
+type ListReportsOutput struct {
+	ReportList []*go_feed.Report
+}
+type ResolveReportParams struct {
+	Token    string
+	ReportID uint64
+	Status   go_feed.ReportStatus
+	Note     string
+}
+type TakedownContentParams struct {
+	Token       string
+	ContentType go_feed.ContentType
+	ContentID   uint64
+	Reason      string
+}
+
+const (
+	defaultReportPageLimit = 20
+	maxReportPageLimit     = 100
+	maxReportReasonLength  = 1000
+)
+
+type ModerationLogic interface {
+	ReportContent(ctx context.Context, params ReportContentParams) (ReportContentOutput, error)
+	ListReports(ctx context.Context, params ListReportsParams) (ListReportsOutput, error)
+	ResolveReport(ctx context.Context, params ResolveReportParams) error
+	TakedownContent(ctx context.Context, params TakedownContentParams) error
+}
+
+type moderationLogic struct {
+	goquDatabase        *goqu.Database
+	reportDataAccessor  database.ReportDataAccessor
+	postDataAccessor    database.PostDataAccessor
+	commentDataAccessor database.CommentDataAccessor
+	pinDataAccessor     database.PinDataAccessor
+	searcher            search.Search
+	tokenLogic          TokenLogic
+	blockLogic          BlockLogic
+	counterLogic        CounterLogic
+	idGenerator         *snowNode
+	moderationConfig    configs.Moderation
+	logger              *zap.Logger
+}
+
+func NewModerationLogic(
+	goquDatabase *goqu.Database,
+	reportDataAccessor database.ReportDataAccessor,
+	postDataAccessor database.PostDataAccessor,
+	commentDataAccessor database.CommentDataAccessor,
+	pinDataAccessor database.PinDataAccessor,
+	searcher search.Search,
+	tokenLogic TokenLogic,
+	blockLogic BlockLogic,
+	counterLogic CounterLogic,
+	idGenerator *snowNode,
+	moderationConfig configs.Moderation,
+	logger *zap.Logger,
+) ModerationLogic {
+	return &moderationLogic{
+		goquDatabase:        goquDatabase,
+		reportDataAccessor:  reportDataAccessor,
+		postDataAccessor:    postDataAccessor,
+		commentDataAccessor: commentDataAccessor,
+		pinDataAccessor:     pinDataAccessor,
+		searcher:            searcher,
+		tokenLogic:          tokenLogic,
+		blockLogic:          blockLogic,
+		counterLogic:        counterLogic,
+		idGenerator:         idGenerator,
+		moderationConfig:    moderationConfig,
+		logger:              logger,
+	}
+}
+
+func protoContentTypeToDatabase(contentType go_feed.ContentType) (database.ReportContentType, error) {
+	switch contentType {
+	case go_feed.ContentType_CONTENT_TYPE_POST:
+		return database.ReportContentTypePost, nil
+	case go_feed.ContentType_CONTENT_TYPE_COMMENT:
+		return database.ReportContentTypeComment, nil
+	default:
+		return "", status.Error(codes.InvalidArgument, "content type must be post or comment")
+	}
+}
+
+func databaseReportToProtoReport(report database.Report) *go_feed.Report {
+	protoReport := &go_feed.Report{
+		Id:                report.ID,
+		ReporterAccountId: report.ReporterAccountID,
+		ContentType:       go_feed.ContentType_CONTENT_TYPE_POST,
+		ContentId:         report.ContentID,
+		Reason:            report.Reason,
+		Status:            go_feed.ReportStatus_REPORT_STATUS_OPEN,
+		CreatedAt:         timestamppb.New(report.CreatedAt),
+		ResolutionNote:    report.ResolutionNote,
+	}
+	if report.ContentType == database.ReportContentTypeComment {
+		protoReport.ContentType = go_feed.ContentType_CONTENT_TYPE_COMMENT
+	}
+	switch report.Status {
+	case database.ReportStatusResolved:
+		protoReport.Status = go_feed.ReportStatus_REPORT_STATUS_RESOLVED
+	case database.ReportStatusDismissed:
+		protoReport.Status = go_feed.ReportStatus_REPORT_STATUS_DISMISSED
+	}
+	if report.ResolvedByAccountID.Valid {
+		protoReport.ResolvedByAccountId = uint64(report.ResolvedByAccountID.Int64)
+	}
+	if report.ResolvedAt.Valid {
+		protoReport.ResolvedAt = timestamppb.New(report.ResolvedAt.Time)
+	}
+	return protoReport
+}
+
+// getAdminAccountID authenticates the token and only lets through the accounts listed as admins in the config.
+func (m moderationLogic) getAdminAccountID(ctx context.Context, token string) (uint64, error) {
+	accountID, _, err := m.tokenLogic.GetAccountIDAndExpireTime(ctx, token)
+	if err != nil {
+		return 0, err
+	}
+	if !lo.Contains(m.moderationConfig.AdminAccountIDs, accountID) {
+		return 0, status.Error(codes.PermissionDenied, "only admins can moderate content")
+	}
+	return accountID, nil
+}
+
+// ReportContent files a report against a visible post or comment. Each account can report a piece of content once.
+func (m moderationLogic) ReportContent(ctx context.Context, params ReportContentParams) (ReportContentOutput, error) {
+	accountID, _, err := m.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
+	if err != nil {
+		return ReportContentOutput{}, err
+	}
+	contentType, err := protoContentTypeToDatabase(params.ContentType)
+	if err != nil {
+		return ReportContentOutput{}, err
+	}
+	reason := strings.TrimSpace(params.Reason)
+	if reason == "" {
+		return ReportContentOutput{}, status.Error(codes.InvalidArgument, "reason is required")
+	}
+	if len([]rune(reason)) > maxReportReasonLength {
+		return ReportContentOutput{}, status.Errorf(codes.InvalidArgument, "reason must be at most %d characters", maxReportReasonLength)
+	}
+
+	switch contentType {
+	case database.ReportContentTypePost:
+		post, err := m.postDataAccessor.GetPostByID(ctx, params.ContentID)
+		if err != nil {
+			return ReportContentOutput{}, err
+		}
+		if post.Status != database.PostStatusPublished {
+			return ReportContentOutput{}, database.ErrPostNotFound
+		}
+		if err = m.blockLogic.CheckCanViewPost(ctx, accountID, post); err != nil {
+			return ReportContentOutput{}, err
+		}
+	case database.ReportContentTypeComment:
+		comment, err := m.commentDataAccessor.GetCommentByID(ctx, params.ContentID)
+		if err != nil {
+			return ReportContentOutput{}, err
+		}
+		post, err := m.postDataAccessor.GetPostByID(ctx, comment.PostID)
+		if err != nil {
+			return ReportContentOutput{}, err
+		}
+		if post.Status != database.PostStatusPublished {
+			return ReportContentOutput{}, database.ErrPostNotFound
+		}
+		if err = m.blockLogic.CheckCanViewComment(ctx, accountID, post, comment); err != nil {
+			return ReportContentOutput{}, err
+		}
+	}
+
+	_, found, err := m.reportDataAccessor.GetReportOfReporter(ctx, accountID, contentType, params.ContentID)
+	if err != nil {
+		return ReportContentOutput{}, err
+	}
+	if found {
+		return ReportContentOutput{}, status.Error(codes.AlreadyExists, "the account has already reported this content")
+	}
+
+	reportID := m.idGenerator.GenID()
+	err = m.reportDataAccessor.CreateReport(ctx, database.Report{
+		ID:                reportID,
+		ReporterAccountID: accountID,
+		ContentType:       contentType,
+		ContentID:         params.ContentID,
+		Reason:            reason,
+		CreatedAt:         time.Now(),
+	})
+	if err != nil {
+		return ReportContentOutput{}, err
+	}
+	return ReportContentOutput{
+		ReportID: reportID,
+	}, nil
+}
+
+func (m moderationLogic) ListReports(ctx context.Context, params ListReportsParams) (ListReportsOutput, error) {
+	if _, err := m.getAdminAccountID(ctx, params.Token); err != nil {
+		return ListReportsOutput{}, err
+	}
+	var reportStatus database.ReportStatus
+	switch params.Status {
+	case go_feed.ReportStatus_REPORT_STATUS_OPEN:
+		reportStatus = database.ReportStatusOpen
+	case go_feed.ReportStatus_REPORT_STATUS_RESOLVED:
+		reportStatus = database.ReportStatusResolved
+	case go_feed.ReportStatus_REPORT_STATUS_DISMISSED:
+		reportStatus = database.ReportStatusDismissed
+	}
+	limit := params.Limit
+	if limit == 0 {
+		limit = defaultReportPageLimit
+	}
+	reportList, err := m.reportDataAccessor.GetReports(ctx, reportStatus, params.Offset, min(limit, maxReportPageLimit))
+	if err != nil {
+		return ListReportsOutput{}, err
+	}
+	return ListReportsOutput{
+		ReportList: lo.Map(reportList, func(item database.Report, _ int) *go_feed.Report {
+			return databaseReportToProtoReport(item)
+		}),
+	}, nil
+}
+
+// ResolveReport closes an open report, either as resolved or as dismissed. It does not touch the reported content,
+// use TakedownContent for that.
+func (m moderationLogic) ResolveReport(ctx context.Context, params ResolveReportParams) error {
+	accountID, err := m.getAdminAccountID(ctx, params.Token)
+	if err != nil {
+		return err
+	}
+	var reportStatus database.ReportStatus
+	switch params.Status {
+	case go_feed.ReportStatus_REPORT_STATUS_RESOLVED:
+		reportStatus = database.ReportStatusResolved
+	case go_feed.ReportStatus_REPORT_STATUS_DISMISSED:
+		reportStatus = database.ReportStatusDismissed
+	default:
+		return status.Error(codes.InvalidArgument, "status must be resolved or dismissed")
+	}
+	return m.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		report, err := m.reportDataAccessor.WithDatabase(td).GetReportByIDWithXLock(ctx, params.ReportID)
+		if err != nil {
+			return err
+		}
+		if report.Status != database.ReportStatusOpen {
+			return status.Error(codes.FailedPrecondition, "the report has already been closed")
+		}
+		return m.reportDataAccessor.WithDatabase(td).ResolveReport(ctx, report.ID, reportStatus, accountID, strings.TrimSpace(params.Note), time.Now())
+	})
+}
+
+// TakedownContent hides a post or comment from every read path and resolves its open reports. The content stays in
+// the database for audit.
+func (m moderationLogic) TakedownContent(ctx context.Context, params TakedownContentParams) error {
+	accountID, err := m.getAdminAccountID(ctx, params.Token)
+	if err != nil {
+		return err
+	}
+	contentType, err := protoContentTypeToDatabase(params.ContentType)
+	if err != nil {
+		return err
+	}
+	reason := strings.TrimSpace(params.Reason)
+	if reason == "" {
+		return status.Error(codes.InvalidArgument, "reason is required")
+	}
+
+	now := time.Now()
+	var removedComment database.Comment
+	var removedCommentCounted bool
+	txErr := m.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		switch contentType {
+		case database.ReportContentTypePost:
+			post, err := m.postDataAccessor.WithDatabase(td).GetPostByIDIncludingDeletedWithXLock(ctx, params.ContentID)
+			if err != nil {
+				return err
+			}
+			post.RemovedAt = toNullTime(now)
+			post.RemovedReason = reason
+			err = m.postDataAccessor.WithDatabase(td).UpdatePost(ctx, post)
+			if err != nil {
+				return err
+			}
+			err = m.pinDataAccessor.WithDatabase(td).DeletePinsOfPost(ctx, post.ID)
+			if err != nil {
+				return err
+			}
+		case database.ReportContentTypeComment:
+			comment, err := m.commentDataAccessor.WithDatabase(td).GetCommentByIdWithXLock(ctx, params.ContentID)
+			if err != nil {
+				return err
+			}
+			removedCommentCounted = isCommentCounted(comment)
+			comment.RemovedAt = toNullTime(now)
+			comment.RemovedReason = reason
+			err = m.commentDataAccessor.WithDatabase(td).UpdateComment(ctx, comment)
+			if err != nil {
+				return err
+			}
+			removedComment = comment
+		}
+		return m.reportDataAccessor.WithDatabase(td).ResolveOpenReportsOfContent(ctx, contentType, params.ContentID, accountID, reason, now)
+	})
+	if txErr != nil {
+		return txErr
+	}
+
+	if removedCommentCounted {
+		m.counterLogic.IncrementCommentCount(ctx, removedComment.PostID, -1)
+	}
+	if contentType == database.ReportContentTypePost {
+		err = m.searcher.DeletePost(ctx, params.ContentID)
+		if err != nil {
+			utils.LoggerWithContext(ctx, m.logger).With(zap.Error(err)).Warn("failed to remove post from search")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+package logic
+
+import (
+	"GoFeed/internal/configs"
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+type ModeratedContentType string
+
+const (
+	ModeratedContentTypePost    ModeratedContentType = "post"
+	ModeratedContentTypeComment ModeratedContentType = "comment"
+)
+
+type ModerationInput struct {
+	ContentType ModeratedContentType
+	AccountID   uint64
+	Text        string
+}
+
+type ModerationResult struct {
+	Rejected bool
+	Reason   string
+}
+
+// Moderator decides whether content may be published. External classifiers plug in by implementing Moderator,
+// or with ModeratorFunc, and being chained after the blocklist with NewChainModerator.
+type Moderator interface {
+	Moderate(ctx context.Context, input ModerationInput) (ModerationResult, error)
+}
+
+type ModeratorFunc func(ctx context.Context, input ModerationInput) (ModerationResult, error)
+
+func (f ModeratorFunc) Moderate(ctx context.Context, input ModerationInput) (ModerationResult, error) {
+	return f(ctx, input)
+}
+
+type blocklistModerator struct {
+	patterns []*regexp.Regexp
+}
+
+func NewBlocklistModerator(moderationConfig configs.Moderation, logger *zap.Logger) (Moderator, error) {
+	patterns := make([]*regexp.Regexp, 0, len(moderationConfig.BlockedKeywords)+len(moderationConfig.BlockedPatterns))
+	for _, keyword := range moderationConfig.BlockedKeywords {
+		keyword = strings.TrimSpace(keyword)
+		if keyword == "" {
+			continue
+		}
+		patterns = append(patterns, regexp.MustCompile(`(?i)(^|[^\pL\pN_])`+regexp.QuoteMeta(keyword)+`($|[^\pL\pN_])`))
+	}
+	for _, pattern := range moderationConfig.BlockedPatterns {
+		compiledPattern, err := regexp.Compile(pattern)
+		if err != nil {
+			logger.With(zap.String("pattern", pattern)).With(zap.Error(err)).Error("failed to compile blocked pattern")
+			return nil, err
+		}
+		patterns = append(patterns, compiledPattern)
+	}
+	return &blocklistModerator{
+		patterns: patterns,
+	}, nil
+}
+
+func (b blocklistModerator) Moderate(_ context.Context, input ModerationInput) (ModerationResult, error) {
+	for _, pattern := range b.patterns {
+		if pattern.MatchString(input.Text) {
+			return ModerationResult{
+				Rejected: true,
+				Reason:   "content contains blocked terms",
+			}, nil
+		}
+	}
+	return ModerationResult{}, nil
+}
+
+type chainModerator struct {
+	moderators []Moderator
+}
+
+// NewChainModerator runs moderators in order and stops at the first rejection.
+func NewChainModerator(moderators ...Moderator) Moderator {
+	return &chainModerator{
+		moderators: moderators,
+	}
+}
+
+func (c chainModerator) Moderate(ctx context.Context, input ModerationInput) (ModerationResult, error) {
+	for _, moderator := range c.moderators {
+		result, err := moderator.Moderate(ctx, input)
+		if err != nil {
+			return ModerationResult{}, err
+		}
+		if result.Rejected {
+			return result, nil
+		}
+	}
+	return ModerationResult{}, nil
+}
+
+// moderateContent returns an InvalidArgument error when moderator rejects text.
+func moderateContent(ctx context.Context, moderator Moderator, input ModerationInput) error {
+	result, err := moderator.Moderate(ctx, input)
+	if err != nil {
+		return err
+	}
+	if !result.Rejected {
+		return nil
+	}
+	return newInvalidArgumentError(fmt.Sprintf("%s was rejected by moderation", input.ContentType), []*errdetails.BadRequest_FieldViolation{
+		{
+			Field:       contentFieldName,
+			Description: result.Reason,
+		},
+	})
+}
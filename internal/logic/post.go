@@ -1,21 +1,32 @@
 package logic
 
 import (
+	"GoFeed/internal/configs"
+	"GoFeed/internal/dataaccess/cache"
 	"GoFeed/internal/dataaccess/database"
+	"GoFeed/internal/dataaccess/linkpreview"
 	"GoFeed/internal/dataaccess/mq/producer"
+	"GoFeed/internal/dataaccess/search"
 	"GoFeed/internal/generated/api/go_feed"
+	"GoFeed/internal/utils"
 	"context"
+	"database/sql"
+	"math"
+	"strings"
+	"time"
 
 	"github.com/doug-martin/goqu/v9"
 	"github.com/samber/lo"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type CreatePostParams struct {
 	Token   string
 	Content string
+	Poll    *NewPollParams
 }
 type CreatePostOutput struct {
 	ID uint64
@@ -30,9 +41,12 @@ type GetPostByIDOutput struct {
 type GetPostOfAccountParams struct {
 	Token      string
 	Of_account uint64
+	Cursor     string
+	Limit      uint64
 }
 type GetPostOfAccountOutput struct {
-	PostList []*go_feed.Post
+	PostList   []*go_feed.Post
+	NextCursor string
 }
 type UpdatePostParams struct {
 	Token   string
@@ -47,6 +61,145 @@ type DeletePostParams struct {
 }
 type DeletePostOutput struct {
 }
+type GetPostsByHashtagParams struct {
+	Token   string
+	Hashtag string
+	Offset  uint64
+	Limit   uint64
+}
+type GetPostsByHashtagOutput struct {
+	PostList []*go_feed.Post
+}
+type GetPostsMentioningAccountParams struct {
+	Token     string
+	AccountID uint64
+	Offset    uint64
+	Limit     uint64
+}
+type GetPostsMentioningAccountOutput struct {
+	PostList []*go_feed.Post
+}
+type SearchPostsParams struct {
+	Token  string
+	Query  string
+	Offset uint64
+	Limit  uint64
+}
+type SearchPostsOutput struct {
+	PostList []*go_feed.Post
+}
+type CreateDraftParams struct {
+	Token     string
+	Content   string
+	PublishAt time.Time
+}
+type CreateDraftOutput struct {
+	ID uint64
+}
+type ListDraftsParams struct {
+	Token string
+}
+type ListDraftsOutput struct {
+	PostList []*go_feed.Post
+}
+type UpdateDraftParams struct {
+	Token     string
+	ID        uint64
+	Content   string
+	PublishAt time.Time
+}
+type PublishDraftParams struct {
+	Token     string
+	ID        uint64
+	PublishAt time.Time
+}
+type RestorePostParams struct {
+	Token string
+	ID    uint64
+}
+type ListDeletedPostsParams struct {
+	Token string
+}
+type ListDeletedPostsOutput struct {
+	PostList []*go_feed.Post
+}
+type CreateBookmarkParams struct {
+	Token  string
+	PostID uint64
+}
+type DeleteBookmarkParams struct {
+	Token  string
+	PostID uint64
+}
+type GetLikedPostsOfAccountParams struct {
+	Token        string
+	AccountID    uint64
+	ReactionType go_feed.ReactionType
+	Cursor       string
+	Limit        uint64
+}
+type GetLikedPostsOfAccountOutput struct {
+	PostList   []*go_feed.Post
+	NextCursor string
+}
+type ListBookmarksParams struct {
+	Token  string
+	Cursor string
+	Limit  uint64
+}
+type ListBookmarksOutput struct {
+	PostList   []*go_feed.Post
+	NextCursor string
+}
+type GetTrendingHashtagsParams struct {
+	Token  string
+	Window go_feed.TrendingWindow
+	Limit  uint64
+}
+type GetTrendingHashtagsOutput struct {
+	HashtagList []*go_feed.TrendingHashtag
+}
+type GetTrendingPostsParams struct {
+	Token  string
+	Window go_feed.TrendingWindow
+	Limit  uint64
+}
+type GetTrendingPostsOutput struct {
+	PostList []*go_feed.Post
+}
+type PinPostParams struct {
+	Token string
+	ID    uint64
+}
+type UnpinPostParams struct {
+	Token string
+	ID    uint64
+}
+type NewPollParams struct {
+	Options        []string
+	ClosesAt       time.Time
+	MultipleChoice bool
+}
+type VotePollParams struct {
+	Token     string
+	PollID    uint64
+	OptionIDs []uint64
+}
+type VotePollOutput struct {
+	Poll *go_feed.Poll
+}
+type GetPollResultsParams struct {
+	Token  string
+	PollID uint64
+}
+type GetPollResultsOutput struct {
+	Poll *go_feed.Poll
+}
+
+const (
+	defaultPostPageLimit = 20
+	maxPostPageLimit     = 100
+)
 
 type PostLogic interface {
 	CreatePost(ctx context.Context, params CreatePostParams) (CreatePostOutput, error)
@@ -54,42 +207,328 @@ type PostLogic interface {
 	GetPostOfAccount(ctx context.Context, params GetPostOfAccountParams) (GetPostOfAccountOutput, error)
 	UpdatePost(ctx context.Context, params UpdatePostParams) (UpdatePostOutput, error)
 	DeletePost(ctx context.Context, params DeletePostParams) error
+	GetPostsByHashtag(ctx context.Context, params GetPostsByHashtagParams) (GetPostsByHashtagOutput, error)
+	GetPostsMentioningAccount(ctx context.Context, params GetPostsMentioningAccountParams) (GetPostsMentioningAccountOutput, error)
+	SearchPosts(ctx context.Context, params SearchPostsParams) (SearchPostsOutput, error)
+	CreateDraft(ctx context.Context, params CreateDraftParams) (CreateDraftOutput, error)
+	ListDrafts(ctx context.Context, params ListDraftsParams) (ListDraftsOutput, error)
+	UpdateDraft(ctx context.Context, params UpdateDraftParams) error
+	PublishDraft(ctx context.Context, params PublishDraftParams) error
+	PublishDuePosts(ctx context.Context) error
+	GenerateLinkPreview(ctx context.Context, postID uint64) error
+	RestorePost(ctx context.Context, params RestorePostParams) error
+	ListDeletedPosts(ctx context.Context, params ListDeletedPostsParams) (ListDeletedPostsOutput, error)
+	PurgeDeletedContent(ctx context.Context) error
+	PersistPostViewCounts(ctx context.Context) error
+	CreateBookmark(ctx context.Context, params CreateBookmarkParams) error
+	DeleteBookmark(ctx context.Context, params DeleteBookmarkParams) error
+	ListBookmarks(ctx context.Context, params ListBookmarksParams) (ListBookmarksOutput, error)
+	GetLikedPostsOfAccount(ctx context.Context, params GetLikedPostsOfAccountParams) (GetLikedPostsOfAccountOutput, error)
+	PinPost(ctx context.Context, params PinPostParams) error
+	UnpinPost(ctx context.Context, params UnpinPostParams) error
+	VotePoll(ctx context.Context, params VotePollParams) (VotePollOutput, error)
+	GetPollResults(ctx context.Context, params GetPollResultsParams) (GetPollResultsOutput, error)
+	CloseDuePolls(ctx context.Context) error
+	RecordTrendingActivity(ctx context.Context, activity producer.TrendingActivity) error
+	RefreshTrending(ctx context.Context) error
+	GetTrendingHashtags(ctx context.Context, params GetTrendingHashtagsParams) (GetTrendingHashtagsOutput, error)
+	GetTrendingPosts(ctx context.Context, params GetTrendingPostsParams) (GetTrendingPostsOutput, error)
 }
 
 type postLogic struct {
-	goquDatabase        *goqu.Database
-	postDataAccessor    database.PostDataAccessor
-	commentDataAccessor database.CommentDataAccessor
-	idGenerator         *snowNode
-	tokenLogic          TokenLogic
-	newFeedJobProducer  producer.NewFeedJobProducer
-	logger              *zap.Logger
+	goquDatabase             *goqu.Database
+	postDataAccessor         database.PostDataAccessor
+	commentDataAccessor      database.CommentDataAccessor
+	accountDataAccessor      database.AccountDataAccessor
+	followDataAccessor       database.FollowDataAccessor
+	hashtagDataAccessor      database.HashtagDataAccessor
+	mentionDataAccessor      database.MentionDataAccessor
+	pinDataAccessor          database.PinDataAccessor
+	pollDataAccessor         database.PollDataAccessor
+	linkPreviewDataAccessor  database.LinkPreviewDataAccessor
+	likeDataAccessor         database.LikeDataAccessor
+	bookmarkDataAccessor     database.BookmarkDataAccessor
+	searcher                 search.Search
+	linkPreviewFetcher       linkpreview.Fetcher
+	postView                 cache.PostView
+	postViewRecordSlots      chan struct{}
+	trending                 cache.Trending
+	counter                  cache.Counter
+	idGenerator              *snowNode
+	tokenLogic               TokenLogic
+	blockLogic               BlockLogic
+	contentPolicy            ContentPolicy
+	moderator                Moderator
+	newFeedJobProducer       producer.NewFeedJobProducer
+	linkPreviewJobProducer   producer.LinkPreviewJobProducer
+	trendingActivityProducer producer.TrendingActivityProducer
+	postConfig               configs.Post
+	logger                   *zap.Logger
 }
 
 func NewPostLogic(
 	goquDatabase *goqu.Database,
 	postDataAccessor database.PostDataAccessor,
 	commentDataAccessor database.CommentDataAccessor,
+	accountDataAccessor database.AccountDataAccessor,
+	followDataAccessor database.FollowDataAccessor,
+	hashtagDataAccessor database.HashtagDataAccessor,
+	mentionDataAccessor database.MentionDataAccessor,
+	pinDataAccessor database.PinDataAccessor,
+	pollDataAccessor database.PollDataAccessor,
+	linkPreviewDataAccessor database.LinkPreviewDataAccessor,
+	likeDataAccessor database.LikeDataAccessor,
+	bookmarkDataAccessor database.BookmarkDataAccessor,
+	searcher search.Search,
+	linkPreviewFetcher linkpreview.Fetcher,
+	postView cache.PostView,
+	trending cache.Trending,
+	counter cache.Counter,
 	idGenerator *snowNode,
 	tokenLogic TokenLogic,
+	blockLogic BlockLogic,
+	contentPolicy ContentPolicy,
+	moderator Moderator,
+	newFeedJobProducer producer.NewFeedJobProducer,
+	linkPreviewJobProducer producer.LinkPreviewJobProducer,
+	trendingActivityProducer producer.TrendingActivityProducer,
+	postConfig configs.Post,
 	logger *zap.Logger,
 ) PostLogic {
 	return &postLogic{
-		goquDatabase:        goquDatabase,
-		postDataAccessor:    postDataAccessor,
-		commentDataAccessor: commentDataAccessor,
-		idGenerator:         idGenerator,
-		tokenLogic:          tokenLogic,
-		logger:              logger,
+		goquDatabase:             goquDatabase,
+		postDataAccessor:         postDataAccessor,
+		commentDataAccessor:      commentDataAccessor,
+		accountDataAccessor:      accountDataAccessor,
+		followDataAccessor:       followDataAccessor,
+		hashtagDataAccessor:      hashtagDataAccessor,
+		mentionDataAccessor:      mentionDataAccessor,
+		pinDataAccessor:          pinDataAccessor,
+		pollDataAccessor:         pollDataAccessor,
+		linkPreviewDataAccessor:  linkPreviewDataAccessor,
+		likeDataAccessor:         likeDataAccessor,
+		bookmarkDataAccessor:     bookmarkDataAccessor,
+		searcher:                 searcher,
+		linkPreviewFetcher:       linkPreviewFetcher,
+		postView:                 postView,
+		postViewRecordSlots:      make(chan struct{}, maxPendingPostViewRecords),
+		trending:                 trending,
+		counter:                  counter,
+		idGenerator:              idGenerator,
+		tokenLogic:               tokenLogic,
+		blockLogic:               blockLogic,
+		contentPolicy:            contentPolicy,
+		moderator:                moderator,
+		newFeedJobProducer:       newFeedJobProducer,
+		linkPreviewJobProducer:   linkPreviewJobProducer,
+		trendingActivityProducer: trendingActivityProducer,
+		postConfig:               postConfig,
+		logger:                   logger,
 	}
 }
 
 func (p postLogic) databasePostToProtoPost(post database.Post) *go_feed.Post {
-	return &go_feed.Post{
-		Id:        post.ID,
-		AccountId: post.AccountID,
-		Content:   post.Content,
+	protoPost := &go_feed.Post{
+		Id:            post.ID,
+		AccountId:     post.AccountID,
+		Content:       post.Content,
+		ContentHtml:   post.ContentHTML,
+		Status:        go_feed.PostStatus_POST_STATUS_PUBLISHED,
+		ViewCount:     post.ViewCount,
+		CommentPolicy: databaseCommentPolicyToProto(post.CommentPolicy),
+	}
+	if post.Status == database.PostStatusDraft {
+		protoPost.Status = go_feed.PostStatus_POST_STATUS_DRAFT
+	}
+	if post.PublishAt.Valid {
+		protoPost.PublishAt = timestamppb.New(post.PublishAt.Time)
+	}
+	if post.DeletedAt.Valid {
+		protoPost.DeletedAt = timestamppb.New(post.DeletedAt.Time)
+	}
+	return protoPost
+}
+
+// databasePostsToProtoPosts converts posts keeping their order and attaches the mentions, poll, link preview and
+// bookmark flag of every post, as seen by viewerID.
+func (p postLogic) databasePostsToProtoPosts(ctx context.Context, viewerID uint64, postList []database.Post) ([]*go_feed.Post, error) {
+	postIDList := lo.Map(postList, func(item database.Post, _ int) uint64 {
+		return item.ID
+	})
+	mentionList, err := p.mentionDataAccessor.GetMentionsOfPosts(ctx, postIDList)
+	if err != nil {
+		return nil, err
+	}
+	accountList, err := p.accountDataAccessor.GetAccountByIDs(ctx, lo.Uniq(lo.Map(mentionList, func(item database.PostMention, _ int) uint64 {
+		return item.AccountID
+	})))
+	if err != nil {
+		return nil, err
+	}
+	accountNameMap := lo.SliceToMap(accountList, func(item database.Account) (uint64, string) {
+		return item.ID, item.Account_name
+	})
+	mentionMap := lo.GroupBy(mentionList, func(item database.PostMention) uint64 {
+		return item.PostID
+	})
+	pollList, err := p.pollDataAccessor.GetPollsOfPosts(ctx, postIDList)
+	if err != nil {
+		return nil, err
+	}
+	pollMap, err := p.databasePollsToProtoPolls(ctx, viewerID, pollList)
+	if err != nil {
+		return nil, err
+	}
+	linkPreviewList, err := p.linkPreviewDataAccessor.GetLinkPreviewsOfPosts(ctx, postIDList)
+	if err != nil {
+		return nil, err
+	}
+	linkPreviewMap := lo.SliceToMap(linkPreviewList, func(item database.LinkPreview) (uint64, *go_feed.LinkPreview) {
+		return item.PostID, &go_feed.LinkPreview{
+			Url:         item.URL,
+			Title:       item.Title,
+			Description: item.Description,
+			ImageUrl:    item.ImageURL,
+			SiteName:    item.SiteName,
+		}
+	})
+	bookmarkedPostIDList, err := p.bookmarkDataAccessor.GetBookmarkedPostIDs(ctx, viewerID, postIDList)
+	if err != nil {
+		return nil, err
+	}
+	bookmarkedPostIDSet := lo.SliceToMap(bookmarkedPostIDList, func(item uint64) (uint64, struct{}) {
+		return item, struct{}{}
+	})
+
+	return lo.Map(postList, func(item database.Post, _ int) *go_feed.Post {
+		post := p.databasePostToProtoPost(item)
+		post.Mentions = lo.Map(mentionMap[item.ID], func(mention database.PostMention, _ int) *go_feed.Mention {
+			return &go_feed.Mention{
+				AccountId:   mention.AccountID,
+				AccountName: accountNameMap[mention.AccountID],
+				Start:       mention.StartOffset,
+				End:         mention.EndOffset,
+			}
+		})
+		post.Poll = pollMap[item.ID]
+		post.LinkPreview = linkPreviewMap[item.ID]
+		_, post.IsBookmarked = bookmarkedPostIDSet[item.ID]
+		return post
+	}), nil
+}
+
+// getHiddenAuthorIDs returns the authors of postList whose posts viewerID must not be shown: the accounts blocked
+// either way, the private accounts viewerID does not follow and, when skipMuted is set, the accounts viewerID muted.
+func (p postLogic) getHiddenAuthorIDs(ctx context.Context, viewerID uint64, postList []database.Post, skipMuted bool) (map[uint64]struct{}, error) {
+	authorIDList := lo.Map(postList, func(item database.Post, _ int) uint64 {
+		return item.AccountID
+	})
+	hiddenAuthorIDList, err := p.blockLogic.GetHiddenAccountIDs(ctx, viewerID, authorIDList)
+	if err != nil {
+		return nil, err
+	}
+	if skipMuted {
+		mutedAuthorIDList, err := p.blockLogic.GetMutedAccountIDs(ctx, viewerID, authorIDList)
+		if err != nil {
+			return nil, err
+		}
+		hiddenAuthorIDList = append(hiddenAuthorIDList, mutedAuthorIDList...)
+	}
+	return lo.SliceToMap(hiddenAuthorIDList, func(item uint64) (uint64, struct{}) {
+		return item, struct{}{}
+	}), nil
+}
+
+// getPostsByIDs loads posts and returns them in the order of postIDList, skipping the ones that no longer exist,
+// are not published or whose author is hidden from the viewer. Posts of muted authors are skipped too when skipMuted is
+// set, which is the case for the lists the viewer browses rather than the ones it built itself like its bookmarks.
+func (p postLogic) getPostsByIDs(ctx context.Context, viewerID uint64, postIDList []uint64, skipMuted bool) ([]*go_feed.Post, error) {
+	postList, err := p.postDataAccessor.GetPostByIDs(ctx, postIDList)
+	if err != nil {
+		return nil, err
+	}
+	hiddenAuthorIDSet, err := p.getHiddenAuthorIDs(ctx, viewerID, postList, skipMuted)
+	if err != nil {
+		return nil, err
+	}
+	postMap := lo.SliceToMap(postList, func(item database.Post) (uint64, database.Post) {
+		return item.ID, item
+	})
+	orderedPostList := make([]database.Post, 0, len(postList))
+	for _, postID := range postIDList {
+		post, ok := postMap[postID]
+		if !ok || post.Status != database.PostStatusPublished {
+			continue
+		}
+		if _, hidden := hiddenAuthorIDSet[post.AccountID]; hidden {
+			continue
+		}
+		orderedPostList = append(orderedPostList, post)
+	}
+	protoPostList, err := p.databasePostsToProtoPosts(ctx, viewerID, orderedPostList)
+	if err != nil {
+		return nil, err
+	}
+	p.recordPostViews(ctx, viewerID, protoPostList)
+	return protoPostList, nil
+}
+
+// savePostEntities indexes the hashtags of a post and stores the mentions that resolve to existing accounts.
+func (p postLogic) savePostEntities(ctx context.Context, td *goqu.TxDatabase, postID uint64, content string) error {
+	hashtags, mentions := extractPostEntities(content)
+	err := p.hashtagDataAccessor.WithDatabase(td).CreatePostHashtags(ctx, postID, hashtags)
+	if err != nil {
+		return err
+	}
+
+	accountList, err := p.accountDataAccessor.WithDatabase(td).GetAccountsByAccountNames(ctx, lo.Uniq(lo.Map(mentions, func(item postMention, _ int) string {
+		return item.AccountName
+	})))
+	if err != nil {
+		return err
+	}
+	accountIDMap := lo.SliceToMap(accountList, func(item database.Account) (string, uint64) {
+		return item.Account_name, item.ID
+	})
+	postMentionList := make([]database.PostMention, 0, len(mentions))
+	for _, mention := range mentions {
+		accountID, ok := accountIDMap[mention.AccountName]
+		if !ok {
+			continue
+		}
+		postMentionList = append(postMentionList, database.PostMention{
+			PostID:      postID,
+			AccountID:   accountID,
+			StartOffset: uint32(mention.Start),
+			EndOffset:   uint32(mention.End),
+		})
+	}
+	return p.mentionDataAccessor.WithDatabase(td).CreatePostMentions(ctx, postMentionList)
+}
+
+func (p postLogic) deletePostEntities(ctx context.Context, td *goqu.TxDatabase, postID uint64) error {
+	err := p.hashtagDataAccessor.WithDatabase(td).DeleteHashtagsOfPost(ctx, postID)
+	if err != nil {
+		return err
+	}
+	return p.mentionDataAccessor.WithDatabase(td).DeleteMentionsOfPost(ctx, postID)
+}
+
+func (p postLogic) indexPost(ctx context.Context, post database.Post) {
+	err := p.searcher.IndexPost(ctx, search.PostDocument{
+		ID:      post.ID,
+		Content: post.Content,
+	})
+	if err != nil {
+		utils.LoggerWithContext(ctx, p.logger).With(zap.Error(err)).Warn("failed to index post for search")
+	}
+}
+
+func (p postLogic) getPageLimit(limit uint64) uint64 {
+	if limit == 0 {
+		return defaultPostPageLimit
 	}
+	return min(limit, maxPostPageLimit)
 }
 func (p postLogic) CreatePost(ctx context.Context, params CreatePostParams) (CreatePostOutput, error) {
 	// Authorization -> Insert DB
@@ -97,16 +536,48 @@ func (p postLogic) CreatePost(ctx context.Context, params CreatePostParams) (Cre
 	if err != nil {
 		return CreatePostOutput{}, err
 	}
+	content, err := p.contentPolicy.ProcessPostContent(params.Content)
+	if err != nil {
+		return CreatePostOutput{}, err
+	}
+	err = moderateContent(ctx, p.moderator, ModerationInput{
+		ContentType: ModeratedContentTypePost,
+		AccountID:   accountID,
+		Text:        content.Text,
+	})
+	if err != nil {
+		return CreatePostOutput{}, err
+	}
+	var pollOptions []string
+	if params.Poll != nil {
+		pollOptions, err = validateNewPoll(*params.Poll, time.Now())
+		if err != nil {
+			return CreatePostOutput{}, err
+		}
+	}
 	postID := p.idGenerator.GenID()
 	txErr := p.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
 		postID, err = p.postDataAccessor.WithDatabase(td).CreatePost(ctx, database.Post{
-			ID:        postID,
-			AccountID: accountID,
-			Content:   params.Content,
+			ID:          postID,
+			AccountID:   accountID,
+			Content:     content.Text,
+			ContentHTML: content.HTML,
+			Status:      database.PostStatusPublished,
+			PublishAt:   sql.NullTime{Time: time.Now(), Valid: true},
 		})
 		if err != nil {
 			return err
 		}
+		err = p.savePostEntities(ctx, td, postID, content.Text)
+		if err != nil {
+			return err
+		}
+		if params.Poll != nil {
+			err = p.createPoll(ctx, td, postID, *params.Poll, pollOptions)
+			if err != nil {
+				return err
+			}
+		}
 		// Producer to Kafka
 		producerErr := p.newFeedJobProducer.Produce(ctx, producer.NewFeedJob{
 			PostID: postID,
@@ -119,13 +590,22 @@ func (p postLogic) CreatePost(ctx context.Context, params CreatePostParams) (Cre
 	if txErr != nil {
 		return CreatePostOutput{}, txErr
 	}
+	p.indexPost(ctx, database.Post{
+		ID:        postID,
+		AccountID: accountID,
+		Content:   content.Text,
+	})
+	if len(extractPostURLs(content.Text)) > 0 {
+		p.enqueueLinkPreview(ctx, postID)
+	}
+	p.enqueueTrendingActivity(ctx, producer.TrendingActivityTypePost, postID, accountID)
 	return CreatePostOutput{
 		ID: postID,
 	}, nil
 
 }
 func (p postLogic) GetPostByID(ctx context.Context, params GetPostByIDParams) (GetPostByIDOutput, error) {
-	_, _, err := p.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
+	accountID, _, err := p.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
 	if err != nil {
 		return GetPostByIDOutput{}, err
 	}
@@ -133,24 +613,124 @@ func (p postLogic) GetPostByID(ctx context.Context, params GetPostByIDParams) (G
 	if err != nil {
 		return GetPostByIDOutput{}, err
 	}
+	if err = p.blockLogic.CheckCanViewPost(ctx, accountID, post); err != nil {
+		return GetPostByIDOutput{}, err
+	}
+	protoPostList, err := p.databasePostsToProtoPosts(ctx, accountID, []database.Post{post})
+	if err != nil {
+		return GetPostByIDOutput{}, err
+	}
+	p.recordPostViews(ctx, accountID, protoPostList)
 	return GetPostByIDOutput{
-		p.databasePostToProtoPost(post),
+		protoPostList[0],
 	}, nil
 }
 
+// checkCanViewPostsOfAccount returns an error when the account and viewerID are blocked either way, or when the
+// account is private and viewerID is neither the account nor one of its followers. Accounts whose request to follow
+// is still pending are not followers yet.
+func (p postLogic) checkCanViewPostsOfAccount(ctx context.Context, viewerID uint64, accountID uint64) error {
+	if viewerID == accountID {
+		return nil
+	}
+	isBlocked, err := p.blockLogic.IsBlockedEitherWay(ctx, viewerID, accountID)
+	if err != nil {
+		return err
+	}
+	if isBlocked {
+		return status.Error(codes.PermissionDenied, "cannot see the posts of an account that is blocked either way")
+	}
+	account, err := p.accountDataAccessor.GetAccountByID(ctx, accountID)
+	if err != nil {
+		return err
+	}
+	if !account.IsPrivate {
+		return nil
+	}
+	isFollowing, err := p.followDataAccessor.IsFollowing(ctx, viewerID, accountID)
+	if err != nil {
+		return err
+	}
+	if !isFollowing {
+		return status.Error(codes.PermissionDenied, "the account is private, only its followers can see its posts")
+	}
+	return nil
+}
+
+// GetPostOfAccount returns a page of the posts of an account, the most recent first. The pinned posts come first on
+// the first page and are left out of the following ones.
 func (p postLogic) GetPostOfAccount(ctx context.Context, params GetPostOfAccountParams) (GetPostOfAccountOutput, error) {
-	_, _, err := p.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
+	accountID, _, err := p.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
 	if err != nil {
 		return GetPostOfAccountOutput{}, err
 	}
-	postList, err := p.postDataAccessor.GetPostsOfAccount(ctx, params.Of_account)
+	if err = p.checkCanViewPostsOfAccount(ctx, accountID, params.Of_account); err != nil {
+		return GetPostOfAccountOutput{}, err
+	}
+	beforeID, err := decodeCursor(params.Cursor)
+	if err != nil {
+		return GetPostOfAccountOutput{}, err
+	}
+	limit := p.getPageLimit(params.Limit)
+	includeDrafts := accountID == params.Of_account
+
+	pinnedPostIDList, err := p.pinDataAccessor.GetPinnedPostIDsOfAccount(ctx, params.Of_account)
 	if err != nil {
 		return GetPostOfAccountOutput{}, err
 	}
+	pinnedPostIDSet := lo.SliceToMap(pinnedPostIDList, func(item uint64) (uint64, struct{}) {
+		return item, struct{}{}
+	})
+	// The pinned posts lead the first page and take up part of its limit, they are left out of every page after
+	// that.
+	pinnedPostList := make([]database.Post, 0)
+	if beforeID == 0 {
+		pinnedPostList, err = p.postDataAccessor.GetPostByIDs(ctx, pinnedPostIDList)
+		if err != nil {
+			return GetPostOfAccountOutput{}, err
+		}
+		pinnedPostList = lo.Filter(pinnedPostList, func(item database.Post, _ int) bool {
+			return item.AccountID == params.Of_account && (includeDrafts || item.Status == database.PostStatusPublished)
+		})
+		pinnedPostList = pinnedPostsFirst(pinnedPostList, pinnedPostIDList)
+		if uint64(len(pinnedPostList)) > limit {
+			pinnedPostList = pinnedPostList[:limit]
+		}
+	}
+	pageLimit := limit - uint64(len(pinnedPostList))
+
+	// Enough rows are fetched to fill the page even when every pinned post is among them.
+	postList, err := p.postDataAccessor.GetPostsOfAccount(ctx, params.Of_account, includeDrafts, beforeID, pageLimit+uint64(len(pinnedPostIDList))+1)
+	if err != nil {
+		return GetPostOfAccountOutput{}, err
+	}
+	postList = lo.Filter(postList, func(item database.Post, _ int) bool {
+		_, isPinned := pinnedPostIDSet[item.ID]
+		return !isPinned
+	})
+	nextCursor := ""
+	if uint64(len(postList)) > pageLimit {
+		postList = postList[:pageLimit]
+		if len(postList) > 0 {
+			nextCursor = encodeCursor(postList[len(postList)-1].ID)
+		} else {
+			// The pinned posts filled the whole first page, the next page starts from the newest post.
+			nextCursor = encodeCursor(math.MaxUint64)
+		}
+	}
+	postList = append(pinnedPostList, postList...)
+
+	protoPostList, err := p.databasePostsToProtoPosts(ctx, accountID, postList)
+	if err != nil {
+		return GetPostOfAccountOutput{}, err
+	}
+	for _, post := range protoPostList {
+		_, post.IsPinned = pinnedPostIDSet[post.Id]
+	}
+	p.recordPostViews(ctx, accountID, protoPostList)
 	return GetPostOfAccountOutput{
-		PostList: lo.Map(postList, func(item database.Post, _ int) *go_feed.Post {
-			return p.databasePostToProtoPost(item)
-		}),
+		PostList:   protoPostList,
+		NextCursor: nextCursor,
 	}, nil
 }
 func (p postLogic) UpdatePost(ctx context.Context, params UpdatePostParams) (UpdatePostOutput, error) {
@@ -158,6 +738,18 @@ func (p postLogic) UpdatePost(ctx context.Context, params UpdatePostParams) (Upd
 	if err != nil {
 		return UpdatePostOutput{}, err
 	}
+	content, err := p.contentPolicy.ProcessPostContent(params.Content)
+	if err != nil {
+		return UpdatePostOutput{}, err
+	}
+	err = moderateContent(ctx, p.moderator, ModerationInput{
+		ContentType: ModeratedContentTypePost,
+		AccountID:   account_id,
+		Text:        content.Text,
+	})
+	if err != nil {
+		return UpdatePostOutput{}, err
+	}
 	txErr := p.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
 		post, err := p.postDataAccessor.WithDatabase(td).GetPostByIDWithXLock(ctx, params.ID)
 		if err != nil {
@@ -166,19 +758,36 @@ func (p postLogic) UpdatePost(ctx context.Context, params UpdatePostParams) (Upd
 		if account_id != post.AccountID {
 			return status.Error(codes.PermissionDenied, "trying to update a post the account does not own")
 		}
+		if post.Status == database.PostStatusDraft {
+			return status.Error(codes.FailedPrecondition, "drafts must be updated with UpdateDraft")
+		}
 
-		post.Content = params.Content
+		post.Content = content.Text
+		post.ContentHTML = content.HTML
 		err = p.postDataAccessor.WithDatabase(td).UpdatePost(ctx, post)
 		if err != nil {
 			return err
 		}
-		return nil
+		err = p.deletePostEntities(ctx, td, post.ID)
+		if err != nil {
+			return err
+		}
+		return p.savePostEntities(ctx, td, post.ID, post.Content)
 	})
 	if txErr != nil {
 		return UpdatePostOutput{}, txErr
 	}
+	p.indexPost(ctx, database.Post{
+		ID:      params.ID,
+		Content: content.Text,
+	})
+	// Always regenerate, the link may have been removed or replaced.
+	p.enqueueLinkPreview(ctx, params.ID)
 	return UpdatePostOutput{}, nil
 }
+
+// DeletePost moves a post to the trash bin. Its comments, likes, poll and link preview are kept so that RestorePost
+// can bring it back, the purge worker removes them along with the post once the retention period is over.
 func (p postLogic) DeletePost(ctx context.Context, params DeletePostParams) error {
 	account_id, _, err := p.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
 	if err != nil {
@@ -192,18 +801,81 @@ func (p postLogic) DeletePost(ctx context.Context, params DeletePostParams) erro
 		if account_id != post.AccountID {
 			return status.Error(codes.PermissionDenied, "trying to delete a post the account does not own")
 		}
-		err = p.commentDataAccessor.WithDatabase(td).DeleteCommentOfPost(ctx, params.ID)
+		post.DeletedAt = toNullTime(time.Now())
+		err = p.postDataAccessor.WithDatabase(td).UpdatePost(ctx, post)
 		if err != nil {
 			return err
 		}
-		err = p.postDataAccessor.WithDatabase(td).DeletePost(ctx, params.ID)
+		err = p.deletePostEntities(ctx, td, params.ID)
 		if err != nil {
 			return err
 		}
-		return nil
+		return p.pinDataAccessor.WithDatabase(td).DeletePinsOfPost(ctx, params.ID)
 	})
 	if txErr != nil {
 		return txErr
 	}
+	err = p.searcher.DeletePost(ctx, params.ID)
+	if err != nil {
+		utils.LoggerWithContext(ctx, p.logger).With(zap.Error(err)).Warn("failed to remove post from search")
+	}
 	return nil
 }
+func (p postLogic) GetPostsByHashtag(ctx context.Context, params GetPostsByHashtagParams) (GetPostsByHashtagOutput, error) {
+	accountID, _, err := p.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
+	if err != nil {
+		return GetPostsByHashtagOutput{}, err
+	}
+	hashtag := normalizeHashtag(params.Hashtag)
+	if hashtag == "" {
+		return GetPostsByHashtagOutput{}, status.Error(codes.InvalidArgument, "hashtag is required")
+	}
+	postIDList, err := p.hashtagDataAccessor.GetPostIDsByHashtag(ctx, hashtag, params.Offset, p.getPageLimit(params.Limit))
+	if err != nil {
+		return GetPostsByHashtagOutput{}, err
+	}
+	postList, err := p.getPostsByIDs(ctx, accountID, postIDList, true)
+	if err != nil {
+		return GetPostsByHashtagOutput{}, err
+	}
+	return GetPostsByHashtagOutput{
+		PostList: postList,
+	}, nil
+}
+func (p postLogic) GetPostsMentioningAccount(ctx context.Context, params GetPostsMentioningAccountParams) (GetPostsMentioningAccountOutput, error) {
+	accountID, _, err := p.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
+	if err != nil {
+		return GetPostsMentioningAccountOutput{}, err
+	}
+	postIDList, err := p.mentionDataAccessor.GetPostIDsMentioningAccount(ctx, params.AccountID, params.Offset, p.getPageLimit(params.Limit))
+	if err != nil {
+		return GetPostsMentioningAccountOutput{}, err
+	}
+	postList, err := p.getPostsByIDs(ctx, accountID, postIDList, true)
+	if err != nil {
+		return GetPostsMentioningAccountOutput{}, err
+	}
+	return GetPostsMentioningAccountOutput{
+		PostList: postList,
+	}, nil
+}
+func (p postLogic) SearchPosts(ctx context.Context, params SearchPostsParams) (SearchPostsOutput, error) {
+	accountID, _, err := p.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
+	if err != nil {
+		return SearchPostsOutput{}, err
+	}
+	if strings.TrimSpace(params.Query) == "" {
+		return SearchPostsOutput{}, status.Error(codes.InvalidArgument, "query is required")
+	}
+	postIDList, err := p.searcher.SearchPosts(ctx, params.Query, params.Offset, p.getPageLimit(params.Limit))
+	if err != nil {
+		return SearchPostsOutput{}, err
+	}
+	postList, err := p.getPostsByIDs(ctx, accountID, postIDList, true)
+	if err != nil {
+		return SearchPostsOutput{}, err
+	}
+	return SearchPostsOutput{
+		PostList: postList,
+	}, nil
+}
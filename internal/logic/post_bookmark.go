@@ -0,0 +1,76 @@
+package logic
+
+import (
+	"GoFeed/internal/dataaccess/database"
+	"context"
+	"time"
+
+	"github.com/samber/lo"
+)
+
+// CreateBookmark privately saves a post the account can see. Bookmarking an already bookmarked post is a no-op.
+func (p postLogic) CreateBookmark(ctx context.Context, params CreateBookmarkParams) error {
+	accountID, _, err := p.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
+	if err != nil {
+		return err
+	}
+	post, err := p.postDataAccessor.GetPostByID(ctx, params.PostID)
+	if err != nil {
+		return err
+	}
+	if post.Status != database.PostStatusPublished {
+		return database.ErrPostNotFound
+	}
+	if err = p.blockLogic.CheckCanViewPost(ctx, accountID, post); err != nil {
+		return err
+	}
+	return p.bookmarkDataAccessor.CreateBookmark(ctx, database.Bookmark{
+		ID:        p.idGenerator.GenID(),
+		AccountID: accountID,
+		PostID:    params.PostID,
+		CreatedAt: time.Now(),
+	})
+}
+
+// DeleteBookmark removes a post from the account's bookmarks. Removing a post that is not bookmarked is a no-op.
+func (p postLogic) DeleteBookmark(ctx context.Context, params DeleteBookmarkParams) error {
+	accountID, _, err := p.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
+	if err != nil {
+		return err
+	}
+	return p.bookmarkDataAccessor.DeleteBookmark(ctx, accountID, params.PostID)
+}
+
+// ListBookmarks returns the account's own bookmarks, the most recently saved first. Posts that were deleted or are no
+// longer visible to the account, such as posts of a private account it no longer follows, are left out.
+func (p postLogic) ListBookmarks(ctx context.Context, params ListBookmarksParams) (ListBookmarksOutput, error) {
+	accountID, _, err := p.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
+	if err != nil {
+		return ListBookmarksOutput{}, err
+	}
+	beforeID, err := decodeCursor(params.Cursor)
+	if err != nil {
+		return ListBookmarksOutput{}, err
+	}
+	limit := p.getPageLimit(params.Limit)
+	bookmarkList, err := p.bookmarkDataAccessor.GetBookmarksOfAccount(ctx, accountID, beforeID, limit+1)
+	if err != nil {
+		return ListBookmarksOutput{}, err
+	}
+
+	nextCursor := ""
+	if uint64(len(bookmarkList)) > limit {
+		bookmarkList = bookmarkList[:limit]
+		nextCursor = encodeCursor(bookmarkList[len(bookmarkList)-1].ID)
+	}
+	postList, err := p.getPostsByIDs(ctx, accountID, lo.Map(bookmarkList, func(item database.Bookmark, _ int) uint64 {
+		return item.PostID
+	}), false)
+	if err != nil {
+		return ListBookmarksOutput{}, err
+	}
+	return ListBookmarksOutput{
+		PostList:   postList,
+		NextCursor: nextCursor,
+	}, nil
+}
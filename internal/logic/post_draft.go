@@ -0,0 +1,223 @@
+package logic
+
+import (
+	"GoFeed/internal/dataaccess/database"
+	"GoFeed/internal/dataaccess/mq/producer"
+	"GoFeed/internal/utils"
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultPostSchedulerBatchSize = 100
+)
+
+var (
+	errPostIsNotDraft = status.Error(codes.FailedPrecondition, "post is not a draft")
+)
+
+func toNullTime(t time.Time) sql.NullTime {
+	return sql.NullTime{Time: t, Valid: !t.IsZero()}
+}
+
+func (p postLogic) CreateDraft(ctx context.Context, params CreateDraftParams) (CreateDraftOutput, error) {
+	accountID, _, err := p.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
+	if err != nil {
+		return CreateDraftOutput{}, err
+	}
+	content, err := p.contentPolicy.ProcessPostContent(params.Content)
+	if err != nil {
+		return CreateDraftOutput{}, err
+	}
+	err = moderateContent(ctx, p.moderator, ModerationInput{
+		ContentType: ModeratedContentTypePost,
+		AccountID:   accountID,
+		Text:        content.Text,
+	})
+	if err != nil {
+		return CreateDraftOutput{}, err
+	}
+	postID, err := p.postDataAccessor.CreatePost(ctx, database.Post{
+		ID:          p.idGenerator.GenID(),
+		AccountID:   accountID,
+		Content:     content.Text,
+		ContentHTML: content.HTML,
+		Status:      database.PostStatusDraft,
+		PublishAt:   toNullTime(params.PublishAt),
+	})
+	if err != nil {
+		return CreateDraftOutput{}, err
+	}
+	return CreateDraftOutput{
+		ID: postID,
+	}, nil
+}
+
+func (p postLogic) ListDrafts(ctx context.Context, params ListDraftsParams) (ListDraftsOutput, error) {
+	accountID, _, err := p.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
+	if err != nil {
+		return ListDraftsOutput{}, err
+	}
+	draftList, err := p.postDataAccessor.GetDraftsOfAccount(ctx, accountID)
+	if err != nil {
+		return ListDraftsOutput{}, err
+	}
+	protoPostList, err := p.databasePostsToProtoPosts(ctx, accountID, draftList)
+	if err != nil {
+		return ListDraftsOutput{}, err
+	}
+	return ListDraftsOutput{
+		PostList: protoPostList,
+	}, nil
+}
+
+func (p postLogic) UpdateDraft(ctx context.Context, params UpdateDraftParams) error {
+	accountID, _, err := p.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
+	if err != nil {
+		return err
+	}
+	content, err := p.contentPolicy.ProcessPostContent(params.Content)
+	if err != nil {
+		return err
+	}
+	err = moderateContent(ctx, p.moderator, ModerationInput{
+		ContentType: ModeratedContentTypePost,
+		AccountID:   accountID,
+		Text:        content.Text,
+	})
+	if err != nil {
+		return err
+	}
+	return p.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		post, err := p.postDataAccessor.WithDatabase(td).GetPostByIDWithXLock(ctx, params.ID)
+		if err != nil {
+			return err
+		}
+		if post.AccountID != accountID {
+			return status.Error(codes.PermissionDenied, "trying to update a draft the account does not own")
+		}
+		if post.Status != database.PostStatusDraft {
+			return errPostIsNotDraft
+		}
+		post.Content = content.Text
+		post.ContentHTML = content.HTML
+		post.PublishAt = toNullTime(params.PublishAt)
+		return p.postDataAccessor.WithDatabase(td).UpdatePost(ctx, post)
+	})
+}
+
+// PublishDraft publishes a draft right away, or schedules it when PublishAt is in the future.
+func (p postLogic) PublishDraft(ctx context.Context, params PublishDraftParams) error {
+	accountID, _, err := p.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	if params.PublishAt.After(now) {
+		return p.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+			post, err := p.postDataAccessor.WithDatabase(td).GetPostByIDWithXLock(ctx, params.ID)
+			if err != nil {
+				return err
+			}
+			if post.AccountID != accountID {
+				return status.Error(codes.PermissionDenied, "trying to publish a draft the account does not own")
+			}
+			if post.Status != database.PostStatusDraft {
+				return errPostIsNotDraft
+			}
+			post.PublishAt = toNullTime(params.PublishAt)
+			return p.postDataAccessor.WithDatabase(td).UpdatePost(ctx, post)
+		})
+	}
+
+	return p.publishDraft(ctx, params.ID, func(post database.Post) error {
+		if post.AccountID != accountID {
+			return status.Error(codes.PermissionDenied, "trying to publish a draft the account does not own")
+		}
+		if post.Status != database.PostStatusDraft {
+			return errPostIsNotDraft
+		}
+		return nil
+	}, now)
+}
+
+// PublishDuePosts publishes every scheduled draft whose publish_at has passed. It is run by the post scheduler worker.
+func (p postLogic) PublishDuePosts(ctx context.Context) error {
+	logger := utils.LoggerWithContext(ctx, p.logger)
+
+	batchSize := p.postConfig.SchedulerBatchSize
+	if batchSize == 0 {
+		batchSize = defaultPostSchedulerBatchSize
+	}
+
+	now := time.Now()
+	postIDList, err := p.postDataAccessor.GetDuePostIDs(ctx, now, batchSize)
+	if err != nil {
+		return err
+	}
+
+	var publishErr error
+	for _, postID := range postIDList {
+		err = p.publishDraft(ctx, postID, func(post database.Post) error {
+			// Another scheduler instance may have published it already.
+			if post.Status != database.PostStatusDraft || !post.PublishAt.Valid || post.PublishAt.Time.After(now) {
+				return errPostIsNotDraft
+			}
+			return nil
+		}, now)
+		if err != nil && !errors.Is(err, errPostIsNotDraft) {
+			logger.With(zap.Uint64("post_id", postID)).With(zap.Error(err)).Error("failed to publish scheduled post")
+			publishErr = errors.Join(publishErr, err)
+		}
+	}
+	return publishErr
+}
+
+// publishDraft flips a draft to published once check passes and emits the new feed job within the same
+// transaction. The post is indexed only after the change is committed.
+func (p postLogic) publishDraft(ctx context.Context, postID uint64, check func(post database.Post) error, now time.Time) error {
+	var publishedPost database.Post
+	txErr := p.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		post, err := p.postDataAccessor.WithDatabase(td).GetPostByIDWithXLock(ctx, postID)
+		if err != nil {
+			return err
+		}
+		if err = check(post); err != nil {
+			return err
+		}
+		post.Status = database.PostStatusPublished
+		post.PublishAt = toNullTime(now)
+		err = p.postDataAccessor.WithDatabase(td).UpdatePost(ctx, post)
+		if err != nil {
+			return err
+		}
+		publishedPost = post
+		err = p.savePostEntities(ctx, td, post.ID, post.Content)
+		if err != nil {
+			return err
+		}
+		// Produced before the commit, same as CreatePost: if it fails the draft stays a draft and the scheduler
+		// retries it on the next run instead of leaving a published post that was never fanned out.
+		return p.newFeedJobProducer.Produce(ctx, producer.NewFeedJob{
+			PostID: post.ID,
+		})
+	})
+	if txErr != nil {
+		return txErr
+	}
+
+	p.indexPost(ctx, publishedPost)
+	if len(extractPostURLs(publishedPost.Content)) > 0 {
+		p.enqueueLinkPreview(ctx, publishedPost.ID)
+	}
+	p.enqueueTrendingActivity(ctx, producer.TrendingActivityTypePost, publishedPost.ID, publishedPost.AccountID)
+	return nil
+}
@@ -0,0 +1,106 @@
+package logic
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+const (
+	hashtagPrefix = '#'
+	mentionPrefix = '@'
+
+	// maxHashtagLength matches the size of the hashtag column, longer hashtags are not indexed.
+	maxHashtagLength = 256
+)
+
+var (
+	postURLRegexp = regexp.MustCompile(`https?://[^\s<>"]+`)
+)
+
+type postMention struct {
+	AccountName string
+	Start       int
+	End         int
+}
+
+func isPostEntityRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_'
+}
+
+func isAllDigits(s string) bool {
+	for _, r := range s {
+		if !unicode.IsDigit(r) {
+			return false
+		}
+	}
+	return true
+}
+
+func normalizeHashtag(hashtag string) string {
+	return strings.ToLower(strings.TrimPrefix(strings.TrimSpace(hashtag), string(hashtagPrefix)))
+}
+
+// extractPostEntities returns the distinct lowercased hashtags and every @mention found in content. Hashtags longer
+// than maxHashtagLength runes are skipped. Mention offsets are expressed in runes so they line up with what clients
+// render.
+func extractPostEntities(content string) ([]string, []postMention) {
+	runes := []rune(content)
+	hashtags := make([]string, 0)
+	hashtagSet := make(map[string]struct{})
+	mentions := make([]postMention, 0)
+
+	for i := 0; i < len(runes); i++ {
+		if runes[i] != hashtagPrefix && runes[i] != mentionPrefix {
+			continue
+		}
+		// Skip things like email addresses or "a#b" where the prefix is glued to a word.
+		if i > 0 && isPostEntityRune(runes[i-1]) {
+			continue
+		}
+
+		end := i + 1
+		for end < len(runes) && isPostEntityRune(runes[end]) {
+			end++
+		}
+		if end == i+1 {
+			continue
+		}
+
+		name := string(runes[i+1 : end])
+		if runes[i] == hashtagPrefix {
+			if isAllDigits(name) || end-i-1 > maxHashtagLength {
+				i = end - 1
+				continue
+			}
+			hashtag := strings.ToLower(name)
+			if _, ok := hashtagSet[hashtag]; !ok {
+				hashtagSet[hashtag] = struct{}{}
+				hashtags = append(hashtags, hashtag)
+			}
+		} else {
+			mentions = append(mentions, postMention{
+				AccountName: name,
+				Start:       i,
+				End:         end,
+			})
+		}
+		i = end - 1
+	}
+
+	return hashtags, mentions
+}
+
+// extractPostURLs returns the http and https urls found in content in order of appearance, without the
+// punctuation that usually follows a link in a sentence.
+func extractPostURLs(content string) []string {
+	urls := make([]string, 0)
+	for _, match := range postURLRegexp.FindAllString(content, -1) {
+		match = strings.TrimRight(match, ".,;:!?'")
+		if strings.HasSuffix(match, ")") && !strings.Contains(match, "(") {
+			match = strings.TrimRight(match, ")")
+		}
+		urls = append(urls, match)
+	}
+	return urls
+}
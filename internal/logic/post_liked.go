@@ -0,0 +1,72 @@
+package logic
+
+import (
+	"GoFeed/internal/dataaccess/database"
+	"GoFeed/internal/generated/api/go_feed"
+	"context"
+	"time"
+
+	"github.com/samber/lo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetLikedPostsOfAccount returns the posts an account reacted to with a type, likes when it is unspecified, the most
+// recently liked first. Only the accounts that can see the posts of the account can see its likes, and accounts that
+// hide their likes only let themselves see them. Posts that were deleted or are no longer published are left out, so a
+// page can be shorter than the limit.
+func (p postLogic) GetLikedPostsOfAccount(ctx context.Context, params GetLikedPostsOfAccountParams) (GetLikedPostsOfAccountOutput, error) {
+	viewerID, _, err := p.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
+	if err != nil {
+		return GetLikedPostsOfAccountOutput{}, err
+	}
+	reactionType := database.ReactionTypeLike
+	if params.ReactionType != go_feed.ReactionType_REACTION_TYPE_UNSPECIFIED {
+		reactionType, err = protoReactionTypeToDatabase(params.ReactionType)
+		if err != nil {
+			return GetLikedPostsOfAccountOutput{}, err
+		}
+	}
+	beforeMicro, beforePostID, err := decodeScoredCursor(params.Cursor)
+	if err != nil {
+		return GetLikedPostsOfAccountOutput{}, err
+	}
+	if err = p.checkCanViewPostsOfAccount(ctx, viewerID, params.AccountID); err != nil {
+		return GetLikedPostsOfAccountOutput{}, err
+	}
+	if params.AccountID != viewerID {
+		account, err := p.accountDataAccessor.GetAccountByID(ctx, params.AccountID)
+		if err != nil {
+			return GetLikedPostsOfAccountOutput{}, err
+		}
+		if account.LikesHidden {
+			return GetLikedPostsOfAccountOutput{}, status.Error(codes.PermissionDenied, "the account hides the posts it liked")
+		}
+	}
+
+	limit := p.getPageLimit(params.Limit)
+	likeList, err := p.likeDataAccessor.GetLikesOfAccount(ctx, params.AccountID, reactionType, database.LikeCursor{
+		CreatedAt: time.UnixMicro(int64(beforeMicro)),
+		PostID:    beforePostID,
+	}, limit+1)
+	if err != nil {
+		return GetLikedPostsOfAccountOutput{}, err
+	}
+
+	nextCursor := ""
+	if uint64(len(likeList)) > limit {
+		likeList = likeList[:limit]
+		lastLike := likeList[len(likeList)-1]
+		nextCursor = encodeScoredCursor(uint64(lastLike.CreatedAt.UnixMicro()), lastLike.PostID)
+	}
+	postList, err := p.getPostsByIDs(ctx, viewerID, lo.Map(likeList, func(item database.Like, _ int) uint64 {
+		return item.PostID
+	}), false)
+	if err != nil {
+		return GetLikedPostsOfAccountOutput{}, err
+	}
+	return GetLikedPostsOfAccountOutput{
+		PostList:   postList,
+		NextCursor: nextCursor,
+	}, nil
+}
@@ -0,0 +1,63 @@
+package logic
+
+import (
+	"GoFeed/internal/dataaccess/database"
+	"GoFeed/internal/dataaccess/mq/producer"
+	"GoFeed/internal/utils"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"go.uber.org/zap"
+)
+
+func (p postLogic) enqueueLinkPreview(ctx context.Context, postID uint64) {
+	err := p.linkPreviewJobProducer.Produce(ctx, producer.LinkPreviewJob{
+		PostID: postID,
+	})
+	if err != nil {
+		utils.LoggerWithContext(ctx, p.logger).With(zap.Error(err)).Warn("failed to enqueue link preview job")
+	}
+}
+
+// GenerateLinkPreview fetches the preview of the first link of a post and replaces the stored one. A page that
+// cannot be fetched only drops the preview, so a bad link never blocks the queue.
+func (p postLogic) GenerateLinkPreview(ctx context.Context, postID uint64) error {
+	logger := utils.LoggerWithContext(ctx, p.logger).With(zap.Uint64("post_id", postID))
+
+	post, err := p.postDataAccessor.GetPostByID(ctx, postID)
+	if err != nil {
+		if errors.Is(err, database.ErrPostNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	urls := extractPostURLs(post.Content)
+	if post.Status != database.PostStatusPublished || len(urls) == 0 {
+		return p.linkPreviewDataAccessor.DeleteLinkPreviewOfPost(ctx, postID)
+	}
+
+	preview, err := p.linkPreviewFetcher.Fetch(ctx, urls[0])
+	if err != nil {
+		logger.With(zap.Error(err)).Info("no link preview for post")
+		return p.linkPreviewDataAccessor.DeleteLinkPreviewOfPost(ctx, postID)
+	}
+
+	return p.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		err := p.linkPreviewDataAccessor.WithDatabase(td).DeleteLinkPreviewOfPost(ctx, postID)
+		if err != nil {
+			return err
+		}
+		return p.linkPreviewDataAccessor.WithDatabase(td).CreateLinkPreview(ctx, database.LinkPreview{
+			PostID:      postID,
+			URL:         preview.URL,
+			Title:       preview.Title,
+			Description: preview.Description,
+			ImageURL:    preview.ImageURL,
+			SiteName:    preview.SiteName,
+			FetchedAt:   time.Now(),
+		})
+	})
+}
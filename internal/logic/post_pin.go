@@ -0,0 +1,90 @@
+package logic
+
+import (
+	"GoFeed/internal/dataaccess/database"
+	"context"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/samber/lo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultMaxPinnedPosts = 3
+)
+
+// pinnedPostsFirst moves the pinned posts to the front of postList in pin order and keeps the order of the rest.
+func pinnedPostsFirst(postList []database.Post, pinnedPostIDList []uint64) []database.Post {
+	postMap := lo.SliceToMap(postList, func(item database.Post) (uint64, database.Post) {
+		return item.ID, item
+	})
+	pinnedPostIDSet := make(map[uint64]struct{}, len(pinnedPostIDList))
+	orderedPostList := make([]database.Post, 0, len(postList))
+	for _, postID := range pinnedPostIDList {
+		if post, ok := postMap[postID]; ok {
+			pinnedPostIDSet[postID] = struct{}{}
+			orderedPostList = append(orderedPostList, post)
+		}
+	}
+	for _, post := range postList {
+		if _, ok := pinnedPostIDSet[post.ID]; !ok {
+			orderedPostList = append(orderedPostList, post)
+		}
+	}
+	return orderedPostList
+}
+
+func (p postLogic) getMaxPinnedPosts() int {
+	if p.postConfig.MaxPinnedPosts == 0 {
+		return defaultMaxPinnedPosts
+	}
+	return int(p.postConfig.MaxPinnedPosts)
+}
+
+// PinPost pins one of the account's own published posts to its profile. Pinning an already pinned post is a no-op.
+func (p postLogic) PinPost(ctx context.Context, params PinPostParams) error {
+	accountID, _, err := p.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
+	if err != nil {
+		return err
+	}
+	return p.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		post, err := p.postDataAccessor.WithDatabase(td).GetPostByIDWithXLock(ctx, params.ID)
+		if err != nil {
+			return err
+		}
+		if post.AccountID != accountID {
+			return status.Error(codes.PermissionDenied, "trying to pin a post the account does not own")
+		}
+		if post.Status != database.PostStatusPublished {
+			return status.Error(codes.FailedPrecondition, "only published posts can be pinned")
+		}
+		// Concurrent pins of the same account would each see room under the limit, so they are serialized on the
+		// account row.
+		_, err = p.accountDataAccessor.WithDatabase(td).GetAccountByIDWithXLock(ctx, accountID)
+		if err != nil {
+			return err
+		}
+		pinnedPostIDList, err := p.pinDataAccessor.WithDatabase(td).GetPinnedPostIDsOfAccount(ctx, accountID)
+		if err != nil {
+			return err
+		}
+		if lo.Contains(pinnedPostIDList, params.ID) {
+			return nil
+		}
+		if len(pinnedPostIDList) >= p.getMaxPinnedPosts() {
+			return status.Errorf(codes.FailedPrecondition, "an account can pin at most %d posts", p.getMaxPinnedPosts())
+		}
+		return p.pinDataAccessor.WithDatabase(td).CreatePin(ctx, accountID, params.ID, time.Now())
+	})
+}
+
+// UnpinPost removes a post from the account's pinned posts. Unpinning a post that is not pinned is a no-op.
+func (p postLogic) UnpinPost(ctx context.Context, params UnpinPostParams) error {
+	accountID, _, err := p.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
+	if err != nil {
+		return err
+	}
+	return p.pinDataAccessor.DeletePin(ctx, accountID, params.ID)
+}
@@ -0,0 +1,306 @@
+package logic
+
+import (
+	"GoFeed/internal/dataaccess/database"
+	"GoFeed/internal/generated/api/go_feed"
+	"GoFeed/internal/utils"
+	"context"
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	minPollOptions       = 2
+	maxPollOptions       = 4
+	maxPollOptionLength  = 100
+	defaultPollBatchSize = 100
+)
+
+var (
+	errPollIsClosed = status.Error(codes.FailedPrecondition, "poll is closed")
+)
+
+func isPollClosed(poll database.Poll, now time.Time) bool {
+	return poll.Closed || !now.Before(poll.ClosesAt)
+}
+
+// validateNewPoll returns the trimmed options of poll.
+func validateNewPoll(poll NewPollParams, now time.Time) ([]string, error) {
+	options := lo.Map(poll.Options, func(item string, _ int) string {
+		return strings.TrimSpace(item)
+	})
+	if len(options) < minPollOptions || len(options) > maxPollOptions {
+		return nil, status.Errorf(codes.InvalidArgument, "a poll must have between %d and %d options", minPollOptions, maxPollOptions)
+	}
+	for _, option := range options {
+		if option == "" {
+			return nil, status.Error(codes.InvalidArgument, "poll options must not be empty")
+		}
+		if utf8.RuneCountInString(option) > maxPollOptionLength {
+			return nil, status.Errorf(codes.InvalidArgument, "poll options must be at most %d characters", maxPollOptionLength)
+		}
+	}
+	if len(lo.Uniq(options)) != len(options) {
+		return nil, status.Error(codes.InvalidArgument, "poll options must be distinct")
+	}
+	if !poll.ClosesAt.After(now) {
+		return nil, status.Error(codes.InvalidArgument, "poll closing time must be in the future")
+	}
+	return options, nil
+}
+
+func (p postLogic) createPoll(ctx context.Context, td *goqu.TxDatabase, postID uint64, poll NewPollParams, options []string) error {
+	pollID := p.idGenerator.GenID()
+	return p.pollDataAccessor.WithDatabase(td).CreatePoll(ctx, database.Poll{
+		ID:             pollID,
+		PostID:         postID,
+		MultipleChoice: poll.MultipleChoice,
+		ClosesAt:       poll.ClosesAt,
+	}, lo.Map(options, func(item string, i int) database.PollOption {
+		return database.PollOption{
+			ID:       p.idGenerator.GenID(),
+			PollID:   pollID,
+			Position: uint32(i),
+			Text:     item,
+		}
+	}))
+}
+
+// databasePollsToProtoPolls converts polls into what viewerID is allowed to see, keyed by post id. Open polls are
+// tallied from the votes, closed polls use the tallies frozen by CloseDuePolls.
+func (p postLogic) databasePollsToProtoPolls(ctx context.Context, viewerID uint64, pollList []database.Poll) (map[uint64]*go_feed.Poll, error) {
+	pollIDList := lo.Map(pollList, func(item database.Poll, _ int) uint64 {
+		return item.ID
+	})
+	optionList, err := p.pollDataAccessor.GetOptionsOfPolls(ctx, pollIDList)
+	if err != nil {
+		return nil, err
+	}
+	voteList, err := p.pollDataAccessor.GetVotesOfAccount(ctx, pollIDList, viewerID)
+	if err != nil {
+		return nil, err
+	}
+	votedOptionIDSet := lo.SliceToMap(voteList, func(item database.PollVote) (uint64, struct{}) {
+		return item.OptionID, struct{}{}
+	})
+	votedPollIDSet := lo.SliceToMap(voteList, func(item database.PollVote) (uint64, struct{}) {
+		return item.PollID, struct{}{}
+	})
+
+	now := time.Now()
+	resultsVisible := func(poll database.Poll) bool {
+		_, hasVoted := votedPollIDSet[poll.ID]
+		return hasVoted || isPollClosed(poll, now)
+	}
+	liveTallyPollIDList := lo.FilterMap(pollList, func(item database.Poll, _ int) (uint64, bool) {
+		return item.ID, !item.Closed && resultsVisible(item)
+	})
+	voteCountList, err := p.pollDataAccessor.GetVoteCountsOfPolls(ctx, liveTallyPollIDList)
+	if err != nil {
+		return nil, err
+	}
+	voterCountList, err := p.pollDataAccessor.GetVoterCountsOfPolls(ctx, liveTallyPollIDList)
+	if err != nil {
+		return nil, err
+	}
+	voteCountMap := lo.SliceToMap(voteCountList, func(item database.PollOptionVoteCount) (uint64, uint64) {
+		return item.OptionID, item.Count
+	})
+	voterCountMap := lo.SliceToMap(voterCountList, func(item database.PollVoterCount) (uint64, uint64) {
+		return item.PollID, item.Count
+	})
+	optionMap := lo.GroupBy(optionList, func(item database.PollOption) uint64 {
+		return item.PollID
+	})
+
+	protoPollMap := make(map[uint64]*go_feed.Poll, len(pollList))
+	for _, poll := range pollList {
+		_, hasVoted := votedPollIDSet[poll.ID]
+		visible := resultsVisible(poll)
+		protoPoll := &go_feed.Poll{
+			Id:             poll.ID,
+			MultipleChoice: poll.MultipleChoice,
+			ClosesAt:       timestamppb.New(poll.ClosesAt),
+			Closed:         isPollClosed(poll, now),
+			ResultsVisible: visible,
+			HasVoted:       hasVoted,
+		}
+		if visible {
+			protoPoll.VoterCount = poll.VoterCount
+			if !poll.Closed {
+				protoPoll.VoterCount = voterCountMap[poll.ID]
+			}
+		}
+		protoPoll.Options = lo.Map(optionMap[poll.ID], func(option database.PollOption, _ int) *go_feed.PollOption {
+			_, voted := votedOptionIDSet[option.ID]
+			protoOption := &go_feed.PollOption{
+				Id:    option.ID,
+				Text:  option.Text,
+				Voted: voted,
+			}
+			if visible {
+				protoOption.VoteCount = option.VoteCount
+				if !poll.Closed {
+					protoOption.VoteCount = voteCountMap[option.ID]
+				}
+			}
+			return protoOption
+		})
+		protoPollMap[poll.PostID] = protoPoll
+	}
+	return protoPollMap, nil
+}
+
+func (p postLogic) getProtoPoll(ctx context.Context, viewerID uint64, poll database.Poll) (*go_feed.Poll, error) {
+	protoPollMap, err := p.databasePollsToProtoPolls(ctx, viewerID, []database.Poll{poll})
+	if err != nil {
+		return nil, err
+	}
+	return protoPollMap[poll.PostID], nil
+}
+
+// getVisiblePoll returns a poll only if viewerID can see the post it is attached to, see BlockLogic.CheckCanViewPost.
+func (p postLogic) getVisiblePoll(ctx context.Context, viewerID uint64, pollID uint64) (database.Poll, error) {
+	poll, err := p.pollDataAccessor.GetPollByID(ctx, pollID)
+	if err != nil {
+		return database.Poll{}, err
+	}
+	post, err := p.postDataAccessor.GetPostByID(ctx, poll.PostID)
+	if err != nil {
+		return database.Poll{}, err
+	}
+	if err = p.blockLogic.CheckCanViewPost(ctx, viewerID, post); err != nil {
+		if errors.Is(err, database.ErrPostNotFound) {
+			return database.Poll{}, database.ErrPollNotFound
+		}
+		return database.Poll{}, err
+	}
+	return poll, nil
+}
+
+func (p postLogic) VotePoll(ctx context.Context, params VotePollParams) (VotePollOutput, error) {
+	accountID, _, err := p.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
+	if err != nil {
+		return VotePollOutput{}, err
+	}
+	optionIDList := lo.Uniq(params.OptionIDs)
+	if len(optionIDList) == 0 {
+		return VotePollOutput{}, status.Error(codes.InvalidArgument, "at least one option is required")
+	}
+	if _, err = p.getVisiblePoll(ctx, accountID, params.PollID); err != nil {
+		return VotePollOutput{}, err
+	}
+
+	var votedPoll database.Poll
+	txErr := p.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		// The shared lock keeps the poll from being closed while the vote is written.
+		poll, err := p.pollDataAccessor.WithDatabase(td).GetPollByIDWithSLock(ctx, params.PollID)
+		if err != nil {
+			return err
+		}
+		if isPollClosed(poll, time.Now()) {
+			return errPollIsClosed
+		}
+		if !poll.MultipleChoice && len(optionIDList) > 1 {
+			return status.Error(codes.InvalidArgument, "poll only allows a single choice")
+		}
+		optionList, err := p.pollDataAccessor.WithDatabase(td).GetOptionsOfPolls(ctx, []uint64{poll.ID})
+		if err != nil {
+			return err
+		}
+		pollOptionIDList := lo.Map(optionList, func(item database.PollOption, _ int) uint64 {
+			return item.ID
+		})
+		if !lo.Every(pollOptionIDList, optionIDList) {
+			return status.Error(codes.InvalidArgument, "option does not belong to the poll")
+		}
+		voteList, err := p.pollDataAccessor.WithDatabase(td).GetVotesOfAccount(ctx, []uint64{poll.ID}, accountID)
+		if err != nil {
+			return err
+		}
+		if len(voteList) > 0 {
+			return status.Error(codes.AlreadyExists, "account has already voted in this poll")
+		}
+		votedPoll = poll
+		return p.pollDataAccessor.WithDatabase(td).CreateVote(ctx, poll.ID, accountID, optionIDList, time.Now())
+	})
+	if txErr != nil {
+		return VotePollOutput{}, txErr
+	}
+
+	protoPoll, err := p.getProtoPoll(ctx, accountID, votedPoll)
+	if err != nil {
+		return VotePollOutput{}, err
+	}
+	return VotePollOutput{
+		Poll: protoPoll,
+	}, nil
+}
+
+func (p postLogic) GetPollResults(ctx context.Context, params GetPollResultsParams) (GetPollResultsOutput, error) {
+	accountID, _, err := p.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
+	if err != nil {
+		return GetPollResultsOutput{}, err
+	}
+	poll, err := p.getVisiblePoll(ctx, accountID, params.PollID)
+	if err != nil {
+		return GetPollResultsOutput{}, err
+	}
+	protoPoll, err := p.getProtoPoll(ctx, accountID, poll)
+	if err != nil {
+		return GetPollResultsOutput{}, err
+	}
+	return GetPollResultsOutput{
+		Poll: protoPoll,
+	}, nil
+}
+
+// CloseDuePolls freezes the tallies of every poll whose closing time has passed. It is run by the poll closer worker.
+func (p postLogic) CloseDuePolls(ctx context.Context) error {
+	logger := utils.LoggerWithContext(ctx, p.logger)
+
+	pollIDList, err := p.pollDataAccessor.GetDuePollIDs(ctx, time.Now(), defaultPollBatchSize)
+	if err != nil {
+		return err
+	}
+
+	var closeErr error
+	for _, pollID := range pollIDList {
+		err = p.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+			poll, err := p.pollDataAccessor.WithDatabase(td).GetPollByIDWithXLock(ctx, pollID)
+			if err != nil {
+				return err
+			}
+			// Another closer instance may have closed it already.
+			if poll.Closed {
+				return nil
+			}
+			voteCountList, err := p.pollDataAccessor.WithDatabase(td).GetVoteCountsOfPolls(ctx, []uint64{poll.ID})
+			if err != nil {
+				return err
+			}
+			voterCountList, err := p.pollDataAccessor.WithDatabase(td).GetVoterCountsOfPolls(ctx, []uint64{poll.ID})
+			if err != nil {
+				return err
+			}
+			voterCount := lo.SumBy(voterCountList, func(item database.PollVoterCount) uint64 {
+				return item.Count
+			})
+			return p.pollDataAccessor.WithDatabase(td).ClosePoll(ctx, poll.ID, voterCount, voteCountList)
+		})
+		if err != nil {
+			logger.With(zap.Uint64("poll_id", pollID)).With(zap.Error(err)).Error("failed to close poll")
+			closeErr = errors.Join(closeErr, err)
+		}
+	}
+	return closeErr
+}
@@ -0,0 +1,216 @@
+package logic
+
+import (
+	"GoFeed/internal/dataaccess/cache"
+	"GoFeed/internal/dataaccess/database"
+	"GoFeed/internal/utils"
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultRestoreWindow    = 30 * 24 * time.Hour
+	defaultDeletedRetention = 90 * 24 * time.Hour
+	defaultPurgeBatchSize   = 100
+)
+
+func (p postLogic) getRestoreWindow() (time.Duration, error) {
+	if p.postConfig.RestoreWindow == "" {
+		return defaultRestoreWindow, nil
+	}
+	restoreWindow, err := p.postConfig.GetRestoreWindowDuration()
+	if err != nil {
+		p.logger.With(zap.Error(err)).Error("failed to parse restore_window")
+		return 0, status.Error(codes.Internal, "failed to parse restore_window")
+	}
+	return restoreWindow, nil
+}
+
+func (p postLogic) getDeletedRetention() (time.Duration, error) {
+	if p.postConfig.DeletedRetention == "" {
+		return defaultDeletedRetention, nil
+	}
+	deletedRetention, err := p.postConfig.GetDeletedRetentionDuration()
+	if err != nil {
+		p.logger.With(zap.Error(err)).Error("failed to parse deleted_retention")
+		return 0, status.Error(codes.Internal, "failed to parse deleted_retention")
+	}
+	return deletedRetention, nil
+}
+
+// RestorePost takes a post out of the trash bin as long as it was deleted less than the restore window ago.
+func (p postLogic) RestorePost(ctx context.Context, params RestorePostParams) error {
+	accountID, _, err := p.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
+	if err != nil {
+		return err
+	}
+	restoreWindow, err := p.getRestoreWindow()
+	if err != nil {
+		return err
+	}
+
+	var restoredPost database.Post
+	txErr := p.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		post, err := p.postDataAccessor.WithDatabase(td).GetDeletedPostByIDWithXLock(ctx, params.ID)
+		if err != nil {
+			return err
+		}
+		if post.AccountID != accountID {
+			return status.Error(codes.PermissionDenied, "trying to restore a post the account does not own")
+		}
+		// A post taken down while it was in the trash bin stays taken down.
+		if post.RemovedAt.Valid {
+			return database.ErrPostNotFound
+		}
+		if time.Since(post.DeletedAt.Time) > restoreWindow {
+			return status.Error(codes.FailedPrecondition, "the post can no longer be restored")
+		}
+		post.DeletedAt = sql.NullTime{}
+		err = p.postDataAccessor.WithDatabase(td).UpdatePost(ctx, post)
+		if err != nil {
+			return err
+		}
+		restoredPost = post
+		if post.Status != database.PostStatusPublished {
+			return nil
+		}
+		return p.savePostEntities(ctx, td, post.ID, post.Content)
+	})
+	if txErr != nil {
+		return txErr
+	}
+	if restoredPost.Status == database.PostStatusPublished {
+		p.indexPost(ctx, restoredPost)
+	}
+	return nil
+}
+
+func (p postLogic) ListDeletedPosts(ctx context.Context, params ListDeletedPostsParams) (ListDeletedPostsOutput, error) {
+	accountID, _, err := p.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
+	if err != nil {
+		return ListDeletedPostsOutput{}, err
+	}
+	postList, err := p.postDataAccessor.GetDeletedPostsOfAccount(ctx, accountID)
+	if err != nil {
+		return ListDeletedPostsOutput{}, err
+	}
+	protoPostList, err := p.databasePostsToProtoPosts(ctx, accountID, postList)
+	if err != nil {
+		return ListDeletedPostsOutput{}, err
+	}
+	return ListDeletedPostsOutput{
+		PostList: protoPostList,
+	}, nil
+}
+
+// PurgeDeletedContent hard deletes the posts and comments that have been deleted for longer than the retention
+// period, together with everything attached to the posts. It is run by the purge worker.
+func (p postLogic) PurgeDeletedContent(ctx context.Context) error {
+	logger := utils.LoggerWithContext(ctx, p.logger)
+
+	deletedRetention, err := p.getDeletedRetention()
+	if err != nil {
+		return err
+	}
+	batchSize := p.postConfig.PurgeBatchSize
+	if batchSize == 0 {
+		batchSize = defaultPurgeBatchSize
+	}
+	deletedBefore := time.Now().Add(-deletedRetention)
+
+	postIDList, err := p.postDataAccessor.GetPurgeablePostIDs(ctx, deletedBefore, batchSize)
+	if err != nil {
+		return err
+	}
+	var purgeErr error
+	for _, postID := range postIDList {
+		if err = p.purgePost(ctx, postID); err != nil {
+			logger.With(zap.Uint64("post_id", postID)).With(zap.Error(err)).Error("failed to purge post")
+			purgeErr = errors.Join(purgeErr, err)
+		}
+	}
+
+	commentIDList, err := p.commentDataAccessor.GetPurgeableCommentIDs(ctx, deletedBefore, batchSize)
+	if err != nil {
+		return errors.Join(purgeErr, err)
+	}
+	for _, commentID := range commentIDList {
+		if err = p.purgeComment(ctx, commentID); err != nil {
+			logger.With(zap.Uint64("comment_id", commentID)).With(zap.Error(err)).Error("failed to purge comment")
+			purgeErr = errors.Join(purgeErr, err)
+		}
+	}
+	return purgeErr
+}
+
+func (p postLogic) purgePost(ctx context.Context, postID uint64) error {
+	txErr := p.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		err := p.likeDataAccessor.WithDatabase(td).DeleteLikesOfPost(ctx, postID)
+		if err != nil {
+			return err
+		}
+		err = p.bookmarkDataAccessor.WithDatabase(td).DeleteBookmarksOfPost(ctx, postID)
+		if err != nil {
+			return err
+		}
+		err = p.likeDataAccessor.WithDatabase(td).DeleteStoredReactionCountsOfPost(ctx, postID)
+		if err != nil {
+			return err
+		}
+		err = p.likeDataAccessor.WithDatabase(td).DeleteCommentLikesOfPost(ctx, postID)
+		if err != nil {
+			return err
+		}
+		err = p.commentDataAccessor.WithDatabase(td).DeleteCommentOfPost(ctx, postID)
+		if err != nil {
+			return err
+		}
+		err = p.deletePostEntities(ctx, td, postID)
+		if err != nil {
+			return err
+		}
+		err = p.pinDataAccessor.WithDatabase(td).DeletePinsOfPost(ctx, postID)
+		if err != nil {
+			return err
+		}
+		err = p.pollDataAccessor.WithDatabase(td).DeletePollOfPost(ctx, postID)
+		if err != nil {
+			return err
+		}
+		err = p.linkPreviewDataAccessor.WithDatabase(td).DeleteLinkPreviewOfPost(ctx, postID)
+		if err != nil {
+			return err
+		}
+		return p.postDataAccessor.WithDatabase(td).DeletePost(ctx, postID)
+	})
+	if txErr != nil {
+		return txErr
+	}
+
+	// The post is gone either way, the cache entries left behind only waste memory until they expire.
+	logger := utils.LoggerWithContext(ctx, p.logger).With(zap.Uint64("post_id", postID))
+	if err := p.postView.Delete(ctx, postID); err != nil {
+		logger.With(zap.Error(err)).Warn("failed to delete viewers of purged post")
+	}
+	if err := p.counter.Delete(ctx, cache.CounterKindPost, postID); err != nil {
+		logger.With(zap.Error(err)).Warn("failed to delete counters of purged post")
+	}
+	return nil
+}
+
+func (p postLogic) purgeComment(ctx context.Context, commentID uint64) error {
+	return p.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		err := p.likeDataAccessor.WithDatabase(td).DeleteLikesOfComment(ctx, commentID)
+		if err != nil {
+			return err
+		}
+		return p.commentDataAccessor.WithDatabase(td).DeleteComment(ctx, commentID)
+	})
+}
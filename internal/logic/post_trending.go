@@ -0,0 +1,151 @@
+package logic
+
+import (
+	"GoFeed/internal/dataaccess/cache"
+	"GoFeed/internal/dataaccess/database"
+	"GoFeed/internal/dataaccess/mq/producer"
+	"GoFeed/internal/generated/api/go_feed"
+	"GoFeed/internal/utils"
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var trendingKinds = []cache.TrendingKind{cache.TrendingKindHashtag, cache.TrendingKindPost}
+
+func protoTrendingWindowToCache(window go_feed.TrendingWindow) (cache.TrendingWindow, error) {
+	switch window {
+	case go_feed.TrendingWindow_TRENDING_WINDOW_1H:
+		return cache.TrendingWindowHour, nil
+	case go_feed.TrendingWindow_TRENDING_WINDOW_UNSPECIFIED, go_feed.TrendingWindow_TRENDING_WINDOW_24H:
+		return cache.TrendingWindowDay, nil
+	case go_feed.TrendingWindow_TRENDING_WINDOW_7D:
+		return cache.TrendingWindowWeek, nil
+	default:
+		return "", status.Error(codes.InvalidArgument, "window must be 1h, 24h or 7d")
+	}
+}
+
+func (p postLogic) enqueueTrendingActivity(ctx context.Context, activityType producer.TrendingActivityType, postID uint64, accountID uint64) {
+	err := p.trendingActivityProducer.Produce(ctx, producer.TrendingActivity{
+		Type:      activityType,
+		PostID:    postID,
+		AccountID: accountID,
+		CreatedAt: time.Now(),
+	})
+	if err != nil {
+		utils.LoggerWithContext(ctx, p.logger).With(zap.Error(err)).Warn("failed to enqueue trending activity")
+	}
+}
+
+// RecordTrendingActivity adds a published or liked post to the trending scores. Publishing a post only counts towards
+// its hashtags, a post itself trends once other accounts like it. The author liking their own post does not count.
+func (p postLogic) RecordTrendingActivity(ctx context.Context, activity producer.TrendingActivity) error {
+	logger := utils.LoggerWithContext(ctx, p.logger).With(zap.Uint64("post_id", activity.PostID))
+
+	post, err := p.postDataAccessor.GetPostByID(ctx, activity.PostID)
+	if err != nil {
+		if errors.Is(err, database.ErrPostNotFound) {
+			return nil
+		}
+		return err
+	}
+	if post.Status != database.PostStatusPublished {
+		return nil
+	}
+
+	switch activity.Type {
+	case producer.TrendingActivityTypePost:
+	case producer.TrendingActivityTypeLike:
+		if activity.AccountID == post.AccountID {
+			return nil
+		}
+		err = p.trending.Add(ctx, cache.TrendingKindPost, strconv.FormatUint(post.ID, 10), activity.AccountID, activity.CreatedAt)
+		if err != nil {
+			return err
+		}
+	default:
+		logger.With(zap.String("type", string(activity.Type))).Warn("skipping trending activity of unknown type")
+		return nil
+	}
+
+	hashtags, err := p.hashtagDataAccessor.GetHashtagsOfPost(ctx, post.ID)
+	if err != nil {
+		return err
+	}
+	for _, hashtag := range hashtags {
+		err = p.trending.Add(ctx, cache.TrendingKindHashtag, hashtag, activity.AccountID, activity.CreatedAt)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RefreshTrending recomputes the decayed trending scores of every window. It is run by the trending worker.
+func (p postLogic) RefreshTrending(ctx context.Context) error {
+	now := time.Now()
+	for _, kind := range trendingKinds {
+		if err := p.trending.Refresh(ctx, kind, now); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p postLogic) GetTrendingHashtags(ctx context.Context, params GetTrendingHashtagsParams) (GetTrendingHashtagsOutput, error) {
+	_, _, err := p.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
+	if err != nil {
+		return GetTrendingHashtagsOutput{}, err
+	}
+	window, err := protoTrendingWindowToCache(params.Window)
+	if err != nil {
+		return GetTrendingHashtagsOutput{}, err
+	}
+	memberList, err := p.trending.GetTop(ctx, cache.TrendingKindHashtag, window, int64(p.getPageLimit(params.Limit)))
+	if err != nil {
+		return GetTrendingHashtagsOutput{}, err
+	}
+	return GetTrendingHashtagsOutput{
+		HashtagList: lo.Map(memberList, func(item cache.SortedSetMember, _ int) *go_feed.TrendingHashtag {
+			return &go_feed.TrendingHashtag{
+				Hashtag: item.Member,
+				Score:   item.Score,
+			}
+		}),
+	}, nil
+}
+
+// GetTrendingPosts returns the posts liked by the most distinct accounts in the window, recent likes weighing more.
+// Posts that have since been deleted or taken down are skipped, so a page can be shorter than the limit.
+func (p postLogic) GetTrendingPosts(ctx context.Context, params GetTrendingPostsParams) (GetTrendingPostsOutput, error) {
+	accountID, _, err := p.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
+	if err != nil {
+		return GetTrendingPostsOutput{}, err
+	}
+	window, err := protoTrendingWindowToCache(params.Window)
+	if err != nil {
+		return GetTrendingPostsOutput{}, err
+	}
+	memberList, err := p.trending.GetTop(ctx, cache.TrendingKindPost, window, int64(p.getPageLimit(params.Limit)))
+	if err != nil {
+		return GetTrendingPostsOutput{}, err
+	}
+	postIDList := lo.FilterMap(memberList, func(item cache.SortedSetMember, _ int) (uint64, bool) {
+		postID, err := strconv.ParseUint(item.Member, 10, 64)
+		return postID, err == nil
+	})
+	postList, err := p.getPostsByIDs(ctx, accountID, postIDList, true)
+	if err != nil {
+		return GetTrendingPostsOutput{}, err
+	}
+	return GetTrendingPostsOutput{
+		PostList: postList,
+	}, nil
+}
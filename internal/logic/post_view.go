@@ -0,0 +1,84 @@
+package logic
+
+import (
+	"GoFeed/internal/generated/api/go_feed"
+	"GoFeed/internal/utils"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+)
+
+const (
+	postViewRecordTimeout          = time.Second
+	maxPendingPostViewRecords      = 64
+	defaultViewCountFlushBatchSize = 500
+)
+
+// recordPostViews counts viewerID as a viewer of the published posts of other accounts. It runs in the background
+// so that a slow or unavailable cache never delays a read. View counts are best effort, so when
+// maxPendingPostViewRecords recordings are already in flight the views are dropped instead of piling up goroutines.
+func (p postLogic) recordPostViews(ctx context.Context, viewerID uint64, postList []*go_feed.Post) {
+	postIDList := lo.FilterMap(postList, func(item *go_feed.Post, _ int) (uint64, bool) {
+		return item.Id, item.AccountId != viewerID && item.Status == go_feed.PostStatus_POST_STATUS_PUBLISHED
+	})
+	if len(postIDList) == 0 {
+		return
+	}
+
+	select {
+	case p.postViewRecordSlots <- struct{}{}:
+	default:
+		utils.LoggerWithContext(ctx, p.logger).Debug("too many post views being recorded, dropping views")
+		return
+	}
+
+	go func() {
+		defer func() { <-p.postViewRecordSlots }()
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), postViewRecordTimeout)
+		defer cancel()
+		if err := p.postView.Add(ctx, viewerID, postIDList...); err != nil {
+			utils.LoggerWithContext(ctx, p.logger).With(zap.Error(err)).Warn("failed to record post views")
+		}
+	}()
+}
+
+// PersistPostViewCounts copies the unique viewer counts of the posts viewed since the last run from the cache to the
+// database. Posts that fail are put back so the next run retries them. It is run by the post view flusher worker.
+func (p postLogic) PersistPostViewCounts(ctx context.Context) error {
+	logger := utils.LoggerWithContext(ctx, p.logger)
+
+	batchSize := p.postConfig.ViewCountFlushBatchSize
+	if batchSize == 0 {
+		batchSize = defaultViewCountFlushBatchSize
+	}
+
+	for {
+		postIDList, err := p.postView.PopPending(ctx, int64(batchSize))
+		if err != nil {
+			return err
+		}
+
+		var persistErr error
+		failedPostIDList := make([]uint64, 0)
+		for _, postID := range postIDList {
+			viewCount, err := p.postView.Count(ctx, postID)
+			if err == nil {
+				err = p.postDataAccessor.UpdateViewCount(ctx, postID, viewCount)
+			}
+			if err != nil {
+				logger.With(zap.Uint64("post_id", postID)).With(zap.Error(err)).Error("failed to persist view count of post")
+				persistErr = errors.Join(persistErr, err)
+				failedPostIDList = append(failedPostIDList, postID)
+			}
+		}
+		if len(failedPostIDList) > 0 {
+			return errors.Join(persistErr, p.postView.AddPending(ctx, failedPostIDList...))
+		}
+		if uint64(len(postIDList)) < batchSize {
+			return nil
+		}
+	}
+}
@@ -1 +1,142 @@
 package logic
+
+import (
+	"GoFeed/internal/configs"
+	"GoFeed/internal/utils"
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	defaultPostSchedulerInterval     = time.Minute
+	defaultPollCloserInterval        = time.Minute
+	defaultPurgeInterval             = time.Hour
+	defaultViewCountFlushInterval    = time.Minute
+	defaultTrendingRefreshInterval   = 5 * time.Minute
+	defaultCounterFlushInterval      = time.Minute
+	defaultSuggestionRefreshInterval = time.Hour
+)
+
+// getWorkerInterval parses a configured worker interval, falling back to defaultInterval when it is not set.
+// Non-positive intervals are rejected since time.NewTicker panics on them.
+func getWorkerInterval(value string, parse func() (time.Duration, error), defaultInterval time.Duration) (time.Duration, error) {
+	if value == "" {
+		return defaultInterval, nil
+	}
+	interval, err := parse()
+	if err != nil {
+		return 0, err
+	}
+	if interval <= 0 {
+		return 0, fmt.Errorf("interval must be positive, got %s", value)
+	}
+	return interval, nil
+}
+
+type Worker interface {
+	Start(ctx context.Context) error
+}
+
+// periodicWorker runs job every interval until ctx is done. A failed run is logged and retried on
+// the next tick, so jobs must be safe to run again.
+type periodicWorker struct {
+	name     string
+	interval time.Duration
+	job      func(ctx context.Context) error
+	logger   *zap.Logger
+}
+
+func newPeriodicWorker(name string, interval time.Duration, job func(ctx context.Context) error, logger *zap.Logger) Worker {
+	return &periodicWorker{
+		name:     name,
+		interval: interval,
+		job:      job,
+		logger:   logger,
+	}
+}
+
+func (w periodicWorker) Start(ctx context.Context) error {
+	logger := utils.LoggerWithContext(ctx, w.logger).With(zap.String("worker", w.name))
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	logger.With(zap.Duration("interval", w.interval)).Info("starting worker")
+	for {
+		select {
+		case <-ctx.Done():
+			logger.Info("stopping worker")
+			return nil
+		case <-ticker.C:
+			if err := w.job(ctx); err != nil {
+				logger.With(zap.Error(err)).Error("worker run failed")
+			}
+		}
+	}
+}
+
+func NewPostSchedulerWorker(postLogic PostLogic, postConfig configs.Post, logger *zap.Logger) (Worker, error) {
+	interval, err := getWorkerInterval(postConfig.SchedulerInterval, postConfig.GetSchedulerIntervalDuration, defaultPostSchedulerInterval)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to parse scheduler_interval")
+		return nil, err
+	}
+	return newPeriodicWorker("post_scheduler", interval, postLogic.PublishDuePosts, logger), nil
+}
+
+func NewPollCloserWorker(postLogic PostLogic, postConfig configs.Post, logger *zap.Logger) (Worker, error) {
+	interval, err := getWorkerInterval(postConfig.PollCloserInterval, postConfig.GetPollCloserIntervalDuration, defaultPollCloserInterval)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to parse poll_closer_interval")
+		return nil, err
+	}
+	return newPeriodicWorker("poll_closer", interval, postLogic.CloseDuePolls, logger), nil
+}
+
+func NewPurgeWorker(postLogic PostLogic, postConfig configs.Post, logger *zap.Logger) (Worker, error) {
+	interval, err := getWorkerInterval(postConfig.PurgeInterval, postConfig.GetPurgeIntervalDuration, defaultPurgeInterval)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to parse purge_interval")
+		return nil, err
+	}
+	return newPeriodicWorker("purge", interval, postLogic.PurgeDeletedContent, logger), nil
+}
+
+func NewPostViewFlusherWorker(postLogic PostLogic, postConfig configs.Post, logger *zap.Logger) (Worker, error) {
+	interval, err := getWorkerInterval(postConfig.ViewCountFlushInterval, postConfig.GetViewCountFlushIntervalDuration, defaultViewCountFlushInterval)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to parse view_count_flush_interval")
+		return nil, err
+	}
+	return newPeriodicWorker("post_view_flusher", interval, postLogic.PersistPostViewCounts, logger), nil
+}
+
+func NewTrendingWorker(postLogic PostLogic, postConfig configs.Post, logger *zap.Logger) (Worker, error) {
+	interval, err := getWorkerInterval(postConfig.TrendingRefreshInterval, postConfig.GetTrendingRefreshIntervalDuration, defaultTrendingRefreshInterval)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to parse trending_refresh_interval")
+		return nil, err
+	}
+	return newPeriodicWorker("trending", interval, postLogic.RefreshTrending, logger), nil
+}
+
+func NewCounterFlusherWorker(counterLogic CounterLogic, counterConfig configs.Counter, logger *zap.Logger) (Worker, error) {
+	interval, err := getWorkerInterval(counterConfig.FlushInterval, counterConfig.GetFlushIntervalDuration, defaultCounterFlushInterval)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to parse flush_interval")
+		return nil, err
+	}
+	return newPeriodicWorker("counter_flusher", interval, counterLogic.FlushCounters, logger), nil
+}
+
+func NewFollowSuggestionWorker(followLogic FollowLogic, followConfig configs.Follow, logger *zap.Logger) (Worker, error) {
+	interval, err := getWorkerInterval(followConfig.SuggestionRefreshInterval, followConfig.GetSuggestionRefreshIntervalDuration, defaultSuggestionRefreshInterval)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to parse suggestion_refresh_interval")
+		return nil, err
+	}
+	return newPeriodicWorker("follow_suggestion", interval, followLogic.RefreshFollowSuggestions, logger), nil
+}